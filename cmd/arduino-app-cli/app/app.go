@@ -34,6 +34,7 @@ func NewAppCmd(cfg config.Configuration) *cobra.Command {
 
 	appCmd.AddCommand(newCreateCmd(cfg))
 	appCmd.AddCommand(newStartCmd(cfg))
+	appCmd.AddCommand(newPrepareCmd(cfg))
 	appCmd.AddCommand(newStopCmd(cfg))
 	appCmd.AddCommand(newRestartCmd(cfg))
 	appCmd.AddCommand(newLogsCmd(cfg))
@@ -41,15 +42,21 @@ func NewAppCmd(cfg config.Configuration) *cobra.Command {
 	appCmd.AddCommand(newPsCmd())
 	appCmd.AddCommand(newMonitorCmd(cfg))
 	appCmd.AddCommand(newCacheCleanCmd(cfg))
+	appCmd.AddCommand(newValidateCmd(cfg))
+	appCmd.AddCommand(newBuildCmd(cfg))
+	appCmd.AddCommand(newUploadCmd(cfg))
+	appCmd.AddCommand(newMigrateCmd(cfg))
+	appCmd.AddCommand(newExportCmd(cfg))
+	appCmd.AddCommand(newImportCmd(cfg))
 
 	return appCmd
 }
 
-func Load(idOrPath string) (app.ArduinoApp, error) {
+func Load(idOrPath string, cfg config.Configuration) (app.ArduinoApp, error) {
 	id, err := servicelocator.GetAppIDProvider().ParseID(idOrPath)
 	if err != nil {
 		return app.ArduinoApp{}, fmt.Errorf("invalid app path: %s", idOrPath)
 	}
 
-	return app.Load(id.ToPath().String())
+	return app.Load(id.ToPath().String(), cfg)
 }
@@ -0,0 +1,115 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newBuildCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "build <app-path>",
+		Short: "Compile the sketch of an app",
+		Long:  "Compile the sketch of an app without uploading it, reporting the compiler diagnostics and the size of the binary.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			app, err := Load(args[0], cfg)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+			}
+			out, _, getResult := feedback.OutputStreams()
+			res, err := orchestrator.BuildSketch(cmd.Context(), app, out)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			printSketchBuildResult(sketchBuildResult{
+				AppName:           app.Name,
+				SketchBuildResult: res,
+				Output:            getResult(),
+			})
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
+
+func printSketchBuildResult(res sketchBuildResult) {
+	if !res.Success {
+		feedback.FatalResult(res, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(res)
+}
+
+type sketchBuildResult struct {
+	AppName string `json:"app_name"`
+	*orchestrator.SketchBuildResult
+	Output *feedback.OutputStreamsResult `json:"output,omitempty"`
+}
+
+func (r sketchBuildResult) String() string {
+	b := &strings.Builder{}
+	for _, d := range r.Diagnostics {
+		location := d.File
+		if d.Line > 0 {
+			location = fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column)
+		}
+		fmt.Fprintf(b, "%s: %s: %s\n", location, d.Severity, d.Message)
+		for _, n := range d.Notes {
+			fmt.Fprintf(b, "  %s:%d:%d: note: %s\n", n.File, n.Line, n.Column, n.Message)
+		}
+	}
+	if !r.Success {
+		return strings.TrimSuffix(b.String(), "\n")
+	}
+	for _, s := range r.Sizes {
+		name := s.Name
+		switch s.Name {
+		case "text":
+			name = "Program storage"
+		case "data":
+			name = "Dynamic memory"
+		}
+		if s.MaxSize > 0 {
+			fmt.Fprintf(b, "%s: %d bytes (%d%%) of %d bytes\n", name, s.Size, s.Size*100/s.MaxSize, s.MaxSize)
+		} else {
+			fmt.Fprintf(b, "%s: %d bytes\n", name, s.Size)
+		}
+	}
+	if r.Uploaded {
+		fmt.Fprintf(b, "✓ Sketch of app %q compiled and uploaded successfully", r.AppName)
+	} else {
+		fmt.Fprintf(b, "✓ Sketch of app %q compiled successfully", r.AppName)
+	}
+	return b.String()
+}
+
+func (r sketchBuildResult) ErrorString() string {
+	if r.Success {
+		return ""
+	}
+	return fmt.Sprintf("✗ Compilation of the sketch of app %q failed: %s", r.AppName, r.Error)
+}
+
+func (r sketchBuildResult) Data() interface{} {
+	return r
+}
@@ -21,11 +21,11 @@ func newCacheCleanCmd(cfg config.Configuration) *cobra.Command {
 		Short: "Delete app cache",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			app, err := Load(args[0])
+			app, err := Load(args[0], cfg)
 			if err != nil {
 				return err
 			}
-			return cacheCleanHandler(cmd.Context(), app, forceClean)
+			return cacheCleanHandler(cmd.Context(), app, forceClean, cfg)
 		},
 		ValidArgsFunction: completion.ApplicationNames(cfg),
 	}
@@ -34,12 +34,13 @@ func newCacheCleanCmd(cfg config.Configuration) *cobra.Command {
 	return appCmd
 }
 
-func cacheCleanHandler(ctx context.Context, app app.ArduinoApp, forceClean bool) error {
+func cacheCleanHandler(ctx context.Context, app app.ArduinoApp, forceClean bool, cfg config.Configuration) error {
 	err := orchestrator.CleanAppCache(
 		ctx,
 		servicelocator.GetDockerClient(),
 		app,
 		orchestrator.CleanAppCacheRequest{ForceClean: forceClean},
+		cfg,
 	)
 	if err != nil {
 		feedback.Fatal(err.Error(), feedback.ErrGeneric)
@@ -0,0 +1,91 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newExportCmd(cfg config.Configuration) *cobra.Command {
+	var output string
+	var includeData bool
+
+	cmd := &cobra.Command{
+		Use:   "export <app-path> -o <bundle.zip|bundle.tar.gz>",
+		Short: "Export an app as a bundle",
+		Long: "Export an app as a zip or tar.gz bundle, that can be imported on another board.\n" +
+			"The .cache folder is never exported, the data folder only when requested.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			exportHandler(cmd.Context(), cfg, args[0], output, includeData)
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Path of the bundle to create, its extension selects the format (.zip or .tar.gz)")
+	cmd.Flags().BoolVar(&includeData, "include-data", false, "Include the data folder of the app")
+	_ = cmd.MarkFlagRequired("output")
+	return cmd
+}
+
+func exportHandler(ctx context.Context, cfg config.Configuration, idOrPath, output string, includeData bool) {
+	format, err := orchestrator.BundleFormatFromFileName(output)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+	}
+	userApp, err := Load(idOrPath, cfg)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		feedback.Fatal(fmt.Sprintf("unable to create the bundle: %s", err), feedback.ErrGeneric)
+	}
+	manifest, err := orchestrator.ExportApp(ctx, userApp, orchestrator.ExportAppRequest{
+		Format:      format,
+		IncludeData: includeData,
+	}, file, cfg)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(output)
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(exportResult{Bundle: output, Manifest: manifest})
+}
+
+type exportResult struct {
+	Bundle   string                         `json:"bundle"`
+	Manifest orchestrator.AppBundleManifest `json:"manifest"`
+}
+
+func (r exportResult) String() string {
+	return fmt.Sprintf("✓ App %q exported to %s", r.Manifest.Name, r.Bundle)
+}
+
+func (r exportResult) Data() interface{} {
+	return r
+}
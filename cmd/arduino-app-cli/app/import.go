@@ -0,0 +1,94 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/cmdutil"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newImportCmd(cfg config.Configuration) *cobra.Command {
+	var name string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "import <bundle>",
+		Short: "Import an app from a bundle",
+		Long: "Import an app from a zip or tar.gz bundle created with `app export`.\n" +
+			"The bricks and models listed in the bundle manifest must be available on this board.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			importHandler(cmd.Context(), cfg, args[0], name, force)
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "Name of the imported app, the one in the bundle by default")
+	cmd.Flags().BoolVar(&force, "force", false, "Import the app even if some of its bricks or models are not available")
+	return cmd
+}
+
+func importHandler(ctx context.Context, cfg config.Configuration, bundlePath, name string, force bool) {
+	file, err := os.Open(bundlePath)
+	if err != nil {
+		feedback.Fatal(fmt.Sprintf("unable to open the bundle: %s", err), feedback.ErrBadArgument)
+	}
+	defer file.Close()
+
+	req := orchestrator.ImportAppRequest{Force: force}
+	if name != "" {
+		req.Name = &name
+	}
+	res, err := orchestrator.ImportApp(ctx, file, req,
+		servicelocator.GetBricksIndex(),
+		servicelocator.GetModelsIndex(),
+		servicelocator.GetAppIDProvider(),
+		cfg,
+	)
+	if err != nil {
+		if errors.Is(err, orchestrator.ErrIncompatibleBundle) {
+			feedback.Fatal(err.Error()+"\nUse --force to import the app anyway", feedback.ErrGeneric)
+		}
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(importResult{ImportAppResponse: res})
+}
+
+type importResult struct {
+	orchestrator.ImportAppResponse
+}
+
+func (r importResult) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "✓ App imported with id %s", cmdutil.IDToAlias(r.ID))
+	for _, w := range r.Warnings {
+		fmt.Fprintf(b, "\nwarning: %s", w)
+	}
+	return b.String()
+}
+
+func (r importResult) Data() interface{} {
+	return r.ImportAppResponse
+}
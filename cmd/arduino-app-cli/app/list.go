@@ -17,6 +17,7 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -61,16 +62,18 @@ func listHandler(ctx context.Context, cfg config.Configuration, showBrokenApps b
 	}
 
 	feedback.PrintResult(appListResult{
-		Apps:           res.Apps,
-		BrokenApps:     res.BrokenApps,
-		showBrokenApps: showBrokenApps,
+		Apps:            res.Apps,
+		BrokenApps:      res.BrokenApps,
+		UnsupportedApps: res.UnsupportedApps,
+		showBrokenApps:  showBrokenApps,
 	})
 }
 
 type appListResult struct {
-	Apps           []orchestrator.AppInfo       `json:"apps"`
-	BrokenApps     []orchestrator.BrokenAppInfo `json:"brokenApps"`
-	showBrokenApps bool
+	Apps            []orchestrator.AppInfo            `json:"apps"`
+	BrokenApps      []orchestrator.BrokenAppInfo      `json:"brokenApps"`
+	UnsupportedApps []orchestrator.UnsupportedAppInfo `json:"unsupportedApps"`
+	showBrokenApps  bool
 }
 
 func (r appListResult) String() string {
@@ -87,12 +90,20 @@ func (r appListResult) String() string {
 			app.Example,
 		})
 	}
+	var b strings.Builder
+	if len(r.UnsupportedApps) > 0 {
+		_, _ = b.WriteString("\nAPPS REQUIRING A NEWER VERSION OF ARDUINO-APP-CLI\n")
+		for _, app := range r.UnsupportedApps {
+			fmt.Fprintf(&b, "%s: app.yaml format version %d (supported up to %d)\n", app.Name, app.FormatVersion, app.SupportedFormatVersion)
+		}
+	}
 	if r.showBrokenApps && len(r.BrokenApps) > 0 {
-		var b strings.Builder
 		_, _ = b.WriteString("\nBROKEN APPS\n")
 		for _, app := range r.BrokenApps {
 			b.WriteString(app.Name + ": " + app.Error + "\n")
 		}
+	}
+	if b.Len() > 0 {
 		return t.Render() + "\n" + b.String()
 	}
 	return t.Render()
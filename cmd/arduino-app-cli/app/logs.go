@@ -43,7 +43,7 @@ func newLogsCmd(cfg config.Configuration) *cobra.Command {
 			if len(args) == 0 {
 				return cmd.Help()
 			}
-			app, err := Load(args[0])
+			app, err := Load(args[0], cfg)
 			if err != nil {
 				return err
 			}
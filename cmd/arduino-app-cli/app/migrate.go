@@ -0,0 +1,100 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newMigrateCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate [app-path...]",
+		Short: "Upgrade the app.yaml of the apps to the current format",
+		Long: "Upgrade the app.yaml of the given apps, or of all the apps in the apps folder, to the current format version.\n" +
+			"The original app.yaml is kept in a backup file in the .cache folder of the app.",
+		Run: func(cmd *cobra.Command, args []string) {
+			migrateHandler(cfg, args)
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
+
+func migrateHandler(cfg config.Configuration, args []string) {
+	var appPaths paths.PathList
+	for _, idOrPath := range args {
+		id, err := servicelocator.GetAppIDProvider().ParseID(idOrPath)
+		if err != nil {
+			feedback.Fatal(fmt.Sprintf("invalid app path: %s", idOrPath), feedback.ErrBadArgument)
+		}
+		appPaths.Add(id.ToPath())
+	}
+	res, err := orchestrator.MigrateApps(appPaths, cfg)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+	result := migrateResult{Apps: res}
+	if result.ErrorString() != "" {
+		feedback.FatalResult(result, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(result)
+}
+
+type migrateResult struct {
+	Apps []orchestrator.AppMigrationResult `json:"apps"`
+}
+
+func (r migrateResult) String() string {
+	if len(r.Apps) == 0 {
+		return "No apps found"
+	}
+	b := &strings.Builder{}
+	for _, app := range r.Apps {
+		switch {
+		case app.Error != "":
+			// Reported by ErrorString.
+		case len(app.Migrations) == 0:
+			fmt.Fprintf(b, "✓ %s: already at format version %d\n", app.Path, app.ToVersion)
+		default:
+			fmt.Fprintf(b, "✓ %s: migrated from format version %d to %d (%s), backup saved to %s\n",
+				app.Path, app.FromVersion, app.ToVersion, strings.Join(app.Migrations, ", "), app.Backup)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r migrateResult) ErrorString() string {
+	b := &strings.Builder{}
+	for _, app := range r.Apps {
+		if app.Error != "" {
+			fmt.Fprintf(b, "✗ %s: %s\n", app.Path, app.Error)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r migrateResult) Data() interface{} {
+	return r
+}
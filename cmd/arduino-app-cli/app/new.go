@@ -19,11 +19,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/arduino/go-paths-helper"
 	"github.com/spf13/cobra"
+	"go.bug.st/f"
 
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
 	"github.com/arduino/arduino-app-cli/cmd/feedback"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 )
 
@@ -35,6 +38,10 @@ func newCreateCmd(cfg config.Configuration) *cobra.Command {
 		noPyton     bool
 		noSketch    bool
 		fromApp     string
+		template    string
+		ports       []int
+		gitInit     bool
+		fromGit     string
 	)
 
 	cmd := &cobra.Command{
@@ -44,33 +51,59 @@ func newCreateCmd(cfg config.Configuration) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cobra.MinimumNArgs(1)
 			name := args[0]
-			return createHandler(cmd.Context(), cfg, name, icon, description, noPyton, noSketch, fromApp)
+			return createHandler(cmd.Context(), cfg, orchestrator.CreateAppRequest{
+				Name:        name,
+				Icon:        icon,
+				Description: description,
+				Ports:       ports,
+				Bricks:      bricks,
+				SkipPython:  noPyton,
+				SkipSketch:  noSketch,
+				GitInit:     gitInit,
+			}, fromApp, fromGit, template)
 		},
 	}
 
 	cmd.Flags().StringVarP(&icon, "icon", "i", "", "Icon for the app")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Description for the app")
 	cmd.Flags().StringVarP(&fromApp, "from-app", "", "", "Create the new app from the path of an existing app")
+	cmd.Flags().StringVarP(&template, "template", "t", "", "Create the new app from a template: an example app (examples:<name>), a brick code example (<brick-id>[/<example>]) or a user template")
+	cmd.Flags().IntSliceVarP(&ports, "ports", "p", nil, "List of ports exposed by the app")
 	cmd.Flags().StringArrayVarP(&bricks, "bricks", "b", []string{}, "List of bricks to include in the app")
 	cmd.Flags().BoolVarP(&noPyton, "no-python", "", false, "Do not include Python files")
 	cmd.Flags().BoolVarP(&noSketch, "no-sketch", "", false, "Do not include Sketch files")
 	cmd.MarkFlagsMutuallyExclusive("no-python", "no-sketch")
+	cmd.Flags().BoolVar(&gitInit, "git", false, "Initialize a git repository in the new app")
+	cmd.Flags().StringVar(&fromGit, "from-git", "", "Clone the new app from a local bare git repository")
+
+	cmd.MarkFlagsMutuallyExclusive("from-app", "from-git", "template")
+	_ = cmd.RegisterFlagCompletionFunc("template", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		brickIDs := f.Map(servicelocator.GetBricksIndex().Bricks, func(b bricksindex.Brick) string { return b.ID })
+		templates, err := orchestrator.ListAppTemplates(brickIDs, servicelocator.GetStaticStore(), cfg)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return f.Map(templates, func(t orchestrator.AppTemplate) string { return t.Name }), cobra.ShellCompDirectiveNoFileComp
+	})
 
 	return cmd
 }
 
-func createHandler(ctx context.Context, cfg config.Configuration, name string, icon string, description string, noPython, noSketch bool, fromApp string) error {
-	if fromApp != "" {
-		id, err := servicelocator.GetAppIDProvider().ParseID(fromApp)
-		if err != nil {
-			feedback.Fatal(err.Error(), feedback.ErrBadArgument)
-			return nil
+func createHandler(ctx context.Context, cfg config.Configuration, req orchestrator.CreateAppRequest, fromApp, fromGit, template string) error {
+	if fromApp != "" || fromGit != "" {
+		cloneReq := orchestrator.CloneAppRequest{Name: &req.Name}
+		if fromGit != "" {
+			cloneReq.FromRepository = paths.New(fromGit)
+		} else {
+			id, err := servicelocator.GetAppIDProvider().ParseID(fromApp)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			cloneReq.FromID = id
 		}
 
-		resp, err := orchestrator.CloneApp(ctx, orchestrator.CloneAppRequest{
-			Name:   &name,
-			FromID: id,
-		}, servicelocator.GetAppIDProvider(), cfg)
+		resp, err := orchestrator.CloneApp(ctx, cloneReq, servicelocator.GetAppIDProvider(), cfg)
 		if err != nil {
 			feedback.Fatal(err.Error(), feedback.ErrGeneric)
 			return nil
@@ -84,13 +117,15 @@ func createHandler(ctx context.Context, cfg config.Configuration, name string, i
 		})
 
 	} else {
-		resp, err := orchestrator.CreateApp(ctx, orchestrator.CreateAppRequest{
-			Name:        name,
-			Icon:        icon,
-			Description: description,
-			SkipPython:  noPython,
-			SkipSketch:  noSketch,
-		}, servicelocator.GetAppIDProvider(), cfg)
+		if template != "" {
+			t, err := orchestrator.FindAppTemplate(template, servicelocator.GetStaticStore(), cfg)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			req.Template = &t
+		}
+		resp, err := orchestrator.CreateApp(ctx, req, servicelocator.GetAppIDProvider(), servicelocator.GetBricksIndex(), cfg)
 		if err != nil {
 			feedback.Fatal(err.Error(), feedback.ErrGeneric)
 			return nil
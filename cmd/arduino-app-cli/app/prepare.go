@@ -0,0 +1,97 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newPrepareCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "prepare app_path",
+		Short: "Download everything an Arduino App needs to start offline",
+		Long: "Download everything an Arduino App needs to start offline: the container images, the models, " +
+			"and the platforms, tools and libraries of the sketch profile.\n\n" +
+			"Once prepared, the app can be started with `app start --offline` on a board without internet access.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := Load(args[0], cfg)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				return nil
+			}
+			return prepareHandler(cmd.Context(), cfg, app)
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
+
+func prepareHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp) error {
+	out, _, getResult := feedback.OutputStreams()
+
+	stream := orchestrator.PrepareApp(
+		ctx,
+		servicelocator.GetDockerClient(),
+		servicelocator.GetProvisioner(),
+		servicelocator.GetModelsIndex(),
+		servicelocator.GetBricksIndex(),
+		app,
+		cfg,
+		servicelocator.GetStaticStore(),
+	)
+	for message := range stream {
+		switch message.GetType() {
+		case orchestrator.ProgressType:
+			fmt.Fprintf(out, "Progress[%s]: %.0f%%\n", message.GetProgress().Name, message.GetProgress().Progress)
+		case orchestrator.InfoType:
+			fmt.Fprintln(out, "[INFO]", message.GetData())
+		case orchestrator.ErrorType:
+			feedback.Fatal(fmt.Sprintf("[ERROR] %s", message.GetError().Error()), feedback.ErrGeneric)
+			return nil
+		}
+	}
+
+	feedback.PrintResult(prepareAppResult{
+		AppName: app.Name,
+		Status:  "prepared",
+		Output:  getResult(),
+	})
+	return nil
+}
+
+type prepareAppResult struct {
+	AppName string                        `json:"appName"`
+	Status  string                        `json:"status"`
+	Output  *feedback.OutputStreamsResult `json:"output,omitempty"`
+}
+
+func (r prepareAppResult) String() string {
+	return fmt.Sprintf("✓ App %q is ready to start offline", r.AppName)
+}
+
+func (r prepareAppResult) Data() interface{} {
+	return r
+}
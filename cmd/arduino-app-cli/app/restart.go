@@ -32,6 +32,7 @@ import (
 )
 
 func newRestartCmd(cfg config.Configuration) *cobra.Command {
+	var opts orchestrator.StartAppOptions
 	cmd := &cobra.Command{
 		Use:   "restart app_path",
 		Short: "Restart or Start an Arduino App",
@@ -40,18 +41,20 @@ func newRestartCmd(cfg config.Configuration) *cobra.Command {
 			if len(args) == 0 {
 				return cmd.Help()
 			}
-			appToStart, err := Load(args[0])
+			appToStart, err := Load(args[0], cfg)
 			if err != nil {
 				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
 			}
-			return restartHandler(cmd.Context(), cfg, appToStart)
+			return restartHandler(cmd.Context(), cfg, appToStart, opts)
 		},
 		ValidArgsFunction: completion.ApplicationNames(cfg),
 	}
+	cmd.Flags().BoolVar(&opts.ForceUpload, "force-upload", false, "Compile and upload the sketch even if it did not change since the last upload")
+	cmd.Flags().BoolVar(&opts.Offline, "offline", false, "Never download anything, fail listing what is missing instead")
 	return cmd
 }
 
-func restartHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp) error {
+func restartHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp, opts orchestrator.StartAppOptions) error {
 	out, _, getResult := feedback.OutputStreams()
 
 	stream := orchestrator.RestartApp(
@@ -63,6 +66,7 @@ func restartHandler(ctx context.Context, cfg config.Configuration, app app.Ardui
 		app,
 		cfg,
 		servicelocator.GetStaticStore(),
+		opts,
 	)
 	for message := range stream {
 		switch message.GetType() {
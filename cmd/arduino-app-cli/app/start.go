@@ -18,6 +18,8 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
+	"os/signal"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/text/cases"
@@ -32,28 +34,40 @@ import (
 )
 
 func newStartCmd(cfg config.Configuration) *cobra.Command {
-	return &cobra.Command{
+	var watch bool
+	var opts orchestrator.StartAppOptions
+	cmd := &cobra.Command{
 		Use:   "start app_path",
 		Short: "Start an Arduino App",
-		Args:  cobra.MaximumNArgs(1),
+		Long: "Start an Arduino App.\n\n" +
+			"With --watch, or when the app.yaml sets `dev: true`, the command keeps running after the app is started " +
+			"and restarts only the Python main whenever a file in the python folder changes. " +
+			"Brick containers and the sketch on the micro are not restarted.\n\n" +
+			"With --offline nothing is downloaded: if an image, a model, or a platform or library of the sketch is " +
+			"missing the command fails listing them. Run `app prepare` while online to fetch them.",
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				return cmd.Help()
 			}
-			app, err := Load(args[0])
+			app, err := Load(args[0], cfg)
 			if err != nil {
 				return err
 			}
-			return startHandler(cmd.Context(), cfg, app)
+			return startHandler(cmd.Context(), cfg, app, watch || app.Descriptor.Dev, opts)
 		},
 		ValidArgsFunction: completion.ApplicationNamesWithFilterFunc(cfg, func(apps orchestrator.AppInfo) bool {
 			return apps.Status != orchestrator.StatusStarting &&
 				apps.Status != orchestrator.StatusRunning
 		}),
 	}
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Restart the Python main when the python folder changes")
+	cmd.Flags().BoolVar(&opts.ForceUpload, "force-upload", false, "Compile and upload the sketch even if it did not change since the last upload")
+	cmd.Flags().BoolVar(&opts.Offline, "offline", false, "Never download anything, fail listing what is missing instead")
+	return cmd
 }
 
-func startHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp) error {
+func startHandler(ctx context.Context, cfg config.Configuration, app app.ArduinoApp, watch bool, opts orchestrator.StartAppOptions) error {
 	out, _, getResult := feedback.OutputStreams()
 
 	stream := orchestrator.StartApp(
@@ -65,6 +79,7 @@ func startHandler(ctx context.Context, cfg config.Configuration, app app.Arduino
 		app,
 		cfg,
 		servicelocator.GetStaticStore(),
+		opts,
 	)
 	for message := range stream {
 		switch message.GetType() {
@@ -78,6 +93,27 @@ func startHandler(ctx context.Context, cfg config.Configuration, app app.Arduino
 			return nil
 		}
 	}
+	if watch && app.MainPythonFile != nil {
+		fmt.Fprintf(out, "[INFO] App %q started, press Ctrl+C to stop watching\n", app.Name)
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+		for message := range orchestrator.WatchApp(
+			ctx,
+			servicelocator.GetModelsIndex(),
+			servicelocator.GetBricksIndex(),
+			app,
+			cfg,
+			orchestrator.DefaultWatchDebounce,
+		) {
+			switch message.GetType() {
+			case orchestrator.InfoType:
+				fmt.Fprintln(out, "[INFO]", message.GetData())
+			case orchestrator.ErrorType:
+				feedback.Fatal(fmt.Sprintf("[ERROR] %s", message.GetError().Error()), feedback.ErrGeneric)
+				return nil
+			}
+		}
+	}
 	outputResult := getResult()
 	feedback.PrintResult(startAppResult{
 		AppName: app.Name,
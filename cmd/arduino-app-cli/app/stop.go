@@ -37,7 +37,7 @@ func newStopCmd(cfg config.Configuration) *cobra.Command {
 			if len(args) == 0 {
 				return cmd.Help()
 			}
-			app, err := Load(args[0])
+			app, err := Load(args[0], cfg)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,52 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newUploadCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "upload <app-path>",
+		Short: "Compile and upload the sketch of an app",
+		Long:  "Compile the sketch of an app and upload it to the micro. The Python part of the app is not started nor restarted.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			app, err := Load(args[0], cfg)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+			}
+			out, _, getResult := feedback.OutputStreams()
+			res, err := orchestrator.UploadSketch(cmd.Context(), servicelocator.GetDockerClient(), app, cfg, out)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			printSketchBuildResult(sketchBuildResult{
+				AppName:           app.Name,
+				SketchBuildResult: res,
+				Output:            getResult(),
+			})
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
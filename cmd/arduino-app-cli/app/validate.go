@@ -0,0 +1,90 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newValidateCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate <app-path>",
+		Short: "Check the app for errors",
+		Long:  "Check the app.yaml descriptor and the app folder for errors, such as unknown keys, bricks missing from the index or required variables without values.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			validateHandler(args[0])
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
+
+func validateHandler(idOrPath string) {
+	id, err := servicelocator.GetAppIDProvider().ParseID(idOrPath)
+	if err != nil {
+		feedback.Fatal(fmt.Sprintf("invalid app path: %s", idOrPath), feedback.ErrBadArgument)
+	}
+	res, err := orchestrator.ValidateApp(id.ToPath(), servicelocator.GetBricksIndex(), servicelocator.GetModelsIndex())
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+	}
+	if !res.Valid {
+		feedback.FatalResult(validateResult{AppValidationResult: res}, feedback.ErrGeneric)
+	}
+	feedback.PrintResult(validateResult{AppValidationResult: res})
+}
+
+type validateResult struct {
+	orchestrator.AppValidationResult
+}
+
+func (r validateResult) ErrorString() string {
+	if r.Valid {
+		return ""
+	}
+	return "✗ The app is not valid"
+}
+
+func (r validateResult) String() string {
+	if len(r.Findings) == 0 {
+		return "✓ The app is valid"
+	}
+	b := &strings.Builder{}
+	for _, finding := range r.Findings {
+		location := finding.File
+		if finding.Line > 0 {
+			location = fmt.Sprintf("%s:%d:%d", finding.File, finding.Line, finding.Column)
+		}
+		if location != "" {
+			location += ": "
+		}
+		fmt.Fprintf(b, "%s%s: %s\n", location, finding.Severity, finding.Message)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r validateResult) Data() interface{} {
+	return r.AppValidationResult
+}
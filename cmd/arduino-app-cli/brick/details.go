@@ -42,7 +42,7 @@ func newBricksDetailsCmd(cfg config.Configuration) *cobra.Command {
 }
 
 func bricksDetailsHandler(id string, cfg config.Configuration) {
-	res, err := servicelocator.GetBrickService().BricksDetails(id, servicelocator.GetAppIDProvider(),
+	res, err := servicelocator.GetBrickService().BricksDetails(id, servicelocator.GetAppIDProvider(), nil,
 		cfg)
 	if err != nil {
 		if errors.Is(err, bricks.ErrBrickNotFound) {
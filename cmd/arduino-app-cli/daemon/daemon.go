@@ -29,10 +29,12 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api"
 	"github.com/arduino/arduino-app-cli/internal/httprecover"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/update"
 	"github.com/arduino/arduino-app-cli/internal/update/apt"
 	"github.com/arduino/arduino-app-cli/internal/update/arduino"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
 )
 
 func NewDaemonCmd(cfg config.Configuration, version string) *cobra.Command {
@@ -97,6 +99,15 @@ func httpHandler(ctx context.Context, cfg config.Configuration, daemonPort, vers
 		ResponseHeaders: []string{},
 	}
 
+	appCatalog, err := appcatalog.New(servicelocator.GetAppIDProvider(), cfg)
+	if err != nil {
+		// The apps are looked up walking the app folders.
+		slog.Warn("unable to watch the app folders", slog.String("error", err.Error()))
+	} else {
+		defer appCatalog.Close()
+	}
+	defer arduinocli.Shared().Close()
+
 	apiSrv := api.NewHTTPRouter(
 		servicelocator.GetDockerClient(),
 		version,
@@ -109,6 +120,7 @@ func httpHandler(ctx context.Context, cfg config.Configuration, daemonPort, vers
 		servicelocator.GetModelsIndex(),
 		servicelocator.GetBricksIndex(),
 		servicelocator.GetBrickService(),
+		appCatalog,
 		servicelocator.GetAppIDProvider(),
 		cfg,
 		corsConfig.Origins,
@@ -18,6 +18,7 @@
 package servicelocator
 
 import (
+	"log/slog"
 	"sync"
 
 	dockerCommand "github.com/docker/cli/cli/command"
@@ -46,7 +47,11 @@ var (
 	})
 
 	GetModelsIndex = sync.OnceValue(func() *modelsindex.ModelsIndex {
-		return f.Must(modelsindex.GenerateModelsIndexFromFile(GetStaticStore().GetAssetsFolder()))
+		modelsIndex := f.Must(modelsindex.GenerateModelsIndexFromFile(GetStaticStore().GetAssetsFolder()))
+		if err := modelsIndex.LoadCustomModels(globalConfig.CustomEIModelsDir()); err != nil {
+			slog.Warn("unable to load custom models", slog.String("error", err.Error()))
+		}
+		return modelsIndex
 	})
 
 	GetProvisioner = sync.OnceValue(func() *orchestrator.Provision {
@@ -30,7 +30,9 @@ import (
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/config"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/daemon"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/model"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/properties"
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/sketch"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/system"
 	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/version"
 	"github.com/arduino/arduino-app-cli/cmd/feedback"
@@ -74,8 +76,10 @@ func run(configuration cfg.Configuration) error {
 		brick.NewBrickCmd(configuration),
 		completion.NewCompletionCommand(),
 		daemon.NewDaemonCmd(configuration, Version),
+		model.NewModelCmd(configuration),
 		properties.NewPropertiesCmd(configuration),
 		config.NewConfigCmd(configuration),
+		sketch.NewSketchCmd(configuration),
 		system.NewSystemCmd(configuration),
 		version.NewVersionCmd(Version),
 	)
@@ -0,0 +1,81 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func newModelImportCmd(cfg config.Configuration) *cobra.Command {
+	var req orchestrator.AIModelImportRequest
+	cmd := &cobra.Command{
+		Use:   "import <file.eim>",
+		Short: "Import a user-trained Edge Impulse model",
+		Long: "Import a user-trained Edge Impulse model in the custom models directory.\n" +
+			"The model can then be selected by ID when adding one of its bricks to an app.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req.File = paths.New(args[0])
+			modelImportHandler(req, cfg)
+		},
+	}
+	cmd.Flags().StringVar(&req.ID, "id", "", "Identifier of the model (defaults to the file name)")
+	cmd.Flags().StringVar(&req.Name, "name", "", "Human readable name of the model")
+	cmd.Flags().StringVar(&req.Description, "description", "", "Description of the model")
+	cmd.Flags().StringVar(&req.Runner, "runner", "", "Runner of the model (defaults to brick)")
+	cmd.Flags().StringVar(&req.RunnerImage, "runner-image", "", "Container image required to run the model")
+	cmd.Flags().StringVar(&req.Modality, "modality", "", "Input modality of the model (e.g. image, audio)")
+	cmd.Flags().StringSliceVar(&req.Bricks, "brick", nil, "Brick that can use the model (can be repeated)")
+	cmd.Flags().StringSliceVar(&req.Labels, "label", nil, "Label of the model (can be repeated)")
+	cmd.Flags().IntSliceVar(&req.InputShape, "input-shape", nil, "Input shape of the model (e.g. 96,96,3)")
+	return cmd
+}
+
+func modelImportHandler(req orchestrator.AIModelImportRequest, cfg config.Configuration) {
+	if req.File.NotExist() {
+		feedback.Fatal(fmt.Sprintf("model file %s not found", req.File), feedback.ErrBadArgument)
+	}
+	res, err := orchestrator.AIModelImport(req, servicelocator.GetModelsIndex(), cfg)
+	if err != nil {
+		if errors.Is(err, modelsindex.ErrInvalidModelFile) || errors.Is(err, modelsindex.ErrInvalidModelID) || errors.Is(err, modelsindex.ErrModelIDInUse) {
+			feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		}
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(modelImportResult{Model: res})
+}
+
+type modelImportResult struct {
+	Model orchestrator.AIModelItem `json:"model"`
+}
+
+func (r modelImportResult) String() string {
+	return fmt.Sprintf("✓ Model %q imported", r.Model.ID)
+}
+
+func (r modelImportResult) Data() interface{} {
+	return r
+}
@@ -0,0 +1,68 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package model
+
+import (
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/tablestyle"
+)
+
+func newModelListCmd() *cobra.Command {
+	var req orchestrator.AIModelsListRequest
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all available AI models",
+		Run: func(cmd *cobra.Command, args []string) {
+			res := orchestrator.AIModelsList(req, servicelocator.GetModelsIndex(), servicelocator.GetBricksIndex())
+			feedback.PrintResult(modelListResult{Models: res.Models})
+		},
+	}
+	cmd.Flags().StringSliceVar(&req.FilterByBrickID, "brick", nil, "Show only the models compatible with the given bricks")
+	cmd.Flags().StringVar(&req.FilterByRunner, "runner", "", "Show only the models using the given runner")
+	cmd.Flags().StringVar(&req.FilterByModality, "modality", "", "Show only the models with the given input modality (e.g. image, audio)")
+	return cmd
+}
+
+type modelListResult struct {
+	Models []orchestrator.AIModelItem `json:"models"`
+}
+
+func (r modelListResult) String() string {
+	t := table.NewWriter()
+	t.SetStyle(tablestyle.CustomCleanStyle)
+	t.AppendHeader(table.Row{"ID", "NAME", "SOURCE", "BRICKS"})
+
+	for _, model := range r.Models {
+		t.AppendRow(table.Row{
+			model.ID,
+			model.Name,
+			model.Source,
+			strings.Join(model.Bricks, ", "),
+		})
+	}
+	return t.Render()
+}
+
+func (r modelListResult) Data() interface{} {
+	return r
+}
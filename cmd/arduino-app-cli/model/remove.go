@@ -0,0 +1,62 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func newModelRemoveCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <model-id>",
+		Short: "Remove a custom AI model",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			modelRemoveHandler(args[0], cfg)
+		},
+	}
+}
+
+func modelRemoveHandler(id string, cfg config.Configuration) {
+	if err := orchestrator.AIModelRemove(id, servicelocator.GetModelsIndex(), cfg); err != nil {
+		if errors.Is(err, modelsindex.ErrModelNotFound) || errors.Is(err, modelsindex.ErrModelNotCustom) {
+			feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+		}
+		feedback.Fatal(err.Error(), feedback.ErrGeneric)
+	}
+	feedback.PrintResult(modelRemoveResult{ID: id})
+}
+
+type modelRemoveResult struct {
+	ID string `json:"id"`
+}
+
+func (r modelRemoveResult) String() string {
+	return fmt.Sprintf("✓ Model %q removed", r.ID)
+}
+
+func (r modelRemoveResult) Data() interface{} {
+	return r
+}
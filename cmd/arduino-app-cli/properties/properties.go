@@ -72,7 +72,7 @@ func NewPropertiesCmd(cfg config.Configuration) *cobra.Command {
 				return nil
 			}
 
-			app, err := app.Load(args[1])
+			app, err := app.Load(args[1], cfg)
 			if err != nil {
 				feedback.Fatal(err.Error(), feedback.ErrBadArgument)
 				return nil
@@ -0,0 +1,106 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newLibAddCmd(cfg config.Configuration) *cobra.Command {
+	var addDeps bool
+	cmd := &cobra.Command{
+		Use:   "add <app-path> <name[@version]|folder|file.zip>",
+		Short: "Add a library to the sketch of an app",
+		Long: "Add a library to the sketch of an app, either from the library index or from a folder or a zip archive.\n" +
+			"Zip archives are extracted, and folders outside the app are copied, in the libraries folder of the app.",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			userApp := loadAppWithSketch(args[0], cfg)
+
+			var added []orchestrator.LibraryReleaseID
+			if libPath := localLibraryPath(userApp.FullPath, args[1]); libPath != nil {
+				lib, err := orchestrator.AddSketchLocalLibrary(cmd.Context(), userApp, libPath)
+				if errors.Is(err, orchestrator.ErrInvalidLibrary) {
+					feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+				} else if err != nil {
+					feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				}
+				added = append(added, lib)
+			} else {
+				libRef, err := orchestrator.ParseLibraryReleaseID(args[1])
+				if err != nil {
+					feedback.Fatal(fmt.Sprintf("invalid library reference %q: %s", args[1], err), feedback.ErrBadArgument)
+				}
+				added, err = orchestrator.AddSketchLibrary(cmd.Context(), userApp, libRef, addDeps)
+				if err != nil {
+					feedback.Fatal(err.Error(), feedback.ErrGeneric)
+				}
+			}
+			feedback.PrintResult(libAddResult{Libraries: added})
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+	cmd.Flags().BoolVar(&addDeps, "add-deps", false, "Add the dependencies of a library of the index as well")
+	return cmd
+}
+
+// localLibraryPath returns the path of a library given as a folder or a zip archive, looked up
+// in the current directory first and then in the app folder, or nil for a library of the index.
+func localLibraryPath(appPath *paths.Path, arg string) *paths.Path {
+	if p := paths.New(arg); p.Exist() {
+		if abs, err := p.Abs(); err == nil {
+			return abs
+		}
+		return p
+	}
+	if p := paths.New(arg); !p.IsAbs() && appPath.JoinPath(p).Exist() {
+		return p
+	}
+	if strings.HasSuffix(strings.ToLower(arg), ".zip") {
+		// Let the orchestrator report the missing archive.
+		return paths.New(arg)
+	}
+	return nil
+}
+
+type libAddResult struct {
+	Libraries []orchestrator.LibraryReleaseID `json:"libraries"`
+}
+
+func (r libAddResult) String() string {
+	if len(r.Libraries) == 0 {
+		return "No libraries added, the sketch already uses them"
+	}
+	names := make([]string, len(r.Libraries))
+	for i, l := range r.Libraries {
+		names[i] = l.String()
+	}
+	return "✓ Added " + strings.Join(names, ", ")
+}
+
+func (r libAddResult) Data() interface{} {
+	return r
+}
@@ -0,0 +1,72 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+)
+
+func newLibDepsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deps <name[@version]>",
+		Short: "Preview the dependency tree of a library",
+		Long:  "Preview the libraries, and their versions, that would be added to a sketch along with the given library.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			libRef, err := orchestrator.ParseLibraryReleaseID(args[0])
+			if err != nil {
+				feedback.Fatal(fmt.Sprintf("invalid library reference %q: %s", args[0], err), feedback.ErrBadArgument)
+			}
+			tree, err := orchestrator.GetLibraryDependencyTree(cmd.Context(), libRef)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(libDepsResult{Tree: tree})
+		},
+	}
+}
+
+type libDepsResult struct {
+	Tree orchestrator.LibraryDependencyNode `json:"library"`
+}
+
+func (r libDepsResult) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "%s@%s", r.Tree.Name, r.Tree.Version)
+	writeDependencyTree(b, r.Tree.Dependencies, "")
+	return b.String()
+}
+
+func writeDependencyTree(b *strings.Builder, deps []orchestrator.LibraryDependencyNode, indent string) {
+	for i, dep := range deps {
+		branch, next := "├── ", "│   "
+		if i == len(deps)-1 {
+			branch, next = "└── ", "    "
+		}
+		fmt.Fprintf(b, "\n%s%s%s@%s", indent, branch, dep.Name, dep.Version)
+		writeDependencyTree(b, dep.Dependencies, indent+next)
+	}
+}
+
+func (r libDepsResult) Data() interface{} {
+	return r
+}
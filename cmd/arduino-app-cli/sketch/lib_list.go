@@ -0,0 +1,104 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/tablestyle"
+)
+
+func newLibListCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <app-path>",
+		Short: "List the libraries of the sketch of an app",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			userApp := loadAppWithSketch(args[0], cfg)
+			libs, err := orchestrator.ListSketchLibraries(cmd.Context(), userApp)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(libListResult{Libraries: libs})
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
+
+type libListResult struct {
+	Libraries []orchestrator.LibraryReleaseID `json:"libraries"`
+}
+
+func (r libListResult) String() string {
+	if len(r.Libraries) == 0 {
+		return "The sketch does not use any library"
+	}
+	t := table.NewWriter()
+	t.SetStyle(tablestyle.CustomCleanStyle)
+	t.AppendHeader(table.Row{"NAME", "VERSION", "SOURCE"})
+	for _, lib := range r.Libraries {
+		if lib.Path != "" {
+			t.AppendRow(table.Row{lib.Name, "", lib.Path})
+		} else {
+			t.AppendRow(table.Row{lib.Name, lib.Version, "index"})
+		}
+	}
+	return t.Render()
+}
+
+func (r libListResult) Data() interface{} {
+	return r
+}
+
+func newLibRemoveCmd(cfg config.Configuration) *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <app-path> <name>",
+		Short: "Remove a library from the sketch of an app",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			userApp := loadAppWithSketch(args[0], cfg)
+			libRef, err := orchestrator.ParseLibraryReleaseID(args[1])
+			if err != nil {
+				feedback.Fatal(fmt.Sprintf("invalid library reference %q: %s", args[1], err), feedback.ErrBadArgument)
+			}
+			removed, err := orchestrator.RemoveSketchLibrary(cmd.Context(), userApp, libRef)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(libRemoveResult{Library: removed})
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+}
+
+type libRemoveResult struct {
+	Library orchestrator.LibraryReleaseID `json:"library"`
+}
+
+func (r libRemoveResult) String() string {
+	return "✓ Removed " + r.Library.String()
+}
+
+func (r libRemoveResult) Data() interface{} {
+	return r
+}
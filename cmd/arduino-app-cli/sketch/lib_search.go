@@ -0,0 +1,121 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/tablestyle"
+)
+
+func newLibSearchCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "search [<query>...]",
+		Short: "Search the libraries of the local library index",
+		Long: "Search the libraries of the local library index, so that it works offline.\n" +
+			"The query supports qualifiers such as author:arduino or name=Servo.",
+		Run: func(cmd *cobra.Command, args []string) {
+			libs, err := orchestrator.SearchLibraries(cmd.Context(), strings.Join(args, " "))
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(libSearchResult{Libraries: libs})
+		},
+	}
+}
+
+type libSearchResult struct {
+	Libraries []orchestrator.LibraryInfo `json:"libraries"`
+}
+
+func (r libSearchResult) String() string {
+	if len(r.Libraries) == 0 {
+		return "No libraries found"
+	}
+	t := table.NewWriter()
+	t.SetStyle(tablestyle.CustomCleanStyle)
+	t.AppendHeader(table.Row{"NAME", "VERSION", "AUTHOR", "SENTENCE"})
+	for _, lib := range r.Libraries {
+		t.AppendRow(table.Row{lib.Name, lib.Version, lib.Author, lib.Sentence})
+	}
+	return t.Render()
+}
+
+func (r libSearchResult) Data() interface{} {
+	return r
+}
+
+func newLibInfoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "info <name>",
+		Short: "Show the details of a library of the local library index",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			lib, err := orchestrator.GetLibraryDetails(cmd.Context(), args[0])
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(libInfoResult{LibraryInfo: lib})
+		},
+	}
+}
+
+type libInfoResult struct {
+	orchestrator.LibraryInfo
+}
+
+func (r libInfoResult) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "Name: %s\n", r.Name)
+	fmt.Fprintf(b, "Version: %s\n", r.Version)
+	if r.Author != "" {
+		fmt.Fprintf(b, "Author: %s\n", r.Author)
+	}
+	if r.Maintainer != "" {
+		fmt.Fprintf(b, "Maintainer: %s\n", r.Maintainer)
+	}
+	if r.Sentence != "" {
+		fmt.Fprintf(b, "Sentence: %s\n", r.Sentence)
+	}
+	if r.Website != "" {
+		fmt.Fprintf(b, "Website: %s\n", r.Website)
+	}
+	if r.Category != "" {
+		fmt.Fprintf(b, "Category: %s\n", r.Category)
+	}
+	if len(r.Architectures) > 0 {
+		fmt.Fprintf(b, "Architectures: %s\n", strings.Join(r.Architectures, ", "))
+	}
+	if len(r.Dependencies) > 0 {
+		deps := make([]string, len(r.Dependencies))
+		for i, d := range r.Dependencies {
+			deps[i] = strings.TrimSpace(d.Name + " " + d.VersionConstraint)
+		}
+		fmt.Fprintf(b, "Dependencies: %s\n", strings.Join(deps, ", "))
+	}
+	fmt.Fprintf(b, "Versions: %s", strings.Join(r.Versions, ", "))
+	return b.String()
+}
+
+func (r libInfoResult) Data() interface{} {
+	return r.LibraryInfo
+}
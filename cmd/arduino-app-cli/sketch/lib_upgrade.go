@@ -0,0 +1,78 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/completion"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newLibUpgradeCmd(cfg config.Configuration) *cobra.Command {
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "upgrade <app-path>",
+		Short: "Upgrade all the libraries of the sketch of an app",
+		Long:  "Upgrade every library of the sketch of an app to its latest version, showing the version changes in sketch.yaml.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			userApp := loadAppWithSketch(args[0], cfg)
+			upgrades, err := orchestrator.UpgradeSketchLibraries(cmd.Context(), userApp, dryRun)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(libUpgradeResult{Upgrades: upgrades, DryRun: dryRun})
+		},
+		ValidArgsFunction: completion.ApplicationNames(cfg),
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the version changes, without altering sketch.yaml")
+	return cmd
+}
+
+type libUpgradeResult struct {
+	Upgrades []orchestrator.LibraryUpgrade `json:"upgrades"`
+	DryRun   bool                          `json:"dry_run,omitempty"`
+}
+
+func (r libUpgradeResult) String() string {
+	if len(r.Upgrades) == 0 {
+		return "All the sketch libraries are up to date"
+	}
+	b := &strings.Builder{}
+	for _, u := range r.Upgrades {
+		if u.FromVersion == "" {
+			fmt.Fprintf(b, "%s: %s (new dependency)\n", u.Name, u.ToVersion)
+			continue
+		}
+		fmt.Fprintf(b, "%s: %s → %s\n", u.Name, u.FromVersion, u.ToVersion)
+	}
+	if r.DryRun {
+		fmt.Fprintf(b, "%d libraries would be upgraded", len(r.Upgrades))
+	} else {
+		fmt.Fprintf(b, "✓ %d libraries upgraded", len(r.Upgrades))
+	}
+	return b.String()
+}
+
+func (r libUpgradeResult) Data() interface{} {
+	return r
+}
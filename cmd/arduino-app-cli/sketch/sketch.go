@@ -0,0 +1,65 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package sketch
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/app"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	arduinoApp "github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func NewSketchCmd(cfg config.Configuration) *cobra.Command {
+	sketchCmd := &cobra.Command{
+		Use:   "sketch",
+		Short: "Manage the sketches of Arduino Apps",
+	}
+
+	sketchCmd.AddCommand(newLibCmd(cfg))
+
+	return sketchCmd
+}
+
+func newLibCmd(cfg config.Configuration) *cobra.Command {
+	libCmd := &cobra.Command{
+		Use:   "lib",
+		Short: "Manage the libraries of the sketches",
+	}
+
+	libCmd.AddCommand(newLibListCmd(cfg))
+	libCmd.AddCommand(newLibAddCmd(cfg))
+	libCmd.AddCommand(newLibRemoveCmd(cfg))
+	libCmd.AddCommand(newLibSearchCmd())
+	libCmd.AddCommand(newLibInfoCmd())
+	libCmd.AddCommand(newLibDepsCmd())
+	libCmd.AddCommand(newLibUpgradeCmd(cfg))
+
+	return libCmd
+}
+
+func loadAppWithSketch(idOrPath string, cfg config.Configuration) arduinoApp.ArduinoApp {
+	userApp, err := app.Load(idOrPath, cfg)
+	if err != nil {
+		feedback.Fatal(err.Error(), feedback.ErrBadArgument)
+	}
+	if userApp.MainSketchPath == nil {
+		feedback.Fatal(orchestrator.ErrAppHasNoSketch.Error(), feedback.ErrBadArgument)
+	}
+	return userApp
+}
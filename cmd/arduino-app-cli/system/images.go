@@ -0,0 +1,138 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/spf13/cobra"
+
+	"github.com/arduino/arduino-app-cli/cmd/arduino-app-cli/internal/servicelocator"
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func newImagesCmd(cfg config.Configuration) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "images",
+		Short: "Move the container images between boards without internet access",
+	}
+	cmd.AddCommand(newImagesExportCmd(cfg))
+	cmd.AddCommand(newImagesImportCmd(cfg))
+	return cmd
+}
+
+func newImagesExportCmd(cfg config.Configuration) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Save the images required by this version into a bundle",
+		Long: "Save the python base image and the images of the bricks into a bundle, " +
+			"to be imported with `system images import` on boards without internet access.",
+		Args: cobra.ExactArgs(0),
+		Run: func(cmd *cobra.Command, _ []string) {
+			result, err := orchestrator.SystemExportImages(
+				cmd.Context(), cfg, servicelocator.GetStaticStore(), servicelocator.GetDockerClient().Client(), paths.New(output),
+			)
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(imagesExportResult{Bundle: output, ExportImagesResult: result})
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Path of the bundle to create")
+	_ = cmd.MarkFlagRequired("output")
+	return cmd
+}
+
+func newImagesImportCmd(cfg config.Configuration) *cobra.Command {
+	var force bool
+	cmd := &cobra.Command{
+		Use:   "import <bundle>",
+		Short: "Load the images of a bundle created by `system images export`",
+		Long: "Load the images of a bundle created by `system images export`. The digests of the bundle are " +
+			"verified before loading it, and bundles with images not required by this version are rejected. " +
+			"Installed images with the same tag as a different bundled image are replaced only with --force. " +
+			"Then the images required by this version that are still missing are reported.",
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			result, err := orchestrator.SystemImportImages(
+				cmd.Context(), cfg, servicelocator.GetStaticStore(), servicelocator.GetDockerClient().Client(),
+				orchestrator.ImportImagesRequest{Bundle: paths.New(args[0]), Force: force},
+			)
+			var replacedErr *orchestrator.ReplacedImagesError
+			if errors.As(err, &replacedErr) {
+				feedback.Fatal(err.Error()+", use --force to replace them", feedback.ErrBadArgument)
+			}
+			if err != nil {
+				feedback.Fatal(err.Error(), feedback.ErrGeneric)
+			}
+			feedback.PrintResult(imagesImportResult(result))
+		},
+	}
+	cmd.Flags().BoolVar(&force, "force", false, "Replace the installed images having the same tag as a different bundled image")
+	return cmd
+}
+
+type imagesExportResult struct {
+	Bundle string `json:"bundle"`
+	orchestrator.ExportImagesResult
+}
+
+func (r imagesExportResult) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "✓ %d images saved to %s\n", len(r.Exported), r.Bundle)
+	for _, image := range r.Exported {
+		fmt.Fprintf(b, "  - %s\n", image)
+	}
+	writeImageList(b, "Not available on this board, so not in the bundle:", r.Missing)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r imagesExportResult) Data() interface{} {
+	return r
+}
+
+type imagesImportResult orchestrator.ImportImagesResult
+
+func (r imagesImportResult) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "✓ %d images imported\n", len(r.Imported))
+	for _, image := range r.Imported {
+		fmt.Fprintf(b, "  - %s\n", image)
+	}
+	writeImageList(b, "Replaced a different image already installed:", r.Replaced)
+	writeImageList(b, "Still missing:", r.Missing)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (r imagesImportResult) Data() interface{} {
+	return r
+}
+
+func writeImageList(b *strings.Builder, title string, images []string) {
+	if len(images) == 0 {
+		return
+	}
+	fmt.Fprintln(b, title)
+	for _, image := range images {
+		fmt.Fprintf(b, "  - %s\n", image)
+	}
+}
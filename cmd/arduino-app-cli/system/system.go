@@ -43,6 +43,7 @@ func NewSystemCmd(cfg config.Configuration) *cobra.Command {
 	cmd.AddCommand(newDownloadImageCmd(cfg))
 	cmd.AddCommand(newUpdateCmd())
 	cmd.AddCommand(newCleanUpCmd(cfg, servicelocator.GetDockerClient()))
+	cmd.AddCommand(newImagesCmd(cfg))
 	cmd.AddCommand(newNetworkModeCmd())
 	cmd.AddCommand(newKeyboardSetCmd())
 	cmd.AddCommand(newBoardSetNameCmd())
@@ -143,35 +144,121 @@ func getFilterFunc(onlyArduino bool) func(p update.UpgradablePackage) bool {
 }
 
 func newCleanUpCmd(cfg config.Configuration, docker command.Cli) *cobra.Command {
+	var dryRun bool
+	var targets []string
 	cmd := &cobra.Command{
 		Use:   "cleanup",
-		Short: "Removes unused and obsolete application images to free up disk space.",
-		Args:  cobra.ExactArgs(0),
+		Short: "Removes unused and obsolete containers, images and models to free up disk space.",
+		Long: "Removes unused and obsolete containers, images and models to free up disk space.\n" +
+			"Use --target to choose what to clean up, including caches, volumes and logs, and --dry-run to list what would be removed.\n" +
+			"Targets: " + strings.Join(cleanupTargetNames(), ", "),
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			staticStore := servicelocator.GetStaticStore()
+			req := orchestrator.SystemCleanupRequest{DryRun: dryRun}
+			for _, target := range targets {
+				if !slices.Contains(orchestrator.CleanupTargets, orchestrator.CleanupTarget(target)) {
+					feedback.Fatal(
+						fmt.Sprintf("invalid cleanup target %q, valid targets are: %s", target, strings.Join(cleanupTargetNames(), ", ")),
+						feedback.ErrBadArgument,
+					)
+				}
+				req.Targets = append(req.Targets, orchestrator.CleanupTarget(target))
+			}
 
-			feedback.Printf("Running cleanup...")
-			result, err := orchestrator.SystemCleanup(cmd.Context(), cfg, staticStore, docker)
+			if !dryRun {
+				feedback.Printf("Running cleanup...")
+			}
+			result, err := orchestrator.SystemCleanup(
+				cmd.Context(),
+				cfg,
+				servicelocator.GetStaticStore(),
+				servicelocator.GetModelsIndex(),
+				docker,
+				req,
+			)
 			if err != nil {
 				return err
 			}
+			feedback.PrintResult(cleanupResult(result))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List what would be removed, without removing it")
+	cmd.Flags().StringSliceVar(&targets, "target", nil, "What to clean up (can be repeated), containers, images and models when not set")
+	return cmd
+}
 
-			if result.IsEmpty() {
-				feedback.Print("Nothing to clean up.")
-				return nil
+func cleanupTargetNames() []string {
+	names := make([]string, 0, len(orchestrator.CleanupTargets))
+	for _, target := range orchestrator.CleanupTargets {
+		names = append(names, string(target))
+	}
+	return names
+}
+
+type cleanupResult orchestrator.SystemCleanupResult
+
+func (r cleanupResult) String() string {
+	if len(r.Items) == 0 {
+		return "Nothing to clean up."
+	}
+
+	b := &strings.Builder{}
+	if r.DryRun {
+		fmt.Fprintf(b, "Would free up %v%s:\n", helpers.ToHumanMiB(r.SpaceFreed), sharedSpaceNote(r.SharedSpace))
+		for _, target := range orchestrator.CleanupTargets {
+			items := slices.DeleteFunc(slices.Clone(r.Items), func(item orchestrator.CleanupItem) bool { return item.Target != target })
+			if len(items) == 0 {
+				continue
 			}
+			fmt.Fprintf(b, "%s:\n", target)
+			for _, item := range items {
+				if item.SharedSize > 0 {
+					fmt.Fprintf(b, "  - %s (%v, %v shared)\n", item.Name, helpers.ToHumanMiB(item.Size), helpers.ToHumanMiB(item.SharedSize))
+				} else if item.Size > 0 {
+					fmt.Fprintf(b, "  - %s (%v)\n", item.Name, helpers.ToHumanMiB(item.Size))
+				} else {
+					fmt.Fprintf(b, "  - %s\n", item.Name)
+				}
+			}
+		}
+		return strings.TrimSuffix(b.String(), "\n")
+	}
 
-			feedback.Print("Cleanup successful.")
-			feedback.Print("Freed up")
-			if result.RunningAppRemoved {
-				feedback.Print("  - 1 running app")
+	b.WriteString("Cleanup successful.\n")
+	fmt.Fprintf(b, "Freed up %v%s\n", helpers.ToHumanMiB(r.SpaceFreed), sharedSpaceNote(r.SharedSpace))
+	for _, target := range orchestrator.CleanupTargets {
+		var count int
+		var size int64
+		for _, item := range r.Items {
+			if item.Target == target {
+				count++
+				size += item.Size
 			}
-			feedback.Printf("  - %d containers", result.ContainersRemoved)
-			feedback.Printf("  - %d images (%v)", result.ImagesRemoved, helpers.ToHumanMiB(result.SpaceFreed))
-			return nil
-		},
+		}
+		if count == 0 {
+			continue
+		}
+		if size > 0 {
+			fmt.Fprintf(b, "  - %d %s (%v)\n", count, target, helpers.ToHumanMiB(size))
+		} else {
+			fmt.Fprintf(b, "  - %d %s\n", count, target)
+		}
 	}
-	return cmd
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// sharedSpaceNote describes the size of the image layers shared with other images, that are freed
+// only if all the images sharing them are removed.
+func sharedSpaceNote(sharedSpace int64) string {
+	if sharedSpace <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(", plus up to %v of layers shared with other images", helpers.ToHumanMiB(sharedSpace))
+}
+
+func (r cleanupResult) Data() interface{} {
+	return r
 }
 
 func newNetworkModeCmd() *cobra.Command {
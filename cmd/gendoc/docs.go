@@ -33,6 +33,7 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
 	"github.com/arduino/arduino-app-cli/internal/update"
 )
@@ -46,9 +47,10 @@ const (
 	SystemTag      Tag = "System"
 	Property       Tag = "Property"
 	LibrariesTag   Tag = "Libraries"
+	SchemaTag      Tag = "Schema"
 )
 
-var validTags = []Tag{ApplicationTag, BrickTag, AIModelsTag, SystemTag, LibrariesTag}
+var validTags = []Tag{ApplicationTag, BrickTag, AIModelsTag, SystemTag, LibrariesTag, SchemaTag}
 
 type Generator struct {
 	reflector *openapi3.Reflector
@@ -267,6 +269,41 @@ type ErrorResponse struct {
 func (g *Generator) InitOperations() {
 
 	operations := []OperationConfig{
+		{
+			OperationId: "listSchemas",
+			Method:      http.MethodGet,
+			Path:        "/v1/schemas",
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: models.SchemaListResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Return the names of the JSON Schemas describing app.yaml, bricks and models files.",
+			Summary:     "List JSON schemas",
+			Tags:        []Tag{SchemaTag},
+		},
+		{
+			OperationId: "getSchema",
+			Method:      http.MethodGet,
+			Path:        "/v1/schemas/{name}",
+			Parameters: (*struct {
+				Name string `path:"name" description:"schema name, e.g. app, brick or models-list."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/schema+json",
+				DataStructure: map[string]any{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Return the JSON Schema with the given name.",
+			Summary:     "Get JSON schema",
+			Tags:        []Tag{SchemaTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "DeleteProperty",
 			Method:      http.MethodDelete,
@@ -372,6 +409,48 @@ func (g *Generator) InitOperations() {
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "getAppCompatibility",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/{appID}/compatibility",
+			Request: (*struct {
+				ID string `path:"appID" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AppCompatibilityResult{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Reports whether the devices attached to the board satisfy the required devices of the app and of its bricks. Each missing device comes with a human-readable hint.",
+			Summary:     "Get app hardware compatibility",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "validateApp",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{appID}/validate",
+			Request: (*struct {
+				ID string `path:"appID" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AppValidationResult{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Checks the app descriptor and the app folder for errors, such as unknown keys, duplicate bricks, bricks missing from the index, required variables without values, models not valid for a brick, port collisions, unknown device classes and missing main files. Each finding reports the line and column in app.yaml.",
+			Summary:     "Validate an app",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+			},
+		},
 		{
 			OperationId: "deleteApp",
 			Method:      http.MethodDelete,
@@ -417,6 +496,123 @@ func (g *Generator) InitOperations() {
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "commitApp",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{id}/commit",
+			Request:     handlers.CommitAppRequest{},
+			Parameters: (*struct {
+				ID string `path:"id" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AppGitCommit{},
+				Description:   "The created commit",
+				StatusCode:    http.StatusCreated,
+			},
+			Description: "Stage all the changes of the app folder and commit them in the git repository containing the app.",
+			Summary:     "Commit the app changes",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusConflict, Reference: "#/components/responses/Conflict"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "listAppSnapshots",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/{id}/snapshots",
+			Parameters: (*struct {
+				ID string `path:"id" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: models.AppSnapshotListResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "List the snapshots of the app, the most recent first. A snapshot of app.yaml, sketch/sketch.yaml and of the python folder is taken before every change to the app made through the API.",
+			Summary:     "List the app snapshots",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "restoreAppSnapshot",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{id}/snapshots/{snapshotID}/restore",
+			Parameters: (*struct {
+				ID         string `path:"id" description:"application identifier."`
+				SnapshotID string `path:"snapshotID" description:"snapshot identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AppSnapshot{},
+				Description:   "The restored snapshot",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Restore the app files saved in the snapshot. The current state of the app is snapshotted before, so that the restore can be undone.",
+			Summary:     "Restore an app snapshot",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "exportApp",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/{id}/export",
+			Parameters: (*struct {
+				ID          string `path:"id" description:"application identifier."`
+				Format      string `query:"format" enum:"zip,tar.gz" description:"bundle format, zip by default."`
+				IncludeData bool   `query:"include_data" description:"include the data folder of the app."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/octet-stream",
+				DataStructure: []byte{},
+				Description:   "The app bundle",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Export the app as a bundle containing the app folder, without the .cache folder, and a manifest listing the bricks, models and sketch libraries it needs.",
+			Summary:     "Export an app",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "importApp",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/import",
+			Parameters: (*struct {
+				Name  string `query:"name" description:"name of the imported app, the one of the bundle by default."`
+				Force bool   `query:"force" description:"import the app even if some of its bricks or models are not available."`
+			})(nil),
+			Request: []byte{},
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.ImportAppResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusCreated,
+			},
+			Description: "Import an app from a zip or tar.gz bundle created by the export. The bricks and models listed in the bundle manifest must be available.",
+			Summary:     "Import an app",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusConflict, Reference: "#/components/responses/Conflict"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "stopApp",
 			Method:      http.MethodPost,
@@ -459,9 +655,12 @@ Contains a JSON object with the details of an error.
 			Method:      http.MethodPost,
 			Path:        "/v1/apps/{id}/start",
 			Request: (*struct {
-				ID string `path:"id" description:"application identifier."`
+				ID          string `path:"id" description:"application identifier."`
+				Watch       bool   `query:"watch" description:"keep the stream open and restart the Python main when the python folder changes. Must be explicitly requested, also for apps with the dev flag."`
+				ForceUpload bool   `query:"force_upload" description:"compile and upload the sketch even if it did not change since the last upload."`
+				Offline     bool   `query:"offline" description:"never download anything: if an image, a model, or a platform or library of the sketch is missing the start fails with an error listing them."`
 			})(nil),
-			Description: "Start the application and handles all the operation to start any dependecies. If the app contains a sketch it also flash it in the micro.",
+			Description: "Start the application and handles all the operation to start any dependecies. If the app contains a sketch it also flash it in the micro, unless the sketch, its sketch.yaml and its libraries did not change since the last successful upload. In watch mode, once the app is started, the stream stays open and every change to the python folder restarts only the main service, leaving the bricks and the micro untouched.",
 			Summary:     "Start an existing app/example",
 			Tags:        []Tag{ApplicationTag},
 			CustomSuccessResponse: &CustomResponseDef{
@@ -480,6 +679,43 @@ Contains a JSON object with an informational message.
 'event: message'
 'data: {"message":"Starting container..."}'
 
+**Event 'error'**:
+Contains a JSON object with the details of an error.
+'event: error'
+'data: {"code":"INTERNAL_SERVER_ERROR","message":"An error occurred during operation"}'
+`,
+			},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "prepareApp",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{id}/prepare",
+			Request: (*struct {
+				ID string `path:"id" description:"application identifier."`
+			})(nil),
+			Description: "Download everything the application needs to start offline: the container images, the models, and the platforms, tools and libraries of the sketch profile.",
+			Summary:     "Prepare an app to start offline",
+			Tags:        []Tag{ApplicationTag},
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "text/event-stream",
+				DataStructure: "",
+				Description: `A stream of Server-Sent Events (SSE) that notifies the progress.
+The client will receive events formatted as follows:
+
+**Event 'progress'**:
+Contains a JSON object with the percentage of completion.
+'event: progress'
+'data: {"progress":0.25}'
+
+**Event 'message'**:
+Contains a JSON object with an informational message.
+'event: message'
+'data: {"message":"Pulling images..."}'
+
 **Event 'error'**:
 Contains a JSON object with the details of an error.
 'event: error'
@@ -562,6 +798,38 @@ Contains a JSON object with the details of an error.
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "getAppsChanges",
+			Method:      http.MethodGet,
+			Path:        "/v1/apps/changes",
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "text/event-stream",
+				DataStructure: appcatalog.Event{},
+			},
+			Description: `A stream of Server-Sent Events (SSE) that notifies the apps created, deleted or changed on disk.
+The client will receive events formatted as follows:
+
+**Event 'created'** and **Event 'deleted'**:
+Contains the identifier of the app added to or removed from the app folders.
+'event: created'
+'data: {"type":"created","id":"dXNlcjpteS1hcHA"}'
+
+**Event 'descriptor-changed'**:
+Contains the identifier of the app whose app.yaml changed.
+'event: descriptor-changed'
+'data: {"type":"descriptor-changed","id":"dXNlcjpteS1hcHA"}'
+
+**Event 'sketch-changed'**:
+Contains the identifier of the app whose sketch changed.
+'event: sketch-changed'
+'data: {"type":"sketch-changed","id":"dXNlcjpteS1hcHA"}'
+`,
+			Summary: "Get application changes",
+			Tags:    []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "getAppEvents",
 			Method:      http.MethodGet,
@@ -618,7 +886,7 @@ Contains a JSON object with the details of an error.
 				Description:   "Successful response",
 				StatusCode:    http.StatusCreated,
 			},
-			Description: "Creates a new app in the default app location.",
+			Description: "Creates a new app in the default app location, optionally starting from a template.",
 			Summary:     "Creates a new app",
 			Tags:        []Tag{ApplicationTag},
 			PossibleErrors: []ErrorResponse{
@@ -674,7 +942,9 @@ Contains a JSON object with the details of an error.
 			OperationId: "getBricks",
 			Method:      http.MethodGet,
 			Path:        "/v1/bricks",
-			Request:     nil,
+			Request: (*struct {
+				Compatible bool `query:"compatible" description:"If true, reports for each brick whether the devices attached to the board satisfy its required devices."`
+			})(nil),
 			CustomSuccessResponse: &CustomResponseDef{
 				ContentType:   "application/json",
 				DataStructure: bricks.BrickListResult{},
@@ -729,7 +999,9 @@ Contains a JSON object with the details of an error.
 			Method:      http.MethodGet,
 			Path:        "/v1/models",
 			Request: (*struct {
-				Bricks string `query:"bricks" description:"Filter models by bricks. If not specified, all models are returned."`
+				Bricks   string `query:"bricks" description:"Filter models by bricks. Only models compatible with at least one of the bricks are returned. If not specified, all models are returned."`
+				Runner   string `query:"runner" description:"Filter models by runner."`
+				Modality string `query:"modality" description:"Filter models by input modality (e.g. image, audio)."`
 			})(nil),
 			CustomSuccessResponse: &CustomResponseDef{
 				ContentType:   "application/json",
@@ -737,7 +1009,7 @@ Contains a JSON object with the details of an error.
 				Description:   "Successful response",
 				StatusCode:    http.StatusOK,
 			},
-			Description: "Returns the list of AI models available in the system. It is possible to filter the models by bricks.",
+			Description: "Returns the list of AI models available in the system. It is possible to filter the models by bricks, runner and input modality.",
 			Summary:     "Get a list of available AI models",
 			Tags:        []Tag{AIModelsTag},
 			PossibleErrors: []ErrorResponse{
@@ -764,6 +1036,57 @@ Contains a JSON object with the details of an error.
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "importAIModel",
+			Method:      http.MethodPut,
+			Path:        "/v1/models/{id}",
+			Parameters: (*struct {
+				ID          string `path:"id" description:"identifier of the custom AI model."`
+				Name        string `query:"name" description:"Human readable name of the model. Defaults to the identifier."`
+				Description string `query:"description" description:"Description of the model."`
+				Runner      string `query:"runner" description:"Runner of the model. Defaults to brick."`
+				RunnerImage string `query:"runner_image" description:"Container image required to run the model."`
+				Modality    string `query:"input_modality" description:"Input modality of the model (e.g. image, audio)."`
+				Bricks      string `query:"bricks" description:"Comma separated list of the bricks that can use the model."`
+				Labels      string `query:"labels" description:"Comma separated list of the labels of the model."`
+				InputShape  string `query:"input_shape" description:"Comma separated input shape of the model (e.g. 96,96,3)."`
+			})(nil),
+			Request: []byte{},
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.AIModelItem{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusCreated,
+			},
+			Description: "Imports a user-trained Edge Impulse model (.eim file sent as the request body) in the custom models directory. The model becomes selectable by the bricks it declares.",
+			Summary:     "Import a custom AI model",
+			Tags:        []Tag{AIModelsTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusConflict, Reference: "#/components/responses/Conflict"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "removeAIModel",
+			Method:      http.MethodDelete,
+			Path:        "/v1/models/{id}",
+			Parameters: (*struct {
+				ID string `path:"id" description:"identifier of the custom AI model."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				Description: "Successful response",
+				StatusCode:  http.StatusOK,
+			},
+			Description: "Removes a custom AI model. Builtin models cannot be removed.",
+			Summary:     "Remove a custom AI model",
+			Tags:        []Tag{AIModelsTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "getSystemResources",
 			Method:      http.MethodGet,
@@ -1013,6 +1336,96 @@ Contains a JSON object with the details of an error.
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "getLibraryDetails",
+			Method:      http.MethodGet,
+			Path:        "/v1/libraries/{name}",
+			Parameters: (*struct {
+				Name string `path:"name" description:"name of the library."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.LibraryInfo{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Returns the details of a library, as of its latest release, along with all its available versions. The local library index is used, so it works offline.",
+			Summary:     "Get library details",
+			Tags:        []Tag{LibrariesTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "getLibraryDependencies",
+			Method:      http.MethodGet,
+			Path:        "/v1/libraries/{name}/dependencies",
+			Parameters: (*struct {
+				Name    string `path:"name" description:"name of the library."`
+				Version string `query:"version" description:"version of the library, defaults to the latest."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.LibraryDependencyNode{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Previews the dependency tree of a library release, with the versions that would be added to a sketch along with the library.",
+			Summary:     "Preview library dependencies",
+			Tags:        []Tag{LibrariesTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusNotFound, Reference: "#/components/responses/NotFound"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "appSketchAddLocalLibrary",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{appID}/sketch/libraries/local",
+			Parameters: (*struct {
+				ID   string `path:"appID" description:"application identifier."`
+				Name string `query:"name" description:"name of the library folder, used when the archive does not contain a single folder."`
+			})(nil),
+			Request: []byte{},
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: handlers.SketchAddLibraryResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusCreated,
+			},
+			Description: "Adds to the App' sketch a library that is not in the library index, uploaded as a zip archive in the request body. The archive is extracted in the libraries folder of the app and the library is referenced by its folder in the sketch project file.",
+			Summary:     "Adds a local library to the App' sketch.",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
+		{
+			OperationId: "appSketchUpgradeLibraries",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{appID}/sketch/libraries/upgrade",
+			Parameters: (*struct {
+				ID     string `path:"appID" description:"application identifier."`
+				DryRun bool   `query:"dry_run" description:"if true, only reports the version changes without altering the sketch project file."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: handlers.SketchUpgradeLibrariesResponse{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Upgrades every library of the App' sketch to its latest version, reporting the version changes in the sketch project file.",
+			Summary:     "Upgrades the libraries of the App' sketch.",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 		{
 			OperationId: "appSketchAddLibrary",
 			Method:      http.MethodPut,
@@ -1073,7 +1486,7 @@ Contains a JSON object with the details of an error.
 				Description:   "Successful response",
 				StatusCode:    http.StatusOK,
 			},
-			Description: "Lists the libraries used in the App' sketch.",
+			Description: "Lists the libraries used in the App' sketch. Libraries not in the library index are reported as \"LibraryName@dir:path\", with the path relative to the sketch.",
 			Summary:     "Lists the libraries used in the App' sketch.",
 			Tags:        []Tag{ApplicationTag},
 			PossibleErrors: []ErrorResponse{
@@ -1082,6 +1495,28 @@ Contains a JSON object with the details of an error.
 				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
 			},
 		},
+		{
+			OperationId: "appSketchBuild",
+			Method:      http.MethodPost,
+			Path:        "/v1/apps/{appID}/sketch/build",
+			Parameters: (*struct {
+				ID string `path:"appID" description:"application identifier."`
+			})(nil),
+			CustomSuccessResponse: &CustomResponseDef{
+				ContentType:   "application/json",
+				DataStructure: orchestrator.SketchBuildResult{},
+				Description:   "Successful response",
+				StatusCode:    http.StatusOK,
+			},
+			Description: "Compiles the App' sketch without uploading it. A compilation failure is not an error: the response reports it with success set to false, along with the compiler diagnostics (file, line, column, severity and message). On success the size of the binary sections is reported too.",
+			Summary:     "Compile the App' sketch.",
+			Tags:        []Tag{ApplicationTag},
+			PossibleErrors: []ErrorResponse{
+				{StatusCode: http.StatusPreconditionFailed, Reference: "#/components/responses/PreconditionFailed"},
+				{StatusCode: http.StatusBadRequest, Reference: "#/components/responses/BadRequest"},
+				{StatusCode: http.StatusInternalServerError, Reference: "#/components/responses/InternalServerError"},
+			},
+		},
 	}
 
 	for _, op := range operations {
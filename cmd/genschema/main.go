@@ -0,0 +1,142 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/swaggest/jsonschema-go"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/schema"
+)
+
+// modelsList mirrors the layout of the models-list.yaml file.
+type modelsList struct {
+	Models []map[string]modelsindex.AIModel `yaml:"models" required:"true"`
+}
+
+func main() {
+	if err := RunGenSchema("internal/schema/schemas"); err != nil {
+		panic(err)
+	}
+}
+
+func RunGenSchema(outputDir string) error {
+	schemas := map[string]struct {
+		value       any
+		title       string
+		description string
+	}{
+		schema.App: {
+			value:       app.AppDescriptor{},
+			title:       "Arduino App",
+			description: "The app.yaml descriptor of an Arduino App.",
+		},
+		schema.Brick: {
+			value:       bricksindex.Brick{},
+			title:       "Arduino App Brick",
+			description: "A brick of the bricks-list.yaml index.",
+		},
+		schema.ModelsList: {
+			value:       modelsList{},
+			title:       "Arduino App Models List",
+			description: "The models-list.yaml index of the AI models.",
+		},
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+	for name, s := range schemas {
+		reflector := jsonschema.Reflector{}
+		sc, err := reflector.Reflect(s.value,
+			jsonschema.PropertyNameTag("yaml"),
+			jsonschema.InlineRefs,
+			jsonschema.InterceptSchema(interceptSchema(reflect.TypeOf(s.value))),
+		)
+		if err != nil {
+			return fmt.Errorf("cannot generate %s schema: %w", name, err)
+		}
+		sc.WithSchema("https://json-schema.org/draft/2020-12/schema")
+		sc.WithTitle(s.title)
+		sc.WithDescription(s.description)
+
+		out, err := json.MarshalIndent(sc, "", "  ")
+		if err != nil {
+			return err
+		}
+		outputPath := filepath.Join(outputDir, schema.FileName(name))
+		if err := os.WriteFile(outputPath, append(out, '\n'), 0600); err != nil {
+			return err
+		}
+		fmt.Printf("JSON schema generated and stored on path: %q\n", outputPath)
+	}
+	return nil
+}
+
+// stringType accepts any scalar, as scalar values are decoded as strings.
+var stringType = jsonschema.Type{SliceOfSimpleTypeValues: []jsonschema.SimpleType{
+	jsonschema.String, jsonschema.Number, jsonschema.Boolean, jsonschema.Null,
+}}
+
+// interceptSchema adapts the reflected schemas to the way the YAML files are decoded.
+func interceptSchema(root reflect.Type) jsonschema.InterceptSchemaFunc {
+	return func(params jsonschema.InterceptSchemaParams) (stop bool, err error) {
+		if !params.Processed {
+			return false, nil
+		}
+		switch params.Value.Type() {
+		case root:
+			return false, nil
+		case reflect.TypeOf(app.Brick{}):
+			// A brick of an app is either its id or a single-key map from the id to its configuration.
+			config := *params.Schema
+			config.Type = &jsonschema.Type{SimpleTypes: f.Ptr(jsonschema.Object)}
+			*params.Schema = jsonschema.Schema{}
+			params.Schema.WithOneOf(
+				jsonschema.String.ToSchemaOrBool(),
+				(&jsonschema.Schema{}).
+					WithType(jsonschema.Object.Type()).
+					WithMinProperties(1).
+					WithMaxProperties(1).
+					WithAdditionalProperties((&jsonschema.Schema{}).
+						WithOneOf(jsonschema.Null.ToSchemaOrBool(), config.ToSchemaOrBool()).
+						ToSchemaOrBool()).
+					ToSchemaOrBool(),
+			)
+			return true, nil
+		case reflect.TypeOf(map[string]string{}):
+			params.Schema.WithAdditionalProperties((&jsonschema.Schema{}).WithType(stringType).ToSchemaOrBool())
+		}
+		if params.Value.Kind() == reflect.String {
+			params.Schema.WithType(stringType)
+			return false, nil
+		}
+		// Null values are decoded as the zero value.
+		if t := params.Schema.Type; t != nil && t.SimpleTypes != nil {
+			params.Schema.Type = &jsonschema.Type{SliceOfSimpleTypeValues: []jsonschema.SimpleType{*t.SimpleTypes, jsonschema.Null}}
+		}
+		return false, nil
+	}
+}
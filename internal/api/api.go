@@ -22,6 +22,7 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/handlers"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
@@ -46,6 +47,7 @@ func NewHTTPRouter(
 	modelsIndex *modelsindex.ModelsIndex,
 	bricksIndex *bricksindex.BricksIndex,
 	brickService *bricks.Service,
+	appCatalog *appcatalog.Catalog,
 	idProvider *app.IDProvider,
 	cfg config.Configuration,
 	allowedOrigins []string,
@@ -56,7 +58,7 @@ func NewHTTPRouter(
 	mux.Handle("GET /v1/version", handlers.HandlerVersion(version))
 	mux.Handle("GET /v1/config", handlers.HandleConfig(cfg))
 	mux.Handle("GET /v1/bricks", handlers.HandleBrickList(brickService))
-	mux.Handle("GET /v1/bricks/{brickID}", handlers.HandleBrickDetails(brickService, idProvider, cfg))
+	mux.Handle("GET /v1/bricks/{brickID}", handlers.HandleBrickDetails(brickService, idProvider, appCatalog, cfg))
 
 	mux.Handle("GET /v1/properties", handlers.HandlePropertyKeys(cfg))
 	mux.Handle("GET /v1/properties/{key}", handlers.HandlePropertyGet(cfg))
@@ -68,36 +70,55 @@ func NewHTTPRouter(
 	mux.Handle("PUT /v1/system/update/apply", handlers.HandleUpdateApply(updater))
 	mux.Handle("GET /v1/system/resources", handlers.HandleSystemResources())
 
-	mux.Handle("GET /v1/models", handlers.HandleModelsList(modelsIndex))
+	mux.Handle("GET /v1/models", handlers.HandleModelsList(modelsIndex, bricksIndex))
 	mux.Handle("GET /v1/models/{modelID}", handlers.HandlerModelByID(modelsIndex))
+	mux.Handle("PUT /v1/models/{modelID}", handlers.HandleModelImport(modelsIndex, cfg))
+	mux.Handle("DELETE /v1/models/{modelID}", handlers.HandleModelRemove(modelsIndex, cfg))
 
-	mux.Handle("GET /v1/apps", handlers.HandleAppList(dockerClient, idProvider, cfg))
-	mux.Handle("POST /v1/apps", handlers.HandleAppCreate(idProvider, cfg))
-	mux.Handle("GET /v1/apps/events", handlers.HandlerAppStatus(dockerClient, idProvider, cfg))
+	mux.Handle("GET /v1/schemas", handlers.HandleSchemaList())
+	mux.Handle("GET /v1/schemas/{name}", handlers.HandleSchemaGet())
+
+	mux.Handle("GET /v1/apps", handlers.HandleAppList(dockerClient, appCatalog, idProvider, cfg))
+	mux.Handle("POST /v1/apps", handlers.WithAppCatalogSync(appCatalog, handlers.HandleAppCreate(idProvider, staticStore, bricksIndex, cfg)))
+	mux.Handle("GET /v1/apps/events", handlers.HandlerAppStatus(dockerClient, appCatalog, idProvider, cfg))
+	mux.Handle("GET /v1/apps/changes", handlers.HandleAppCatalogEvents(appCatalog))
 
 	mux.Handle("GET /v1/apps/{appID}", handlers.HandleAppDetails(dockerClient, bricksIndex, idProvider, cfg))
-	mux.Handle("PATCH /v1/apps/{appID}", handlers.HandleAppDetailsEdits(dockerClient, bricksIndex, idProvider, cfg))
-	mux.Handle("GET /v1/apps/{appID}/logs", handlers.HandleAppLogs(dockerClient, idProvider, staticStore))
+	mux.Handle("PATCH /v1/apps/{appID}", handlers.WithAppCatalogSync(appCatalog, handlers.WithAppSnapshot(idProvider, cfg, handlers.HandleAppDetailsEdits(dockerClient, bricksIndex, idProvider, cfg))))
+	mux.Handle("GET /v1/apps/{appID}/logs", handlers.HandleAppLogs(dockerClient, idProvider, staticStore, cfg))
 	mux.Handle("POST /v1/apps/{appID}/start", handlers.HandleAppStart(dockerClient, provisioner, modelsIndex, bricksIndex, idProvider, cfg, staticStore))
-	mux.Handle("POST /v1/apps/{appID}/stop", handlers.HandleAppStop(dockerClient, idProvider))
-	mux.Handle("POST /v1/apps/{appID}/clone", handlers.HandleAppClone(dockerClient, idProvider, cfg))
-	mux.Handle("DELETE /v1/apps/{appID}", handlers.HandleAppDelete(idProvider))
-	mux.Handle("GET /v1/apps/{appID}/exposed-ports", handlers.HandleAppPorts(bricksIndex, idProvider))
-	mux.Handle("PUT /v1/apps/{appID}/sketch/libraries/{libRef}", handlers.HandleSketchAddLibrary(idProvider))
-	mux.Handle("DELETE /v1/apps/{appID}/sketch/libraries/{libRef}", handlers.HandleSketchRemoveLibrary(idProvider))
-	mux.Handle("GET /v1/apps/{appID}/sketch/libraries", handlers.HandleSketchListLibraries(idProvider))
-
-	mux.Handle("GET /v1/apps/{appID}/bricks", handlers.HandleAppBrickInstancesList(brickService, idProvider))
-	mux.Handle("GET /v1/apps/{appID}/bricks/{brickID}", handlers.HandleAppBrickInstanceDetails(brickService, idProvider))
-	mux.Handle("PUT /v1/apps/{appID}/bricks/{brickID}", handlers.HandleBrickCreate(brickService, idProvider))
-	mux.Handle("PATCH /v1/apps/{appID}/bricks/{brickID}", handlers.HandleBrickUpdates(brickService, idProvider))
-	mux.Handle("DELETE /v1/apps/{appID}/bricks/{brickID}", handlers.HandleBrickDelete(brickService, idProvider))
+	mux.Handle("POST /v1/apps/{appID}/prepare", handlers.HandleAppPrepare(dockerClient, provisioner, modelsIndex, bricksIndex, idProvider, cfg, staticStore))
+	mux.Handle("POST /v1/apps/{appID}/stop", handlers.HandleAppStop(dockerClient, idProvider, cfg))
+	mux.Handle("POST /v1/apps/import", handlers.WithAppCatalogSync(appCatalog, handlers.HandleAppImport(bricksIndex, modelsIndex, idProvider, cfg)))
+	mux.Handle("GET /v1/apps/{appID}/export", handlers.HandleAppExport(idProvider, cfg))
+	mux.Handle("POST /v1/apps/{appID}/clone", handlers.WithAppCatalogSync(appCatalog, handlers.HandleAppClone(dockerClient, idProvider, cfg)))
+	mux.Handle("POST /v1/apps/{appID}/commit", handlers.HandleAppCommit(idProvider, cfg))
+	mux.Handle("DELETE /v1/apps/{appID}", handlers.WithAppCatalogSync(appCatalog, handlers.HandleAppDelete(idProvider, cfg)))
+	mux.Handle("GET /v1/apps/{appID}/exposed-ports", handlers.HandleAppPorts(bricksIndex, idProvider, cfg))
+	mux.Handle("GET /v1/apps/{appID}/compatibility", handlers.HandleAppCompatibility(bricksIndex, idProvider, cfg))
+	mux.Handle("POST /v1/apps/{appID}/validate", handlers.HandleAppValidate(bricksIndex, modelsIndex, idProvider))
+	mux.Handle("PUT /v1/apps/{appID}/sketch/libraries/{libRef}", handlers.WithAppSnapshot(idProvider, cfg, handlers.HandleSketchAddLibrary(idProvider, cfg)))
+	mux.Handle("DELETE /v1/apps/{appID}/sketch/libraries/{libRef}", handlers.WithAppSnapshot(idProvider, cfg, handlers.HandleSketchRemoveLibrary(idProvider, cfg)))
+	mux.Handle("GET /v1/apps/{appID}/sketch/libraries", handlers.HandleSketchListLibraries(idProvider, cfg))
+	mux.Handle("POST /v1/apps/{appID}/sketch/libraries/local", handlers.WithAppSnapshot(idProvider, cfg, handlers.HandleSketchAddLocalLibrary(idProvider, cfg)))
+	mux.Handle("POST /v1/apps/{appID}/sketch/libraries/upgrade", handlers.HandleSketchUpgradeLibraries(idProvider, cfg))
+	mux.Handle("POST /v1/apps/{appID}/sketch/build", handlers.HandleSketchBuild(idProvider, cfg))
+	mux.Handle("GET /v1/apps/{appID}/snapshots", handlers.HandleAppSnapshotList(idProvider, cfg))
+	mux.Handle("POST /v1/apps/{appID}/snapshots/{snapshotID}/restore", handlers.WithAppCatalogSync(appCatalog, handlers.HandleAppSnapshotRestore(idProvider, cfg)))
+
+	mux.Handle("GET /v1/apps/{appID}/bricks", handlers.HandleAppBrickInstancesList(brickService, idProvider, cfg))
+	mux.Handle("GET /v1/apps/{appID}/bricks/{brickID}", handlers.HandleAppBrickInstanceDetails(brickService, idProvider, cfg))
+	mux.Handle("PUT /v1/apps/{appID}/bricks/{brickID}", handlers.WithAppCatalogSync(appCatalog, handlers.WithAppSnapshot(idProvider, cfg, handlers.HandleBrickCreate(brickService, idProvider, cfg))))
+	mux.Handle("PATCH /v1/apps/{appID}/bricks/{brickID}", handlers.WithAppCatalogSync(appCatalog, handlers.WithAppSnapshot(idProvider, cfg, handlers.HandleBrickUpdates(brickService, idProvider, cfg))))
+	mux.Handle("DELETE /v1/apps/{appID}/bricks/{brickID}", handlers.WithAppCatalogSync(appCatalog, handlers.WithAppSnapshot(idProvider, cfg, handlers.HandleBrickDelete(brickService, idProvider, cfg))))
 
 	mux.Handle("GET /v1/docs/", http.StripPrefix("/v1/docs/", handlers.DocsServer(docsFS)))
 
 	mux.Handle("GET /v1/monitor/ws", handlers.HandleMonitorWS(allowedOrigins))
 
 	mux.Handle("GET /v1/libraries", handlers.HandleLibraryList(cfg.LibrariesAPIURL, version))
+	mux.Handle("GET /v1/libraries/{name}", handlers.HandleLibraryDetails())
+	mux.Handle("GET /v1/libraries/{name}/dependencies", handlers.HandleLibraryDependencies())
 
 	return mux
 }
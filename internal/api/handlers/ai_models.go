@@ -16,17 +16,25 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/arduino/go-paths-helper"
+
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
-func HandleModelsList(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
+func HandleModelsList(modelsIndex *modelsindex.ModelsIndex, bricksIndex *bricksindex.BricksIndex) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		params := r.URL.Query()
 
@@ -35,8 +43,10 @@ func HandleModelsList(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
 			brickFilter = strings.Split(strings.TrimSpace(brick), ",")
 		}
 		res := orchestrator.AIModelsList(orchestrator.AIModelsListRequest{
-			FilterByBrickID: brickFilter,
-		}, modelsIndex)
+			FilterByBrickID:  brickFilter,
+			FilterByRunner:   params.Get("runner"),
+			FilterByModality: params.Get("modality"),
+		}, modelsIndex, bricksIndex)
 		render.EncodeResponse(w, http.StatusOK, res)
 	}
 }
@@ -57,3 +67,133 @@ func HandlerModelByID(modelsIndex *modelsindex.ModelsIndex) http.HandlerFunc {
 		render.EncodeResponse(w, http.StatusOK, res)
 	}
 }
+
+// maxModelUploadSize bounds the size of the model files accepted by HandleModelImport.
+const maxModelUploadSize = 1 << 30
+
+func HandleModelImport(modelsIndex *modelsindex.ModelsIndex, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("modelID")
+		if id == "" {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "id must be set"})
+			return
+		}
+		if err := modelsindex.ValidateCustomModelID(id); err != nil {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			return
+		}
+		params := r.URL.Query()
+		inputShape, err := parseInputShape(params.Get("input_shape"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			return
+		}
+
+		tmpDir, err := paths.MkTempDir("", "model-import")
+		if err != nil {
+			slog.Error("Unable to create temp dir", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to import the model"})
+			return
+		}
+		defer func() { _ = tmpDir.RemoveAll() }()
+
+		defer r.Body.Close()
+		modelFile := tmpDir.Join(id + modelsindex.CustomModelExtension)
+		if err := writeUploadedFile(modelFile, http.MaxBytesReader(w, r.Body, maxModelUploadSize)); err != nil {
+			slog.Error("Unable to read the model file", slog.String("error", err.Error()))
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "model file too large"})
+				return
+			}
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid body"})
+			return
+		}
+
+		res, err := orchestrator.AIModelImport(orchestrator.AIModelImportRequest{
+			File:        modelFile,
+			ID:          id,
+			Name:        params.Get("name"),
+			Description: params.Get("description"),
+			Runner:      params.Get("runner"),
+			RunnerImage: params.Get("runner_image"),
+			Modality:    params.Get("input_modality"),
+			Bricks:      splitListParam(params.Get("bricks")),
+			Labels:      splitListParam(params.Get("labels")),
+			InputShape:  inputShape,
+		}, modelsIndex, cfg)
+		if err != nil {
+			switch {
+			case errors.Is(err, modelsindex.ErrInvalidModelID):
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			case errors.Is(err, modelsindex.ErrModelIDInUse):
+				render.EncodeResponse(w, http.StatusConflict, models.ErrorResponse{Details: err.Error()})
+			default:
+				slog.Error("Unable to import the model", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to import the model"})
+			}
+			return
+		}
+		render.EncodeResponse(w, http.StatusCreated, res)
+	}
+}
+
+func HandleModelRemove(modelsIndex *modelsindex.ModelsIndex, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("modelID")
+		if id == "" {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "id must be set"})
+			return
+		}
+		if err := orchestrator.AIModelRemove(id, modelsIndex, cfg); err != nil {
+			switch {
+			case errors.Is(err, modelsindex.ErrModelNotFound):
+				details := fmt.Sprintf("models with id %q not found", id)
+				render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: details})
+			case errors.Is(err, modelsindex.ErrModelNotCustom):
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			default:
+				slog.Error("Unable to remove the model", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to remove the model"})
+			}
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, nil)
+	}
+}
+
+func writeUploadedFile(dst *paths.Path, body io.Reader) error {
+	f, err := dst.Create()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	n, err := io.Copy(f, body)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("body cannot be empty")
+	}
+	return nil
+}
+
+func splitListParam(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSpace(value), ",")
+}
+
+func parseInputShape(value string) ([]int, error) {
+	items := splitListParam(value)
+	shape := make([]int, 0, len(items))
+	for _, item := range items {
+		dim, err := strconv.Atoi(strings.TrimSpace(item))
+		if err != nil || dim <= 0 {
+			return nil, fmt.Errorf("invalid input_shape %q", value)
+		}
+		shape = append(shape, dim)
+	}
+	return shape, nil
+}
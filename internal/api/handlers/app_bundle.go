@@ -0,0 +1,149 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gosimple/slug"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+func HandleAppExport(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+			return
+		}
+		params := r.URL.Query()
+
+		format := orchestrator.BundleFormatZip
+		if f := params.Get("format"); f != "" {
+			format = orchestrator.BundleFormat(f)
+		}
+		var contentType string
+		switch format {
+		case orchestrator.BundleFormatZip:
+			contentType = "application/zip"
+		case orchestrator.BundleFormatTarGz:
+			contentType = "application/gzip"
+		default:
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid format, use zip or tar.gz"})
+			return
+		}
+		includeData := false
+		if v := params.Get("include_data"); v != "" {
+			if includeData, err = strconv.ParseBool(v); err != nil {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid include_data value"})
+				return
+			}
+		}
+
+		userApp, err := app.Load(id.ToPath().String(), cfg)
+		if err != nil {
+			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
+			return
+		}
+
+		// The bundle is streamed: the response starts with the first bytes of the bundle, so that
+		// an error while collecting the app files can still be reported with a status code.
+		bundle := &startOnWriteResponse{w: w, start: func() {
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", slug.Make(userApp.Name)+"."+string(format)))
+			w.WriteHeader(http.StatusOK)
+		}}
+		if _, err := orchestrator.ExportApp(r.Context(), userApp, orchestrator.ExportAppRequest{
+			Format:      format,
+			IncludeData: includeData,
+		}, bundle, cfg); err != nil {
+			slog.Error("Unable to export the app", slog.String("error", err.Error()), slog.String("path", id.String()))
+			if bundle.started {
+				// Abort the response, so that the client does not get a truncated bundle.
+				panic(http.ErrAbortHandler)
+			}
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to export the app"})
+			return
+		}
+	}
+}
+
+// startOnWriteResponse calls start before writing the first bytes of the response.
+type startOnWriteResponse struct {
+	w       http.ResponseWriter
+	start   func()
+	started bool
+}
+
+func (s *startOnWriteResponse) Write(p []byte) (int, error) {
+	if !s.started {
+		s.started = true
+		s.start()
+	}
+	return s.w.Write(p)
+}
+
+func HandleAppImport(
+	bricksIndex *bricksindex.BricksIndex,
+	modelsIndex *modelsindex.ModelsIndex,
+	idProvider *app.IDProvider,
+	cfg config.Configuration,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		params := r.URL.Query()
+
+		req := orchestrator.ImportAppRequest{}
+		if name := params.Get("name"); name != "" {
+			req.Name = &name
+		}
+		if v := params.Get("force"); v != "" {
+			force, err := strconv.ParseBool(v)
+			if err != nil {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid force value"})
+				return
+			}
+			req.Force = force
+		}
+
+		res, err := orchestrator.ImportApp(r.Context(), r.Body, req, bricksIndex, modelsIndex, idProvider, cfg)
+		if err != nil {
+			switch {
+			case errors.Is(err, orchestrator.ErrAppAlreadyExists):
+				render.EncodeResponse(w, http.StatusConflict, models.ErrorResponse{Details: "app already exists"})
+			case errors.Is(err, orchestrator.ErrInvalidBundle), errors.Is(err, orchestrator.ErrIncompatibleBundle):
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			default:
+				slog.Error("Unable to import the app", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to import the app"})
+			}
+			return
+		}
+		render.EncodeResponse(w, http.StatusCreated, res)
+	}
+}
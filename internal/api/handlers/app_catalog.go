@@ -0,0 +1,56 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+func HandleAppCatalogEvents(appCatalog *appcatalog.Catalog) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if appCatalog == nil {
+			render.EncodeResponse(w, http.StatusServiceUnavailable, models.ErrorResponse{Details: "app catalogue not available"})
+			return
+		}
+		sseStream, err := render.NewSSEStream(r.Context(), w)
+		if err != nil {
+			slog.Error("Unable to create SSE stream", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to create SSE stream"})
+			return
+		}
+		defer sseStream.Close()
+
+		for ev := range appCatalog.Events(r.Context()) {
+			sseStream.Send(render.SSEEvent{Type: string(ev.Type), Data: ev})
+		}
+	}
+}
+
+// WithAppCatalogSync syncs the app catalogue after a request changing the apps, so that
+// the next requests see the changes without waiting for the filesystem events.
+func WithAppCatalogSync(appCatalog *appcatalog.Catalog, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		if appCatalog != nil {
+			appCatalog.Sync()
+		}
+	}
+}
@@ -25,18 +25,26 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
+	"github.com/arduino/arduino-app-cli/internal/store"
 )
 
 type CreateAppRequest struct {
-	Name        string `json:"name" description:"application name" example:"My Awesome App" required:"true"`
-	Icon        string `json:"icon" description:"application icon" `
-	Description string `json:"description" description:"application description" `
+	Name        string   `json:"name" description:"application name" example:"My Awesome App" required:"true"`
+	Icon        string   `json:"icon" description:"application icon" `
+	Description string   `json:"description" description:"application description" `
+	Template    string   `json:"template,omitempty" description:"template the application is created from: an example app (examples:<name>), a brick code example (<brick-id>[/<example>]) or a user template"`
+	Bricks      []string `json:"bricks,omitempty" description:"bricks to add to the application"`
+	Ports       []int    `json:"ports,omitempty" description:"ports exposed by the application"`
+	GitInit     bool     `json:"git_init,omitempty" description:"initialize a git repository in the application folder"`
 }
 
 func HandleAppCreate(
 	idProvider *app.IDProvider,
+	staticStore *store.StaticStore,
+	bricksIndex *bricksindex.BricksIndex,
 	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -62,16 +70,32 @@ func HandleAppCreate(
 			return
 		}
 
+		var template *orchestrator.AppTemplate
+		if req.Template != "" {
+			t, err := orchestrator.FindAppTemplate(req.Template, staticStore, cfg)
+			if err != nil {
+				slog.Error("unable to find app template", slog.String("template", req.Template), slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+				return
+			}
+			template = &t
+		}
+
 		resp, err := orchestrator.CreateApp(
 			r.Context(),
 			orchestrator.CreateAppRequest{
 				Name:        req.Name,
 				Icon:        req.Icon,
 				Description: req.Description,
+				Ports:       req.Ports,
+				Bricks:      req.Bricks,
 				SkipPython:  skipPython,
 				SkipSketch:  skipSketch,
+				Template:    template,
+				GitInit:     req.GitInit,
 			},
 			idProvider,
+			bricksIndex,
 			cfg,
 		)
 		if err != nil {
@@ -22,10 +22,11 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
-func HandleAppDelete(idProvider *app.IDProvider) http.HandlerFunc {
+func HandleAppDelete(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
 		if err != nil {
@@ -36,8 +37,12 @@ func HandleAppDelete(idProvider *app.IDProvider) http.HandlerFunc {
 			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot delete example"})
 			return
 		}
+		if id.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot delete read-only apps"})
+			return
+		}
 
-		app, err := app.Load(id.ToPath().String())
+		app, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -45,7 +45,7 @@ func HandleAppDetails(
 			return
 		}
 
-		app, err := app.Load(id.ToPath().String())
+		app, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -67,6 +67,7 @@ type EditRequest struct {
 	Icon        *string `json:"icon" example:"💻" description:"application icon"`
 	Description *string `json:"description" example:"This is my awesome app" description:"application description"`
 	Default     *bool   `json:"default"`
+	Dev         *bool   `json:"dev" description:"make app start from the command line watch the app by default, restarting the Python main when the python folder changes"`
 }
 
 func HandleAppDetailsEdits(
@@ -81,7 +82,7 @@ func HandleAppDetailsEdits(
 			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
 			return
 		}
-		appToEdit, err := app.Load(id.ToPath().String())
+		appToEdit, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -96,9 +97,9 @@ func HandleAppDetailsEdits(
 			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid request"})
 			return
 		}
-		if id.IsExample() {
-			if editRequest.Description != nil || editRequest.Icon != nil || editRequest.Name != nil {
-				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "you can patch just the default field for example apps"})
+		if id.IsExample() || id.IsReadOnly() {
+			if editRequest.Description != nil || editRequest.Icon != nil || editRequest.Name != nil || editRequest.Dev != nil {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "you can patch just the default field for example and read-only apps"})
 				return
 			}
 			appEditRequest = orchestrator.AppEditRequest{
@@ -110,6 +111,7 @@ func HandleAppDetailsEdits(
 				Name:        editRequest.Name,
 				Icon:        editRequest.Icon,
 				Description: editRequest.Description,
+				Dev:         editRequest.Dev,
 			}
 		}
 		err = orchestrator.EditApp(appEditRequest, &appToEdit, cfg)
@@ -0,0 +1,83 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+type CommitAppRequest struct {
+	Message     string `json:"message" description:"commit message" required:"true"`
+	AuthorName  string `json:"author_name,omitempty" description:"commit author name, the one configured in the repository by default"`
+	AuthorEmail string `json:"author_email,omitempty" description:"commit author email"`
+}
+
+func HandleAppCommit(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+			return
+		}
+		if id.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
+		defer r.Body.Close()
+
+		var req CommitAppRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			slog.Error("unable to decode app commit request", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "unable to decode app commit request"})
+			return
+		}
+
+		userApp, err := app.Load(id.ToPath().String(), cfg)
+		if err != nil {
+			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
+			return
+		}
+
+		res, err := orchestrator.CommitApp(userApp, orchestrator.CommitAppRequest{
+			Message:     req.Message,
+			AuthorName:  req.AuthorName,
+			AuthorEmail: req.AuthorEmail,
+		})
+		if err != nil {
+			switch {
+			case errors.Is(err, orchestrator.ErrNotGitRepository):
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			case errors.Is(err, orchestrator.ErrNothingToCommit):
+				render.EncodeResponse(w, http.StatusConflict, models.ErrorResponse{Details: err.Error()})
+			default:
+				slog.Error("unable to commit app", slog.String("error", err.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to commit app"})
+			}
+			return
+		}
+		render.EncodeResponse(w, http.StatusCreated, res)
+	}
+}
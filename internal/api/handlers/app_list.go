@@ -26,6 +26,7 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
@@ -37,6 +38,7 @@ type AppListResponse struct {
 
 func HandleAppList(
 	dockerCli command.Cli,
+	appCatalog *appcatalog.Catalog,
 	idProvider *app.IDProvider,
 	cfg config.Configuration,
 ) http.HandlerFunc {
@@ -66,6 +68,7 @@ func HandleAppList(
 			ShowExamples:    showExamples,
 			ShowOnlyDefault: showOnlyDefault,
 			StatusFilter:    statusFilter,
+			Catalog:         appCatalog,
 		}, idProvider, cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()))
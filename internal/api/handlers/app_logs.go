@@ -27,6 +27,7 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 	"github.com/arduino/arduino-app-cli/internal/store"
 )
@@ -35,6 +36,7 @@ func HandleAppLogs(
 	dockerClient command.Cli,
 	idProvider *app.IDProvider,
 	staticStore *store.StaticStore,
+	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
@@ -43,7 +45,7 @@ func HandleAppLogs(
 			return
 		}
 
-		app, err := app.Load(id.ToPath().String())
+		app, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -24,6 +24,7 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
@@ -39,6 +40,7 @@ type port struct {
 func HandleAppPorts(
 	bricksIndex *bricksindex.BricksIndex,
 	idProvider *app.IDProvider,
+	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
@@ -47,7 +49,7 @@ func HandleAppPorts(
 			return
 		}
 
-		app, err := app.Load(id.ToPath().String())
+		app, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
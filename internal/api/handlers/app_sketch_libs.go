@@ -16,16 +16,23 @@
 package handlers
 
 import (
+	"errors"
+	"log/slog"
 	"net/http"
+	"path/filepath"
 	"strconv"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
 
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
-func HandleSketchAddLibrary(idProvider *app.IDProvider) http.HandlerFunc {
+func HandleSketchAddLibrary(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
 		if err != nil {
@@ -36,7 +43,11 @@ func HandleSketchAddLibrary(idProvider *app.IDProvider) http.HandlerFunc {
 			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter examples"})
 			return
 		}
-		app, err := app.Load(id.ToPath().String())
+		if id.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
+		app, err := app.Load(id.ToPath().String(), cfg)
 
 		// Get query param addDeps (default false)
 		addDeps, _ := strconv.ParseBool(r.URL.Query().Get("add_deps"))
@@ -67,7 +78,10 @@ type SketchAddLibraryResponse struct {
 	AddedLibraries []orchestrator.LibraryReleaseID `json:"libraries"`
 }
 
-func HandleSketchRemoveLibrary(idProvider *app.IDProvider) http.HandlerFunc {
+// maxLibraryUploadSize bounds the size of the library archives accepted by HandleSketchAddLocalLibrary.
+const maxLibraryUploadSize = 100 << 20
+
+func HandleSketchAddLocalLibrary(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
 		if err != nil {
@@ -78,7 +92,79 @@ func HandleSketchRemoveLibrary(idProvider *app.IDProvider) http.HandlerFunc {
 			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter examples"})
 			return
 		}
-		app, err := app.Load(id.ToPath().String())
+		if id.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
+		userApp, err := app.Load(id.ToPath().String(), cfg)
+		if err != nil {
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
+			return
+		}
+		if userApp.MainSketchPath == nil {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: orchestrator.ErrAppHasNoSketch.Error()})
+			return
+		}
+
+		// The library is uploaded as a zip archive, vendored in the libraries folder of the app.
+		name := r.URL.Query().Get("name")
+		if name == "" {
+			name = "library"
+		} else if name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid library name"})
+			return
+		}
+		tmpDir, err := paths.MkTempDir("", "library-upload")
+		if err != nil {
+			slog.Error("Unable to create temp dir", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to add sketch library"})
+			return
+		}
+		defer func() { _ = tmpDir.RemoveAll() }()
+
+		defer r.Body.Close()
+		archive := tmpDir.Join(name + ".zip")
+		if err := writeUploadedFile(archive, http.MaxBytesReader(w, r.Body, maxLibraryUploadSize)); err != nil {
+			slog.Error("Unable to read the library archive", slog.String("error", err.Error()))
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "library archive too large"})
+				return
+			}
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "invalid body"})
+			return
+		}
+
+		lib, err := orchestrator.AddSketchLocalLibrary(r.Context(), userApp, archive)
+		if errors.Is(err, orchestrator.ErrInvalidLibrary) {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			return
+		} else if err != nil {
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to add sketch library: " + err.Error()})
+			return
+		}
+		render.EncodeResponse(w, http.StatusCreated, SketchAddLibraryResponse{
+			AddedLibraries: []orchestrator.LibraryReleaseID{lib},
+		})
+	}
+}
+
+func HandleSketchRemoveLibrary(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+			return
+		}
+		if id.IsExample() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter examples"})
+			return
+		}
+		if id.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
+		app, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
 			return
@@ -107,14 +193,14 @@ type SketchRemoveLibraryResponse struct {
 	RemovedLibraries []orchestrator.LibraryReleaseID `json:"libraries"`
 }
 
-func HandleSketchListLibraries(idProvider *app.IDProvider) http.HandlerFunc {
+func HandleSketchListLibraries(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
 		if err != nil {
 			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
 			return
 		}
-		app, err := app.Load(id.ToPath().String())
+		app, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
 			return
@@ -135,3 +221,51 @@ func HandleSketchListLibraries(idProvider *app.IDProvider) http.HandlerFunc {
 type SketchListLibraryResponse struct {
 	Libraries []orchestrator.LibraryReleaseID `json:"libraries"`
 }
+
+func HandleSketchUpgradeLibraries(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+			return
+		}
+		dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+		if !dryRun && id.IsExample() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter examples"})
+			return
+		}
+		if !dryRun && id.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
+		app, err := app.Load(id.ToPath().String(), cfg)
+		if err != nil {
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
+			return
+		}
+
+		if !dryRun {
+			if _, err := orchestrator.SnapshotApp(app, r.Method+" "+r.URL.Path); err != nil {
+				slog.Warn("unable to snapshot app", slog.String("error", err.Error()), slog.String("path", id.String()))
+			}
+		}
+
+		upgrades, err := orchestrator.UpgradeSketchLibraries(r.Context(), app, dryRun)
+		if errors.Is(err, orchestrator.ErrAppHasNoSketch) {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			return
+		} else if err != nil {
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to upgrade sketch libraries: " + err.Error()})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, SketchUpgradeLibrariesResponse{
+			Upgrades: upgrades,
+			DryRun:   dryRun,
+		})
+	}
+}
+
+type SketchUpgradeLibrariesResponse struct {
+	Upgrades []orchestrator.LibraryUpgrade `json:"upgrades"`
+	DryRun   bool                          `json:"dry_run,omitempty"`
+}
@@ -0,0 +1,123 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/render"
+)
+
+// WithAppSnapshot snapshots the files of the app before calling the given handler,
+// so that the changes it makes can be undone. The snapshot is dropped if the request
+// fails. Failures are only logged: the request is handled anyway.
+func WithAppSnapshot(idProvider *app.IDProvider, cfg config.Configuration, next http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil || id.IsExample() || id.IsReadOnly() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		userApp, err := app.Load(id.ToPath().String(), cfg)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		err = orchestrator.RunWithAppSnapshot(userApp, r.Method+" "+r.URL.Path, func() bool {
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+			return rec.status >= 200 && rec.status < 300
+		})
+		if err != nil {
+			slog.Warn("unable to snapshot app", slog.String("error", err.Error()), slog.String("path", id.String()))
+		}
+	}
+}
+
+// statusRecorder records the status code of the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func HandleAppSnapshotList(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+			return
+		}
+		userApp, err := app.Load(id.ToPath().String(), cfg)
+		if err != nil {
+			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
+			return
+		}
+		snapshots, err := orchestrator.ListAppSnapshots(userApp)
+		if err != nil {
+			slog.Error("unable to list app snapshots", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to list app snapshots"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, models.AppSnapshotListResponse{Snapshots: snapshots})
+	}
+}
+
+func HandleAppSnapshotRestore(idProvider *app.IDProvider, cfg config.Configuration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := idProvider.IDFromBase64(r.PathValue("appID"))
+		if err != nil {
+			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid id"})
+			return
+		}
+		if id.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
+		userApp, err := app.Load(id.ToPath().String(), cfg)
+		if err != nil {
+			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
+			return
+		}
+		snapshot, err := orchestrator.RestoreAppSnapshot(userApp, r.PathValue("snapshotID"))
+		if err != nil {
+			if errors.Is(err, orchestrator.ErrSnapshotNotFound) {
+				render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: err.Error()})
+				return
+			}
+			slog.Error("unable to restore app snapshot", slog.String("error", err.Error()))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to restore app snapshot"})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, snapshot)
+	}
+}
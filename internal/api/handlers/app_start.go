@@ -18,6 +18,7 @@ package handlers
 import (
 	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/docker/cli/cli/command"
 
@@ -47,7 +48,7 @@ func HandleAppStart(
 			return
 		}
 
-		app, err := app.Load(id.ToPath().String())
+		app, err := app.Load(id.ToPath().String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", id.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -69,18 +70,35 @@ func HandleAppStart(
 		type log struct {
 			Message string `json:"message"`
 		}
-		for item := range orchestrator.StartApp(r.Context(), dockerCli, provisioner, modelsIndex, bricksIndex, app, cfg, staticStore) {
+		var opts orchestrator.StartAppOptions
+		opts.ForceUpload, _ = strconv.ParseBool(r.URL.Query().Get("force_upload"))
+		opts.Offline, _ = strconv.ParseBool(r.URL.Query().Get("offline"))
+		failed := false
+		send := func(item orchestrator.StreamMessage) {
 			switch item.GetType() {
 			case orchestrator.ProgressType:
 				sseStream.Send(render.SSEEvent{Type: "progress", Data: progress(*item.GetProgress())})
 			case orchestrator.InfoType:
 				sseStream.Send(render.SSEEvent{Type: "message", Data: log{Message: item.GetData()}})
 			case orchestrator.ErrorType:
+				failed = true
 				sseStream.SendError(render.SSEErrorData{
 					Code:    render.InternalServiceErr,
 					Message: item.GetError().Error(),
 				})
 			}
 		}
+		for item := range orchestrator.StartApp(r.Context(), dockerCli, provisioner, modelsIndex, bricksIndex, app, cfg, staticStore, opts) {
+			send(item)
+		}
+
+		// In watch mode the stream stays open until the client disconnects.
+		watch, _ := strconv.ParseBool(r.URL.Query().Get("watch"))
+		if failed || !watch || app.MainPythonFile == nil {
+			return
+		}
+		for item := range orchestrator.WatchApp(r.Context(), modelsIndex, bricksIndex, app, cfg, orchestrator.DefaultWatchDebounce) {
+			send(item)
+		}
 	}
 }
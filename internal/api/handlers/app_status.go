@@ -24,12 +24,14 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/api/models"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
 func HandlerAppStatus(
 	dockerCli command.Cli,
+	appCatalog *appcatalog.Catalog,
 	idProvider *app.IDProvider,
 	cfg config.Configuration,
 ) http.HandlerFunc {
@@ -42,7 +44,7 @@ func HandlerAppStatus(
 		}
 		defer sseStream.Close()
 
-		result, err := orchestrator.ListApps(r.Context(), dockerCli, orchestrator.ListAppRequest{ShowExamples: true, ShowApps: true}, idProvider, cfg)
+		result, err := orchestrator.ListApps(r.Context(), dockerCli, orchestrator.ListAppRequest{ShowExamples: true, ShowApps: true, Catalog: appCatalog}, idProvider, cfg)
 		if err != nil {
 			sseStream.SendError(render.SSEErrorData{Code: render.InternalServiceErr, Message: err.Error()})
 		}
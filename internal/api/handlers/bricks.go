@@ -24,15 +24,32 @@ import (
 	"net/http"
 
 	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricks"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
 func HandleBrickList(brickService *bricks.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res, err := brickService.List()
+		var (
+			res bricks.BrickListResult
+			err error
+		)
+		if queryParamsValidator(r.URL.Query().Get("compatible")) {
+			available, devErr := orchestrator.GetAvailableDevices()
+			if devErr != nil {
+				slog.Error("Unable to detect board devices", slog.String("error", devErr.Error()))
+				render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to detect board devices"})
+				return
+			}
+			res, err = brickService.ListWithCompatibility(available)
+		} else {
+			res, err = brickService.List()
+		}
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to retrieve brick list"})
@@ -46,6 +63,7 @@ func HandleBrickList(brickService *bricks.Service) http.HandlerFunc {
 func HandleAppBrickInstancesList(
 	brickService *bricks.Service,
 	idProvider *app.IDProvider,
+	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		appId, err := idProvider.IDFromBase64(r.PathValue("appID"))
@@ -55,7 +73,7 @@ func HandleAppBrickInstancesList(
 		}
 		appPath := appId.ToPath()
 
-		app, err := app.Load(appPath.String())
+		app, err := app.Load(appPath.String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", appId.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -76,6 +94,7 @@ func HandleAppBrickInstancesList(
 func HandleAppBrickInstanceDetails(
 	brickService *bricks.Service,
 	idProvider *app.IDProvider,
+	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		appId, err := idProvider.IDFromBase64(r.PathValue("appID"))
@@ -85,7 +104,7 @@ func HandleAppBrickInstanceDetails(
 		}
 		appPath := appId.ToPath()
 
-		app, err := app.Load(appPath.String())
+		app, err := app.Load(appPath.String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", appId.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -111,6 +130,7 @@ func HandleAppBrickInstanceDetails(
 func HandleBrickCreate(
 	brickService *bricks.Service,
 	idProvider *app.IDProvider,
+	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		appId, err := idProvider.IDFromBase64(r.PathValue("appID"))
@@ -118,9 +138,13 @@ func HandleBrickCreate(
 			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid app id"})
 			return
 		}
+		if appId.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
 		appPath := appId.ToPath()
 
-		app, err := app.Load(appPath.String())
+		app, err := app.Load(appPath.String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", appId.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -144,6 +168,10 @@ func HandleBrickCreate(
 		req.ID = id
 
 		err = brickService.BrickCreate(req, app)
+		if errors.Is(err, modelsindex.ErrIncompatibleModel) {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			return
+		}
 		if err != nil {
 			// TODO: handle specific errors
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()))
@@ -154,7 +182,7 @@ func HandleBrickCreate(
 	}
 }
 
-func HandleBrickDetails(brickService *bricks.Service, idProvider *app.IDProvider,
+func HandleBrickDetails(brickService *bricks.Service, idProvider *app.IDProvider, appCatalog *appcatalog.Catalog,
 	cfg config.Configuration) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id := r.PathValue("brickID")
@@ -162,7 +190,7 @@ func HandleBrickDetails(brickService *bricks.Service, idProvider *app.IDProvider
 			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "id must be set"})
 			return
 		}
-		res, err := brickService.BricksDetails(id, idProvider, cfg)
+		res, err := brickService.BricksDetails(id, idProvider, appCatalog, cfg)
 		if err != nil {
 			if errors.Is(err, bricks.ErrBrickNotFound) {
 				details := fmt.Sprintf("brick with id %q not found", id)
@@ -181,6 +209,7 @@ func HandleBrickDetails(brickService *bricks.Service, idProvider *app.IDProvider
 func HandleBrickUpdates(
 	brickService *bricks.Service,
 	idProvider *app.IDProvider,
+	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		appId, err := idProvider.IDFromBase64(r.PathValue("appID"))
@@ -188,9 +217,13 @@ func HandleBrickUpdates(
 			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid app id"})
 			return
 		}
+		if appId.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
 		appPath := appId.ToPath()
 
-		app, err := app.Load(appPath.String())
+		app, err := app.Load(appPath.String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", appId.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
@@ -212,6 +245,10 @@ func HandleBrickUpdates(
 
 		req.ID = id
 		err = brickService.BrickUpdate(req, app)
+		if errors.Is(err, modelsindex.ErrIncompatibleModel) {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: err.Error()})
+			return
+		}
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to update the brick"})
@@ -227,6 +264,7 @@ func HandleBrickUpdates(
 func HandleBrickDelete(
 	brickService *bricks.Service,
 	idProvider *app.IDProvider,
+	cfg config.Configuration,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		appId, err := idProvider.IDFromBase64(r.PathValue("appID"))
@@ -234,9 +272,13 @@ func HandleBrickDelete(
 			render.EncodeResponse(w, http.StatusPreconditionFailed, models.ErrorResponse{Details: "invalid app id"})
 			return
 		}
+		if appId.IsReadOnly() {
+			render.EncodeResponse(w, http.StatusBadRequest, models.ErrorResponse{Details: "cannot alter read-only apps"})
+			return
+		}
 		appPath := appId.ToPath()
 
-		app, err := app.Load(appPath.String())
+		app, err := app.Load(appPath.String(), cfg)
 		if err != nil {
 			slog.Error("Unable to parse the app.yaml", slog.String("error", err.Error()), slog.String("path", appId.String()))
 			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to find the app"})
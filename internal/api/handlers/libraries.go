@@ -16,10 +16,15 @@
 package handlers
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator"
+	"github.com/arduino/arduino-app-cli/internal/render"
 )
 
 // HandleLibraryList is a proxy to the List libraries API
@@ -37,6 +42,37 @@ func HandleLibraryList(target *url.URL, version string) http.Handler {
 	}
 }
 
+// HandleLibraryDetails returns the details of a library from the local library index.
+func HandleLibraryDetails() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		lib, err := orchestrator.GetLibraryDetails(r.Context(), r.PathValue("name"))
+		if errors.Is(err, orchestrator.ErrLibraryNotFound) {
+			render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: err.Error()})
+			return
+		} else if err != nil {
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to get library details: " + err.Error()})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, lib)
+	}
+}
+
+// HandleLibraryDependencies previews the dependency tree of a library release.
+func HandleLibraryDependencies() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		libRef := orchestrator.NewLibraryReleaseID(r.PathValue("name"), r.URL.Query().Get("version"))
+		tree, err := orchestrator.GetLibraryDependencyTree(r.Context(), libRef)
+		if errors.Is(err, orchestrator.ErrLibraryNotFound) {
+			render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: err.Error()})
+			return
+		} else if err != nil {
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to resolve library dependencies: " + err.Error()})
+			return
+		}
+		render.EncodeResponse(w, http.StatusOK, tree)
+	}
+}
+
 // NOTE: this is only to generate the openapi docs.
 type LibraryListResponse struct {
 	Libraries  []Library  `json:"libraries"`
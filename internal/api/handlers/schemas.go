@@ -0,0 +1,54 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/arduino/arduino-app-cli/internal/api/models"
+	"github.com/arduino/arduino-app-cli/internal/render"
+	"github.com/arduino/arduino-app-cli/internal/schema"
+)
+
+func HandleSchemaList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		render.EncodeResponse(w, http.StatusOK, models.SchemaListResponse{Schemas: schema.Names()})
+	}
+}
+
+func HandleSchemaGet() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name, _ := strings.CutSuffix(r.PathValue("name"), ".schema.json")
+		content, err := schema.Get(name)
+		if err != nil {
+			if errors.Is(err, schema.ErrSchemaNotFound) {
+				details := fmt.Sprintf("schema %q not found", name)
+				render.EncodeResponse(w, http.StatusNotFound, models.ErrorResponse{Details: details})
+				return
+			}
+			slog.Error("Unable to read schema", slog.String("error", err.Error()), slog.String("name", name))
+			render.EncodeResponse(w, http.StatusInternalServerError, models.ErrorResponse{Details: "unable to read schema"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/schema+json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(content)
+	}
+}
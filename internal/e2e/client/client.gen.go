@@ -12,16 +12,30 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AppDetailedInfoFlashMode.
+const (
+	Flash AppDetailedInfoFlashMode = "flash"
+	Ram   AppDetailedInfoFlashMode = "ram"
+)
+
 // Defines values for PackageType.
 const (
 	ArduinoPlatform PackageType = "arduino-platform"
 	DebianPackage   PackageType = "debian-package"
 )
 
+// Defines values for SketchDiagnosticSeverity.
+const (
+	Error   SketchDiagnosticSeverity = "error"
+	Fatal   SketchDiagnosticSeverity = "fatal"
+	Warning SketchDiagnosticSeverity = "warning"
+)
+
 // Defines values for Status.
 const (
 	Failed   Status = "failed"
@@ -31,6 +45,12 @@ const (
 	Stopping Status = "stopping"
 )
 
+// Defines values for ExportAppParamsFormat.
+const (
+	TarGz ExportAppParamsFormat = "tar.gz"
+	Zip   ExportAppParamsFormat = "zip"
+)
+
 // Defines values for ListLibrariesParamsSort.
 const (
 	ForksAsc   ListLibrariesParamsSort = "forks_asc"
@@ -46,10 +66,15 @@ type AIModelItem struct {
 	BrickIds           *[]string          `json:"brick_ids"`
 	Description        *string            `json:"description,omitempty"`
 	Id                 *string            `json:"id,omitempty"`
+	InputModality      *string            `json:"input_modality,omitempty"`
+	InputShape         *[]int             `json:"input_shape,omitempty"`
+	Labels             *[]string          `json:"labels,omitempty"`
 	Metadata           *map[string]string `json:"metadata,omitempty"`
 	ModelConfiguration *map[string]string `json:"model_configuration,omitempty"`
 	Name               *string            `json:"name,omitempty"`
 	Runner             *string            `json:"runner,omitempty"`
+	RunnerImage        *string            `json:"runner_image,omitempty"`
+	Source             *string            `json:"source,omitempty"`
 }
 
 // AIModelsListResult defines model for AIModelsListResult.
@@ -62,6 +87,25 @@ type AppBrickInstancesResult struct {
 	Bricks *[]BrickInstance `json:"bricks"`
 }
 
+// AppBundleManifest defines model for AppBundleManifest.
+type AppBundleManifest struct {
+	AppFormatVersion *int      `json:"app_format_version,omitempty"`
+	Bricks           *[]string `json:"bricks"`
+	IncludesData     *bool     `json:"includes_data,omitempty"`
+	ManifestVersion  *int      `json:"manifest_version,omitempty"`
+	Models           *[]string `json:"models,omitempty"`
+	Name             *string   `json:"name,omitempty"`
+	RunnerVersion    *string   `json:"runner_version,omitempty"`
+	SketchLibraries  *[]string `json:"sketch_libraries,omitempty"`
+}
+
+// AppCompatibilityResult defines model for AppCompatibilityResult.
+type AppCompatibilityResult struct {
+	Bricks         *[]BrickCompatibility `json:"bricks"`
+	Compatible     *bool                 `json:"compatible,omitempty"`
+	MissingDevices *[]MissingDevice      `json:"missing_devices"`
+}
+
 // AppDetailedBrick defines model for AppDetailedBrick.
 type AppDetailedBrick struct {
 	Category *string `json:"category,omitempty"`
@@ -74,16 +118,46 @@ type AppDetailedInfo struct {
 	Bricks      *[]AppDetailedBrick `json:"bricks,omitempty"`
 	Default     *bool               `json:"default,omitempty"`
 	Description *string             `json:"description,omitempty"`
+	Dev         *bool               `json:"dev,omitempty"`
 	Example     *bool               `json:"example,omitempty"`
-	Icon        *string             `json:"icon,omitempty"`
-	Id          string              `json:"id"`
-	Name        string              `json:"name"`
-	Path        *string             `json:"path,omitempty"`
+
+	// FlashMode where the sketch is deployed, only set for apps with a sketch
+	FlashMode *AppDetailedInfoFlashMode `json:"flash_mode,omitempty"`
+
+	// Flashed the sketch stored in the flash of the MCU was uploaded by this app
+	Flashed  *bool       `json:"flashed,omitempty"`
+	Git      *AppGitInfo `json:"git,omitempty"`
+	Icon     *string     `json:"icon,omitempty"`
+	Id       string      `json:"id"`
+	Name     string      `json:"name"`
+	Path     *string     `json:"path,omitempty"`
+	ReadOnly *bool       `json:"read_only,omitempty"`
 
 	// Status Application status
 	Status Status `json:"status"`
 }
 
+// AppDetailedInfoFlashMode where the sketch is deployed, only set for apps with a sketch
+type AppDetailedInfoFlashMode string
+
+// AppGitCommit defines model for AppGitCommit.
+type AppGitCommit struct {
+	Author  *string    `json:"author,omitempty"`
+	Date    *time.Time `json:"date,omitempty"`
+	Hash    string     `json:"hash"`
+	Message *string    `json:"message,omitempty"`
+}
+
+// AppGitInfo defines model for AppGitInfo.
+type AppGitInfo struct {
+	// Branch current branch, empty when HEAD is detached
+	Branch *string `json:"branch,omitempty"`
+
+	// Dirty true if the app folder has uncommitted changes
+	Dirty      *bool         `json:"dirty,omitempty"`
+	LastCommit *AppGitCommit `json:"last_commit,omitempty"`
+}
+
 // AppInfo defines model for AppInfo.
 type AppInfo struct {
 	Default     *bool   `json:"default,omitempty"`
@@ -92,6 +166,7 @@ type AppInfo struct {
 	Icon        *string `json:"icon,omitempty"`
 	Id          *string `json:"id,omitempty"`
 	Name        *string `json:"name,omitempty"`
+	ReadOnly    *bool   `json:"read_only,omitempty"`
 
 	// Status Application status
 	Status *Status `json:"status,omitempty"`
@@ -119,6 +194,36 @@ type AppReference struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// AppSnapshot defines model for AppSnapshot.
+type AppSnapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Files app files and folders saved in the snapshot
+	Files *[]string `json:"files"`
+	Id    string    `json:"id"`
+
+	// Reason the operation that triggered the snapshot
+	Reason *string `json:"reason,omitempty"`
+}
+
+// AppSnapshotListResponse defines model for AppSnapshotListResponse.
+type AppSnapshotListResponse struct {
+	Snapshots *[]AppSnapshot `json:"snapshots"`
+}
+
+// AppValidationResult defines model for AppValidationResult.
+type AppValidationResult struct {
+	Findings *[]ValidationFinding `json:"findings"`
+	Valid    *bool                `json:"valid,omitempty"`
+}
+
+// BrickCompatibility defines model for BrickCompatibility.
+type BrickCompatibility struct {
+	Compatible     *bool            `json:"compatible,omitempty"`
+	Id             *string          `json:"id,omitempty"`
+	MissingDevices *[]MissingDevice `json:"missing_devices"`
+}
+
 // BrickConfigVariable defines model for BrickConfigVariable.
 type BrickConfigVariable struct {
 	Description *string `json:"description,omitempty"`
@@ -164,13 +269,14 @@ type BrickInstance struct {
 
 // BrickListItem defines model for BrickListItem.
 type BrickListItem struct {
-	Author      *string   `json:"author,omitempty"`
-	Category    *string   `json:"category,omitempty"`
-	Description *string   `json:"description,omitempty"`
-	Id          *string   `json:"id,omitempty"`
-	Models      *[]string `json:"models"`
-	Name        *string   `json:"name,omitempty"`
-	Status      *string   `json:"status,omitempty"`
+	Author        *string        `json:"author,omitempty"`
+	Category      *string        `json:"category,omitempty"`
+	Compatibility *Compatibility `json:"compatibility,omitempty"`
+	Description   *string        `json:"description,omitempty"`
+	Id            *string        `json:"id,omitempty"`
+	Models        *[]string      `json:"models"`
+	Name          *string        `json:"name,omitempty"`
+	Status        *string        `json:"status,omitempty"`
 }
 
 // BrickListResult defines model for BrickListResult.
@@ -210,6 +316,24 @@ type CodeExample struct {
 	Path *string `json:"path,omitempty"`
 }
 
+// CommitAppRequest defines model for CommitAppRequest.
+type CommitAppRequest struct {
+	// AuthorEmail commit author email
+	AuthorEmail *string `json:"author_email,omitempty"`
+
+	// AuthorName commit author name, the one configured in the repository by default
+	AuthorName *string `json:"author_name,omitempty"`
+
+	// Message commit message
+	Message string `json:"message"`
+}
+
+// Compatibility defines model for Compatibility.
+type Compatibility struct {
+	Compatible     *bool            `json:"compatible,omitempty"`
+	MissingDevices *[]MissingDevice `json:"missing_devices"`
+}
+
 // ConfigDirectories defines model for ConfigDirectories.
 type ConfigDirectories struct {
 	Apps     *string `json:"apps,omitempty"`
@@ -224,14 +348,26 @@ type ConfigResponse struct {
 
 // CreateAppRequest defines model for CreateAppRequest.
 type CreateAppRequest struct {
+	// Bricks bricks to add to the application
+	Bricks *[]string `json:"bricks,omitempty"`
+
 	// Description application description
 	Description *string `json:"description,omitempty"`
 
+	// GitInit initialize a git repository in the application folder
+	GitInit *bool `json:"git_init,omitempty"`
+
 	// Icon application icon
 	Icon *string `json:"icon,omitempty"`
 
 	// Name application name
 	Name string `json:"name"`
+
+	// Ports ports exposed by the application
+	Ports *[]int `json:"ports,omitempty"`
+
+	// Template template the application is created from: an example app (examples:<name>), a brick code example (<brick-id>[/<example>]) or a user template
+	Template *string `json:"template,omitempty"`
 }
 
 // CreateAppResponse defines model for CreateAppResponse.
@@ -246,6 +382,9 @@ type EditRequest struct {
 	// Description application description
 	Description *string `json:"description"`
 
+	// Dev make app start from the command line watch the app by default, restarting the Python main when the python folder changes
+	Dev *bool `json:"dev"`
+
 	// Icon application icon
 	Icon *string `json:"icon"`
 
@@ -259,6 +398,19 @@ type ErrorResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// Event defines model for Event.
+type Event struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// ImportAppResponse defines model for ImportAppResponse.
+type ImportAppResponse struct {
+	Id       *string            `json:"id,omitempty"`
+	Manifest *AppBundleManifest `json:"manifest,omitempty"`
+	Warnings *[]string          `json:"warnings"`
+}
+
 // Library defines model for Library.
 type Library struct {
 	Architectures *[]string `json:"architectures"`
@@ -290,6 +442,41 @@ type Library struct {
 	Website  *string   `json:"website,omitempty"`
 }
 
+// LibraryDependency defines model for LibraryDependency.
+type LibraryDependency struct {
+	Name              string  `json:"name"`
+	VersionConstraint *string `json:"version_constraint,omitempty"`
+}
+
+// LibraryDependencyNode defines model for LibraryDependencyNode.
+type LibraryDependencyNode struct {
+	Dependencies *[]LibraryDependencyNode `json:"dependencies,omitempty"`
+	Name         string                   `json:"name"`
+	Version      string                   `json:"version"`
+}
+
+// LibraryInfo defines model for LibraryInfo.
+type LibraryInfo struct {
+	Architectures *[]string            `json:"architectures,omitempty"`
+	Author        *string              `json:"author,omitempty"`
+	Category      *string              `json:"category,omitempty"`
+	Dependencies  *[]LibraryDependency `json:"dependencies,omitempty"`
+	Includes      *[]string            `json:"includes,omitempty"`
+	License       *string              `json:"license,omitempty"`
+	Maintainer    *string              `json:"maintainer,omitempty"`
+	Name          string               `json:"name"`
+	Paragraph     *string              `json:"paragraph,omitempty"`
+	Sentence      *string              `json:"sentence,omitempty"`
+	Types         *[]string            `json:"types,omitempty"`
+
+	// Version the latest version of the library
+	Version string `json:"version"`
+
+	// Versions all the versions of the library, oldest first
+	Versions *[]string `json:"versions,omitempty"`
+	Website  *string   `json:"website,omitempty"`
+}
+
 // LibraryListResponse defines model for LibraryListResponse.
 type LibraryListResponse struct {
 	Libraries  *[]Library  `json:"libraries"`
@@ -299,6 +486,20 @@ type LibraryListResponse struct {
 // LibraryReleaseID defines model for LibraryReleaseID.
 type LibraryReleaseID = map[string]interface{}
 
+// LibraryUpgrade defines model for LibraryUpgrade.
+type LibraryUpgrade struct {
+	// FromVersion the current version, empty for the dependencies added by the upgrade
+	FromVersion string `json:"from_version"`
+	Name        string `json:"name"`
+	ToVersion   string `json:"to_version"`
+}
+
+// MissingDevice defines model for MissingDevice.
+type MissingDevice struct {
+	Class *string `json:"class,omitempty"`
+	Hint  *string `json:"hint,omitempty"`
+}
+
 // PackageType Package type
 type PackageType string
 
@@ -329,11 +530,47 @@ type PropertyKeysResponse struct {
 	Keys *[]string `json:"keys"`
 }
 
+// SchemaListResponse defines model for SchemaListResponse.
+type SchemaListResponse struct {
+	Schemas *[]string `json:"schemas"`
+}
+
 // SketchAddLibraryResponse defines model for SketchAddLibraryResponse.
 type SketchAddLibraryResponse struct {
 	Libraries *[]LibraryReleaseID `json:"libraries"`
 }
 
+// SketchBuildResult defines model for SketchBuildResult.
+type SketchBuildResult struct {
+	Diagnostics   *[]SketchDiagnostic  `json:"diagnostics"`
+	Error         *string              `json:"error,omitempty"`
+	Sizes         *[]SketchSectionSize `json:"sizes,omitempty"`
+	Success       bool                 `json:"success"`
+	Uploaded      *bool                `json:"uploaded,omitempty"`
+	UsedLibraries *[]LibraryReleaseID  `json:"used_libraries,omitempty"`
+}
+
+// SketchDiagnostic defines model for SketchDiagnostic.
+type SketchDiagnostic struct {
+	Column   *int                     `json:"column,omitempty"`
+	File     string                   `json:"file"`
+	Line     int                      `json:"line"`
+	Message  string                   `json:"message"`
+	Notes    *[]SketchDiagnosticNote  `json:"notes,omitempty"`
+	Severity SketchDiagnosticSeverity `json:"severity"`
+}
+
+// SketchDiagnosticSeverity defines model for SketchDiagnostic.Severity.
+type SketchDiagnosticSeverity string
+
+// SketchDiagnosticNote defines model for SketchDiagnosticNote.
+type SketchDiagnosticNote struct {
+	Column  *int    `json:"column,omitempty"`
+	File    *string `json:"file,omitempty"`
+	Line    *int    `json:"line,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
 // SketchListLibraryResponse defines model for SketchListLibraryResponse.
 type SketchListLibraryResponse struct {
 	Libraries *[]LibraryReleaseID `json:"libraries"`
@@ -344,6 +581,19 @@ type SketchRemoveLibraryResponse struct {
 	Libraries *[]LibraryReleaseID `json:"libraries"`
 }
 
+// SketchSectionSize defines model for SketchSectionSize.
+type SketchSectionSize struct {
+	MaxSize *int   `json:"max_size,omitempty"`
+	Name    string `json:"name"`
+	Size    int    `json:"size"`
+}
+
+// SketchUpgradeLibrariesResponse defines model for SketchUpgradeLibrariesResponse.
+type SketchUpgradeLibrariesResponse struct {
+	DryRun   *bool             `json:"dry_run,omitempty"`
+	Upgrades *[]LibraryUpgrade `json:"upgrades"`
+}
+
 // Status Application status
 type Status string
 
@@ -362,6 +612,15 @@ type UpgradablePackage struct {
 	Type *PackageType `json:"type,omitempty"`
 }
 
+// ValidationFinding defines model for ValidationFinding.
+type ValidationFinding struct {
+	Column   *int    `json:"column,omitempty"`
+	File     *string `json:"file,omitempty"`
+	Line     *int    `json:"line,omitempty"`
+	Message  *string `json:"message,omitempty"`
+	Severity *string `json:"severity,omitempty"`
+}
+
 // VersionResponse defines model for VersionResponse.
 type VersionResponse struct {
 	Version *string `json:"version,omitempty"`
@@ -403,12 +662,51 @@ type CreateAppParams struct {
 	SkipSketch *bool `form:"skip-sketch,omitempty" json:"skip-sketch,omitempty"`
 }
 
+// ImportAppJSONBody defines parameters for ImportApp.
+type ImportAppJSONBody = string
+
+// ImportAppParams defines parameters for ImportApp.
+type ImportAppParams struct {
+	// Name name of the imported app, the one of the bundle by default.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Force import the app even if some of its bricks or models are not available.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// AppSketchAddLocalLibraryJSONBody defines parameters for AppSketchAddLocalLibrary.
+type AppSketchAddLocalLibraryJSONBody = string
+
+// AppSketchAddLocalLibraryParams defines parameters for AppSketchAddLocalLibrary.
+type AppSketchAddLocalLibraryParams struct {
+	// Name name of the library folder, used when the archive does not contain a single folder.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// AppSketchUpgradeLibrariesParams defines parameters for AppSketchUpgradeLibraries.
+type AppSketchUpgradeLibrariesParams struct {
+	// DryRun if true, only reports the version changes without altering the sketch project file.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
 // AppSketchAddLibraryParams defines parameters for AppSketchAddLibrary.
 type AppSketchAddLibraryParams struct {
 	// AddDeps if set to "true", the library's dependencies will be added as well.
 	AddDeps *string `form:"add_deps,omitempty" json:"add_deps,omitempty"`
 }
 
+// ExportAppParams defines parameters for ExportApp.
+type ExportAppParams struct {
+	// Format bundle format, zip by default.
+	Format *ExportAppParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// IncludeData include the data folder of the app.
+	IncludeData *bool `form:"include_data,omitempty" json:"include_data,omitempty"`
+}
+
+// ExportAppParamsFormat defines parameters for ExportApp.
+type ExportAppParamsFormat string
+
 // GetAppLogsParams defines parameters for GetAppLogs.
 type GetAppLogsParams struct {
 	Filter   *string `form:"filter,omitempty" json:"filter,omitempty"`
@@ -416,6 +714,24 @@ type GetAppLogsParams struct {
 	Nofollow *bool   `form:"nofollow,omitempty" json:"nofollow,omitempty"`
 }
 
+// StartAppParams defines parameters for StartApp.
+type StartAppParams struct {
+	// Watch keep the stream open and restart the Python main when the python folder changes. Must be explicitly requested, also for apps with the dev flag.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ForceUpload compile and upload the sketch even if it did not change since the last upload.
+	ForceUpload *bool `form:"force_upload,omitempty" json:"force_upload,omitempty"`
+
+	// Offline never download anything: if an image, a model, or a platform or library of the sketch is missing the start fails with an error listing them.
+	Offline *bool `form:"offline,omitempty" json:"offline,omitempty"`
+}
+
+// GetBricksParams defines parameters for GetBricks.
+type GetBricksParams struct {
+	// Compatible If true, reports for each brick whether the devices attached to the board satisfy its required devices.
+	Compatible *bool `form:"compatible,omitempty" json:"compatible,omitempty"`
+}
+
 // ListLibrariesParams defines parameters for ListLibraries.
 type ListLibrariesParams struct {
 	// Search Search term to filter libraries by name, sentence, paragraph.
@@ -440,10 +756,52 @@ type ListLibrariesParams struct {
 // ListLibrariesParamsSort defines parameters for ListLibraries.
 type ListLibrariesParamsSort string
 
+// GetLibraryDependenciesParams defines parameters for GetLibraryDependencies.
+type GetLibraryDependenciesParams struct {
+	// Version version of the library, defaults to the latest.
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+}
+
 // GetAIModelsParams defines parameters for GetAIModels.
 type GetAIModelsParams struct {
-	// Bricks Filter models by bricks. If not specified, all models are returned.
+	// Bricks Filter models by bricks. Only models compatible with at least one of the bricks are returned. If not specified, all models are returned.
 	Bricks *string `form:"bricks,omitempty" json:"bricks,omitempty"`
+
+	// Runner Filter models by runner.
+	Runner *string `form:"runner,omitempty" json:"runner,omitempty"`
+
+	// Modality Filter models by input modality (e.g. image, audio).
+	Modality *string `form:"modality,omitempty" json:"modality,omitempty"`
+}
+
+// ImportAIModelJSONBody defines parameters for ImportAIModel.
+type ImportAIModelJSONBody = string
+
+// ImportAIModelParams defines parameters for ImportAIModel.
+type ImportAIModelParams struct {
+	// Name Human readable name of the model. Defaults to the identifier.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Description Description of the model.
+	Description *string `form:"description,omitempty" json:"description,omitempty"`
+
+	// Runner Runner of the model. Defaults to brick.
+	Runner *string `form:"runner,omitempty" json:"runner,omitempty"`
+
+	// RunnerImage Container image required to run the model.
+	RunnerImage *string `form:"runner_image,omitempty" json:"runner_image,omitempty"`
+
+	// InputModality Input modality of the model (e.g. image, audio).
+	InputModality *string `form:"input_modality,omitempty" json:"input_modality,omitempty"`
+
+	// Bricks Comma separated list of the bricks that can use the model.
+	Bricks *string `form:"bricks,omitempty" json:"bricks,omitempty"`
+
+	// Labels Comma separated list of the labels of the model.
+	Labels *string `form:"labels,omitempty" json:"labels,omitempty"`
+
+	// InputShape Comma separated input shape of the model (e.g. 96,96,3).
+	InputShape *string `form:"input_shape,omitempty" json:"input_shape,omitempty"`
 }
 
 // UpdatePropertyJSONBody defines parameters for UpdateProperty.
@@ -464,18 +822,30 @@ type CheckUpdateParams struct {
 // CreateAppJSONRequestBody defines body for CreateApp for application/json ContentType.
 type CreateAppJSONRequestBody = CreateAppRequest
 
+// ImportAppJSONRequestBody defines body for ImportApp for application/json ContentType.
+type ImportAppJSONRequestBody = ImportAppJSONBody
+
 // UpdateAppBrickInstanceJSONRequestBody defines body for UpdateAppBrickInstance for application/json ContentType.
 type UpdateAppBrickInstanceJSONRequestBody = BrickCreateUpdateRequest
 
 // UpsertAppBrickInstanceJSONRequestBody defines body for UpsertAppBrickInstance for application/json ContentType.
 type UpsertAppBrickInstanceJSONRequestBody = BrickCreateUpdateRequest
 
+// AppSketchAddLocalLibraryJSONRequestBody defines body for AppSketchAddLocalLibrary for application/json ContentType.
+type AppSketchAddLocalLibraryJSONRequestBody = AppSketchAddLocalLibraryJSONBody
+
 // EditAppJSONRequestBody defines body for EditApp for application/json ContentType.
 type EditAppJSONRequestBody = EditRequest
 
 // CloneAppJSONRequestBody defines body for CloneApp for application/json ContentType.
 type CloneAppJSONRequestBody = CloneRequest
 
+// CommitAppJSONRequestBody defines body for CommitApp for application/json ContentType.
+type CommitAppJSONRequestBody = CommitAppRequest
+
+// ImportAIModelJSONRequestBody defines body for ImportAIModel for application/json ContentType.
+type ImportAIModelJSONRequestBody = ImportAIModelJSONBody
+
 // UpdatePropertyJSONRequestBody defines body for UpdateProperty for application/json ContentType.
 type UpdatePropertyJSONRequestBody = UpdatePropertyJSONBody
 
@@ -560,9 +930,17 @@ type ClientInterface interface {
 
 	CreateApp(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAppsChanges request
+	GetAppsChanges(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAppsEvents request
 	GetAppsEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ImportAppWithBody request with any body
+	ImportAppWithBody(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportApp(ctx context.Context, params *ImportAppParams, body ImportAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAppBrickInstances request
 	GetAppBrickInstances(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -582,18 +960,35 @@ type ClientInterface interface {
 
 	UpsertAppBrickInstance(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAppCompatibility request
+	GetAppCompatibility(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAppPorts request
 	GetAppPorts(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AppSketchBuild request
+	AppSketchBuild(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AppSketchListLibraries request
 	AppSketchListLibraries(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AppSketchAddLocalLibraryWithBody request with any body
+	AppSketchAddLocalLibraryWithBody(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AppSketchAddLocalLibrary(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, body AppSketchAddLocalLibraryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppSketchUpgradeLibraries request
+	AppSketchUpgradeLibraries(ctx context.Context, appID string, params *AppSketchUpgradeLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AppSketchRemoveLibrary request
 	AppSketchRemoveLibrary(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// AppSketchAddLibrary request
 	AppSketchAddLibrary(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ValidateApp request
+	ValidateApp(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteApp request
 	DeleteApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -610,20 +1005,37 @@ type ClientInterface interface {
 
 	CloneApp(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CommitAppWithBody request with any body
+	CommitAppWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CommitApp(ctx context.Context, id string, body CommitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAppEvents request
 	GetAppEvents(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ExportApp request
+	ExportApp(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAppLogs request
 	GetAppLogs(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PrepareApp request
+	PrepareApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAppSnapshots request
+	ListAppSnapshots(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreAppSnapshot request
+	RestoreAppSnapshot(ctx context.Context, id string, snapshotID string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// StartApp request
-	StartApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	StartApp(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// StopApp request
 	StopApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetBricks request
-	GetBricks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetBricks(ctx context.Context, params *GetBricksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetBrickDetails request
 	GetBrickDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -634,12 +1046,26 @@ type ClientInterface interface {
 	// ListLibraries request
 	ListLibraries(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetLibraryDetails request
+	GetLibraryDetails(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLibraryDependencies request
+	GetLibraryDependencies(ctx context.Context, name string, params *GetLibraryDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAIModels request
 	GetAIModels(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RemoveAIModel request
+	RemoveAIModel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAIModelDetails request
 	GetAIModelDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ImportAIModelWithBody request with any body
+	ImportAIModelWithBody(ctx context.Context, id string, params *ImportAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportAIModel(ctx context.Context, id string, params *ImportAIModelParams, body ImportAIModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetPropertyKeys request
 	GetPropertyKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -654,6 +1080,12 @@ type ClientInterface interface {
 
 	UpdateProperty(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListSchemas request
+	ListSchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSchema request
+	GetSchema(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSystemResources request
 	GetSystemResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -706,6 +1138,18 @@ func (c *Client) CreateApp(ctx context.Context, params *CreateAppParams, body Cr
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetAppsChanges(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppsChangesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetAppsEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAppsEventsRequest(c.Server)
 	if err != nil {
@@ -718,6 +1162,30 @@ func (c *Client) GetAppsEvents(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
+func (c *Client) ImportAppWithBody(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportAppRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportApp(ctx context.Context, params *ImportAppParams, body ImportAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportAppRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetAppBrickInstances(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAppBrickInstancesRequest(c.Server, appID)
 	if err != nil {
@@ -802,8 +1270,8 @@ func (c *Client) UpsertAppBrickInstance(ctx context.Context, appID string, brick
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAppPorts(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAppPortsRequest(c.Server, appID)
+func (c *Client) GetAppCompatibility(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppCompatibilityRequest(c.Server, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -814,8 +1282,8 @@ func (c *Client) GetAppPorts(ctx context.Context, appID string, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) AppSketchListLibraries(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAppSketchListLibrariesRequest(c.Server, appID)
+func (c *Client) GetAppPorts(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppPortsRequest(c.Server, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -826,8 +1294,8 @@ func (c *Client) AppSketchListLibraries(ctx context.Context, appID string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) AppSketchRemoveLibrary(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAppSketchRemoveLibraryRequest(c.Server, appID, libRef)
+func (c *Client) AppSketchBuild(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchBuildRequest(c.Server, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -838,8 +1306,8 @@ func (c *Client) AppSketchRemoveLibrary(ctx context.Context, appID string, libRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) AppSketchAddLibrary(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAppSketchAddLibraryRequest(c.Server, appID, libRef, params)
+func (c *Client) AppSketchListLibraries(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchListLibrariesRequest(c.Server, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -850,8 +1318,8 @@ func (c *Client) AppSketchAddLibrary(ctx context.Context, appID string, libRef s
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAppRequest(c.Server, id)
+func (c *Client) AppSketchAddLocalLibraryWithBody(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchAddLocalLibraryRequestWithBody(c.Server, appID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -862,8 +1330,8 @@ func (c *Client) DeleteApp(ctx context.Context, id string, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAppDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAppDetailsRequest(c.Server, id)
+func (c *Client) AppSketchAddLocalLibrary(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, body AppSketchAddLocalLibraryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchAddLocalLibraryRequest(c.Server, appID, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -874,8 +1342,8 @@ func (c *Client) GetAppDetails(ctx context.Context, id string, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) EditAppWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEditAppRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) AppSketchUpgradeLibraries(ctx context.Context, appID string, params *AppSketchUpgradeLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchUpgradeLibrariesRequest(c.Server, appID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -886,8 +1354,8 @@ func (c *Client) EditAppWithBody(ctx context.Context, id string, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) EditApp(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEditAppRequest(c.Server, id, body)
+func (c *Client) AppSketchRemoveLibrary(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchRemoveLibraryRequest(c.Server, appID, libRef)
 	if err != nil {
 		return nil, err
 	}
@@ -898,8 +1366,8 @@ func (c *Client) EditApp(ctx context.Context, id string, body EditAppJSONRequest
 	return c.Client.Do(req)
 }
 
-func (c *Client) CloneAppWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCloneAppRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) AppSketchAddLibrary(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSketchAddLibraryRequest(c.Server, appID, libRef, params)
 	if err != nil {
 		return nil, err
 	}
@@ -910,8 +1378,8 @@ func (c *Client) CloneAppWithBody(ctx context.Context, id string, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) CloneApp(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCloneAppRequest(c.Server, id, body)
+func (c *Client) ValidateApp(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateAppRequest(c.Server, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -922,8 +1390,8 @@ func (c *Client) CloneApp(ctx context.Context, id string, body CloneAppJSONReque
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAppEvents(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAppEventsRequest(c.Server, id)
+func (c *Client) DeleteApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAppRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -934,8 +1402,8 @@ func (c *Client) GetAppEvents(ctx context.Context, id string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAppLogs(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAppLogsRequest(c.Server, id, params)
+func (c *Client) GetAppDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppDetailsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -946,8 +1414,8 @@ func (c *Client) GetAppLogs(ctx context.Context, id string, params *GetAppLogsPa
 	return c.Client.Do(req)
 }
 
-func (c *Client) StartApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStartAppRequest(c.Server, id)
+func (c *Client) EditAppWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditAppRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -958,8 +1426,8 @@ func (c *Client) StartApp(ctx context.Context, id string, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) StopApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStopAppRequest(c.Server, id)
+func (c *Client) EditApp(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditAppRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -970,8 +1438,8 @@ func (c *Client) StopApp(ctx context.Context, id string, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBricks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBricksRequest(c.Server)
+func (c *Client) CloneAppWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneAppRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -982,8 +1450,8 @@ func (c *Client) GetBricks(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetBrickDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBrickDetailsRequest(c.Server, id)
+func (c *Client) CloneApp(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneAppRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -994,8 +1462,8 @@ func (c *Client) GetBrickDetails(ctx context.Context, id string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetConfigRequest(c.Server)
+func (c *Client) CommitAppWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommitAppRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1006,8 +1474,8 @@ func (c *Client) GetConfig(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListLibraries(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListLibrariesRequest(c.Server, params)
+func (c *Client) CommitApp(ctx context.Context, id string, body CommitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommitAppRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1018,8 +1486,8 @@ func (c *Client) ListLibraries(ctx context.Context, params *ListLibrariesParams,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAIModels(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAIModelsRequest(c.Server, params)
+func (c *Client) GetAppEvents(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppEventsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1030,8 +1498,8 @@ func (c *Client) GetAIModels(ctx context.Context, params *GetAIModelsParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAIModelDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAIModelDetailsRequest(c.Server, id)
+func (c *Client) ExportApp(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportAppRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1042,8 +1510,8 @@ func (c *Client) GetAIModelDetails(ctx context.Context, id string, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPropertyKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPropertyKeysRequest(c.Server)
+func (c *Client) GetAppLogs(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAppLogsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1054,8 +1522,8 @@ func (c *Client) GetPropertyKeys(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProperty(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePropertyRequest(c.Server, key)
+func (c *Client) PrepareApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPrepareAppRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1066,8 +1534,8 @@ func (c *Client) DeleteProperty(ctx context.Context, key string, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProperty(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPropertyRequest(c.Server, key)
+func (c *Client) ListAppSnapshots(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAppSnapshotsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1078,8 +1546,8 @@ func (c *Client) GetProperty(ctx context.Context, key string, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePropertyWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePropertyRequestWithBody(c.Server, key, contentType, body)
+func (c *Client) RestoreAppSnapshot(ctx context.Context, id string, snapshotID string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreAppSnapshotRequest(c.Server, id, snapshotID)
 	if err != nil {
 		return nil, err
 	}
@@ -1090,8 +1558,8 @@ func (c *Client) UpdatePropertyWithBody(ctx context.Context, key string, content
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProperty(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePropertyRequest(c.Server, key, body)
+func (c *Client) StartApp(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartAppRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1102,8 +1570,8 @@ func (c *Client) UpdateProperty(ctx context.Context, key string, body UpdateProp
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSystemResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSystemResourcesRequest(c.Server)
+func (c *Client) StopApp(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopAppRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1114,8 +1582,8 @@ func (c *Client) GetSystemResources(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApplyUpdate(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApplyUpdateRequest(c.Server, params)
+func (c *Client) GetBricks(ctx context.Context, params *GetBricksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBricksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1126,8 +1594,8 @@ func (c *Client) ApplyUpdate(ctx context.Context, params *ApplyUpdateParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CheckUpdate(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCheckUpdateRequest(c.Server, params)
+func (c *Client) GetBrickDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBrickDetailsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1138,8 +1606,8 @@ func (c *Client) CheckUpdate(ctx context.Context, params *CheckUpdateParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) EventsUpdate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEventsUpdateRequest(c.Server)
+func (c *Client) GetConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConfigRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1150,8 +1618,8 @@ func (c *Client) EventsUpdate(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetVersionsRequest(c.Server)
+func (c *Client) ListLibraries(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLibrariesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1162,9 +1630,237 @@ func (c *Client) GetVersions(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-// NewGetAppsRequest generates requests for GetApps
-func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, error) {
-	var err error
+func (c *Client) GetLibraryDetails(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLibraryDetailsRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLibraryDependencies(ctx context.Context, name string, params *GetLibraryDependenciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLibraryDependenciesRequest(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAIModels(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAIModelsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveAIModel(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveAIModelRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAIModelDetails(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAIModelDetailsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportAIModelWithBody(ctx context.Context, id string, params *ImportAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportAIModelRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportAIModel(ctx context.Context, id string, params *ImportAIModelParams, body ImportAIModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportAIModelRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPropertyKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPropertyKeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteProperty(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePropertyRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProperty(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPropertyRequest(c.Server, key)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePropertyWithBody(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePropertyRequestWithBody(c.Server, key, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProperty(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePropertyRequest(c.Server, key, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSchemasRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSchema(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSchemaRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSystemResources(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSystemResourcesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyUpdate(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyUpdateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckUpdate(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckUpdateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EventsUpdate(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEventsUpdateRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVersionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAppsRequest generates requests for GetApps
+func NewGetAppsRequest(server string, params *GetAppsParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
@@ -1305,8 +2001,8 @@ func NewCreateAppRequestWithBody(server string, params *CreateAppParams, content
 	return req, nil
 }
 
-// NewGetAppsEventsRequest generates requests for GetAppsEvents
-func NewGetAppsEventsRequest(server string) (*http.Request, error) {
+// NewGetAppsChangesRequest generates requests for GetAppsChanges
+func NewGetAppsChangesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1314,7 +2010,7 @@ func NewGetAppsEventsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/events")
+	operationPath := fmt.Sprintf("/v1/apps/changes")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1332,23 +2028,16 @@ func NewGetAppsEventsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetAppBrickInstancesRequest generates requests for GetAppBrickInstances
-func NewGetAppBrickInstancesRequest(server string, appID string) (*http.Request, error) {
+// NewGetAppsEventsRequest generates requests for GetAppsEvents
+func NewGetAppsEventsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/bricks", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/events")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1366,30 +2055,27 @@ func NewGetAppBrickInstancesRequest(server string, appID string) (*http.Request,
 	return req, nil
 }
 
-// NewDeleteAppBrickInstanceRequest generates requests for DeleteAppBrickInstance
-func NewDeleteAppBrickInstanceRequest(server string, appID string, brickID string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
+// NewImportAppRequest calls the generic ImportApp builder with application/json body
+func NewImportAppRequest(server string, params *ImportAppParams, body ImportAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportAppRequestWithBody(server, params, "application/json", bodyReader)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "brickID", runtime.ParamLocationPath, brickID)
-	if err != nil {
-		return nil, err
-	}
+// NewImportAppRequestWithBody generates requests for ImportApp with any type of body
+func NewImportAppRequestWithBody(server string, params *ImportAppParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/bricks/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/apps/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1399,10 +2085,125 @@ func NewDeleteAppBrickInstanceRequest(server string, appID string, brickID strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAppBrickInstancesRequest generates requests for GetAppBrickInstances
+func NewGetAppBrickInstancesRequest(server string, appID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/apps/%s/bricks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAppBrickInstanceRequest generates requests for DeleteAppBrickInstance
+func NewDeleteAppBrickInstanceRequest(server string, appID string, brickID string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "brickID", runtime.ParamLocationPath, brickID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/apps/%s/bricks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
@@ -1556,8 +2357,8 @@ func NewUpsertAppBrickInstanceRequestWithBody(server string, appID string, brick
 	return req, nil
 }
 
-// NewGetAppPortsRequest generates requests for GetAppPorts
-func NewGetAppPortsRequest(server string, appID string) (*http.Request, error) {
+// NewGetAppCompatibilityRequest generates requests for GetAppCompatibility
+func NewGetAppCompatibilityRequest(server string, appID string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1572,7 +2373,7 @@ func NewGetAppPortsRequest(server string, appID string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/exposed-ports", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/compatibility", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1590,8 +2391,8 @@ func NewGetAppPortsRequest(server string, appID string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewAppSketchListLibrariesRequest generates requests for AppSketchListLibraries
-func NewAppSketchListLibrariesRequest(server string, appID string) (*http.Request, error) {
+// NewGetAppPortsRequest generates requests for GetAppPorts
+func NewGetAppPortsRequest(server string, appID string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1606,7 +2407,7 @@ func NewAppSketchListLibrariesRequest(server string, appID string) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/exposed-ports", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1624,8 +2425,8 @@ func NewAppSketchListLibrariesRequest(server string, appID string) (*http.Reques
 	return req, nil
 }
 
-// NewAppSketchRemoveLibraryRequest generates requests for AppSketchRemoveLibrary
-func NewAppSketchRemoveLibraryRequest(server string, appID string, libRef string) (*http.Request, error) {
+// NewAppSketchBuildRequest generates requests for AppSketchBuild
+func NewAppSketchBuildRequest(server string, appID string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1635,19 +2436,12 @@ func NewAppSketchRemoveLibraryRequest(server string, appID string, libRef string
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "libRef", runtime.ParamLocationPath, libRef)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/build", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1657,7 +2451,7 @@ func NewAppSketchRemoveLibraryRequest(server string, appID string, libRef string
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1665,8 +2459,8 @@ func NewAppSketchRemoveLibraryRequest(server string, appID string, libRef string
 	return req, nil
 }
 
-// NewAppSketchAddLibraryRequest generates requests for AppSketchAddLibrary
-func NewAppSketchAddLibraryRequest(server string, appID string, libRef string, params *AppSketchAddLibraryParams) (*http.Request, error) {
+// NewAppSketchListLibrariesRequest generates requests for AppSketchListLibraries
+func NewAppSketchListLibrariesRequest(server string, appID string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1676,9 +2470,47 @@ func NewAppSketchAddLibraryRequest(server string, appID string, libRef string, p
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "libRef", runtime.ParamLocationPath, libRef)
+	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAppSketchAddLocalLibraryRequest calls the generic AppSketchAddLocalLibrary builder with application/json body
+func NewAppSketchAddLocalLibraryRequest(server string, appID string, params *AppSketchAddLocalLibraryParams, body AppSketchAddLocalLibraryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAppSketchAddLocalLibraryRequestWithBody(server, appID, params, "application/json", bodyReader)
+}
+
+// NewAppSketchAddLocalLibraryRequestWithBody generates requests for AppSketchAddLocalLibrary with any type of body
+func NewAppSketchAddLocalLibraryRequestWithBody(server string, appID string, params *AppSketchAddLocalLibraryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -1688,7 +2520,7 @@ func NewAppSketchAddLibraryRequest(server string, appID string, libRef string, p
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/local", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1701,9 +2533,9 @@ func NewAppSketchAddLibraryRequest(server string, appID string, libRef string, p
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.AddDeps != nil {
+		if params.Name != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "add_deps", runtime.ParamLocationQuery, *params.AddDeps); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1720,21 +2552,23 @@ func NewAppSketchAddLibraryRequest(server string, appID string, libRef string, p
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteAppRequest generates requests for DeleteApp
-func NewDeleteAppRequest(server string, id string) (*http.Request, error) {
+// NewAppSketchUpgradeLibrariesRequest generates requests for AppSketchUpgradeLibraries
+func NewAppSketchUpgradeLibrariesRequest(server string, appID string, params *AppSketchUpgradeLibrariesParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -1744,7 +2578,7 @@ func NewDeleteAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/upgrade", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1754,7 +2588,29 @@ func NewDeleteAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1762,13 +2618,20 @@ func NewDeleteAppRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetAppDetailsRequest generates requests for GetAppDetails
-func NewGetAppDetailsRequest(server string, id string) (*http.Request, error) {
+// NewAppSketchRemoveLibraryRequest generates requests for AppSketchRemoveLibrary
+func NewAppSketchRemoveLibraryRequest(server string, appID string, libRef string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "libRef", runtime.ParamLocationPath, libRef)
 	if err != nil {
 		return nil, err
 	}
@@ -1778,7 +2641,7 @@ func NewGetAppDetailsRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1788,7 +2651,7 @@ func NewGetAppDetailsRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1796,24 +2659,20 @@ func NewGetAppDetailsRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewEditAppRequest calls the generic EditApp builder with application/json body
-func NewEditAppRequest(server string, id string, body EditAppJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewAppSketchAddLibraryRequest generates requests for AppSketchAddLibrary
+func NewAppSketchAddLibraryRequest(server string, appID string, libRef string, params *AppSketchAddLibraryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewEditAppRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewEditAppRequestWithBody generates requests for EditApp with any type of body
-func NewEditAppRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "libRef", runtime.ParamLocationPath, libRef)
 	if err != nil {
 		return nil, err
 	}
@@ -1823,7 +2682,7 @@ func NewEditAppRequestWithBody(server string, id string, contentType string, bod
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/sketch/libraries/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1833,34 +2692,43 @@ func NewEditAppRequestWithBody(server string, id string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.AddDeps != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "add_deps", runtime.ParamLocationQuery, *params.AddDeps); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewCloneAppRequest calls the generic CloneApp builder with application/json body
-func NewCloneAppRequest(server string, id string, body CloneAppJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCloneAppRequestWithBody(server, id, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewCloneAppRequestWithBody generates requests for CloneApp with any type of body
-func NewCloneAppRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+// NewValidateAppRequest generates requests for ValidateApp
+func NewValidateAppRequest(server string, appID string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "appID", runtime.ParamLocationPath, appID)
 	if err != nil {
 		return nil, err
 	}
@@ -1870,7 +2738,7 @@ func NewCloneAppRequestWithBody(server string, id string, contentType string, bo
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/clone", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/validate", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1880,18 +2748,16 @@ func NewCloneAppRequestWithBody(server string, id string, contentType string, bo
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetAppEventsRequest generates requests for GetAppEvents
-func NewGetAppEventsRequest(server string, id string) (*http.Request, error) {
+// NewDeleteAppRequest generates requests for DeleteApp
+func NewDeleteAppRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1906,7 +2772,7 @@ func NewGetAppEventsRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/events", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1916,7 +2782,7 @@ func NewGetAppEventsRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1924,8 +2790,8 @@ func NewGetAppEventsRequest(server string, id string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetAppLogsRequest generates requests for GetAppLogs
-func NewGetAppLogsRequest(server string, id string, params *GetAppLogsParams) (*http.Request, error) {
+// NewGetAppDetailsRequest generates requests for GetAppDetails
+func NewGetAppDetailsRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1940,7 +2806,7 @@ func NewGetAppLogsRequest(server string, id string, params *GetAppLogsParams) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/logs", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1950,60 +2816,6 @@ func NewGetAppLogsRequest(server string, id string, params *GetAppLogsParams) (*
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Tail != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail", runtime.ParamLocationQuery, *params.Tail); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Nofollow != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nofollow", runtime.ParamLocationQuery, *params.Nofollow); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2012,8 +2824,19 @@ func NewGetAppLogsRequest(server string, id string, params *GetAppLogsParams) (*
 	return req, nil
 }
 
-// NewStartAppRequest generates requests for StartApp
-func NewStartAppRequest(server string, id string) (*http.Request, error) {
+// NewEditAppRequest calls the generic EditApp builder with application/json body
+func NewEditAppRequest(server string, id string, body EditAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditAppRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewEditAppRequestWithBody generates requests for EditApp with any type of body
+func NewEditAppRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2028,7 +2851,7 @@ func NewStartAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/start", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2038,16 +2861,29 @@ func NewStartAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewStopAppRequest generates requests for StopApp
-func NewStopAppRequest(server string, id string) (*http.Request, error) {
+// NewCloneAppRequest calls the generic CloneApp builder with application/json body
+func NewCloneAppRequest(server string, id string, body CloneAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloneAppRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCloneAppRequestWithBody generates requests for CloneApp with any type of body
+func NewCloneAppRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2062,7 +2898,7 @@ func NewStopAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/apps/%s/stop", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/clone", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2072,24 +2908,44 @@ func NewStopAppRequest(server string, id string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetBricksRequest generates requests for GetBricks
-func NewGetBricksRequest(server string) (*http.Request, error) {
+// NewCommitAppRequest calls the generic CommitApp builder with application/json body
+func NewCommitAppRequest(server string, id string, body CommitAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCommitAppRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCommitAppRequestWithBody generates requests for CommitApp with any type of body
+func NewCommitAppRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/bricks")
+	operationPath := fmt.Sprintf("/v1/apps/%s/commit", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2099,16 +2955,18 @@ func NewGetBricksRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetBrickDetailsRequest generates requests for GetBrickDetails
-func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error) {
+// NewGetAppEventsRequest generates requests for GetAppEvents
+func NewGetAppEventsRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2123,7 +2981,7 @@ func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/bricks/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/events", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2141,16 +2999,23 @@ func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetConfigRequest generates requests for GetConfig
-func NewGetConfigRequest(server string) (*http.Request, error) {
+// NewExportAppRequest generates requests for ExportApp
+func NewExportAppRequest(server string, id string, params *ExportAppParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/config")
+	operationPath := fmt.Sprintf("/v1/apps/%s/export", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2160,6 +3025,44 @@ func NewGetConfigRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeData != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_data", runtime.ParamLocationQuery, *params.IncludeData); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2168,16 +3071,23 @@ func NewGetConfigRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewListLibrariesRequest generates requests for ListLibraries
-func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.Request, error) {
+// NewGetAppLogsRequest generates requests for GetAppLogs
+func NewGetAppLogsRequest(server string, id string, params *GetAppLogsParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/libraries")
+	operationPath := fmt.Sprintf("/v1/apps/%s/logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2190,9 +3100,9 @@ func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Search != nil {
+		if params.Filter != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2206,9 +3116,9 @@ func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.
 
 		}
 
-		if params.Architecture != nil {
+		if params.Tail != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "architecture", runtime.ParamLocationQuery, *params.Architecture); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tail", runtime.ParamLocationQuery, *params.Tail); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2222,9 +3132,9 @@ func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.
 
 		}
 
-		if params.Platform != nil {
+		if params.Nofollow != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "platform", runtime.ParamLocationQuery, *params.Platform); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nofollow", runtime.ParamLocationQuery, *params.Nofollow); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2238,56 +3148,8 @@ func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.
 
 		}
 
-		if params.Sort != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -2297,16 +3159,23 @@ func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.
 	return req, nil
 }
 
-// NewGetAIModelsRequest generates requests for GetAIModels
-func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Request, error) {
+// NewPrepareAppRequest generates requests for PrepareApp
+func NewPrepareAppRequest(server string, id string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/models")
+	operationPath := fmt.Sprintf("/v1/apps/%s/prepare", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2316,29 +3185,7 @@ func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Requ
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Bricks != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bricks", runtime.ParamLocationQuery, *params.Bricks); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2346,8 +3193,8 @@ func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Requ
 	return req, nil
 }
 
-// NewGetAIModelDetailsRequest generates requests for GetAIModelDetails
-func NewGetAIModelDetailsRequest(server string, id string) (*http.Request, error) {
+// NewListAppSnapshotsRequest generates requests for ListAppSnapshots
+func NewListAppSnapshotsRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2362,7 +3209,7 @@ func NewGetAIModelDetailsRequest(server string, id string) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/models/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/snapshots", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2380,16 +3227,30 @@ func NewGetAIModelDetailsRequest(server string, id string) (*http.Request, error
 	return req, nil
 }
 
-// NewGetPropertyKeysRequest generates requests for GetPropertyKeys
-func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
+// NewRestoreAppSnapshotRequest generates requests for RestoreAppSnapshot
+func NewRestoreAppSnapshotRequest(server string, id string, snapshotID string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "snapshotID", runtime.ParamLocationPath, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/properties")
+	operationPath := fmt.Sprintf("/v1/apps/%s/snapshots/%s/restore", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2399,7 +3260,7 @@ func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2407,13 +3268,13 @@ func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeletePropertyRequest generates requests for DeleteProperty
-func NewDeletePropertyRequest(server string, key string) (*http.Request, error) {
+// NewStartAppRequest generates requests for StartApp
+func NewStartAppRequest(server string, id string, params *StartAppParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2423,7 +3284,7 @@ func NewDeletePropertyRequest(server string, key string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/start", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2433,7 +3294,61 @@ func NewDeletePropertyRequest(server string, key string) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Watch != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "watch", runtime.ParamLocationQuery, *params.Watch); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ForceUpload != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force_upload", runtime.ParamLocationQuery, *params.ForceUpload); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offline != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offline", runtime.ParamLocationQuery, *params.Offline); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2441,13 +3356,13 @@ func NewDeletePropertyRequest(server string, key string) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetPropertyRequest generates requests for GetProperty
-func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
+// NewStopAppRequest generates requests for StopApp
+func NewStopAppRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2457,7 +3372,7 @@ func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/apps/%s/stop", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2467,7 +3382,7 @@ func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2475,24 +3390,62 @@ func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdatePropertyRequest calls the generic UpdateProperty builder with application/json body
-func NewUpdatePropertyRequest(server string, key string, body UpdatePropertyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetBricksRequest generates requests for GetBricks
+func NewGetBricksRequest(server string, params *GetBricksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePropertyRequestWithBody(server, key, "application/json", bodyReader)
+
+	operationPath := fmt.Sprintf("/v1/bricks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Compatible != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "compatible", runtime.ParamLocationQuery, *params.Compatible); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewUpdatePropertyRequestWithBody generates requests for UpdateProperty with any type of body
-func NewUpdatePropertyRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetBrickDetailsRequest generates requests for GetBrickDetails
+func NewGetBrickDetailsRequest(server string, id string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2502,7 +3455,7 @@ func NewUpdatePropertyRequestWithBody(server string, key string, contentType str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/bricks/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2512,18 +3465,16 @@ func NewUpdatePropertyRequestWithBody(server string, key string, contentType str
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetSystemResourcesRequest generates requests for GetSystemResources
-func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
+// NewGetConfigRequest generates requests for GetConfig
+func NewGetConfigRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2531,7 +3482,7 @@ func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/resources")
+	operationPath := fmt.Sprintf("/v1/config")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2549,8 +3500,8 @@ func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewApplyUpdateRequest generates requests for ApplyUpdate
-func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Request, error) {
+// NewListLibrariesRequest generates requests for ListLibraries
+func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2558,7 +3509,7 @@ func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/update/apply")
+	operationPath := fmt.Sprintf("/v1/libraries")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2571,9 +3522,9 @@ func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.OnlyArduino != nil {
+		if params.Search != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2587,27 +3538,148 @@ func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Requ
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+		if params.Architecture != nil {
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "architecture", runtime.ParamLocationQuery, *params.Architecture); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Platform != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "platform", runtime.ParamLocationQuery, *params.Platform); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
-// NewCheckUpdateRequest generates requests for CheckUpdate
-func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Request, error) {
+// NewGetLibraryDetailsRequest generates requests for GetLibraryDetails
+func NewGetLibraryDetailsRequest(server string, name string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/update/check")
+	operationPath := fmt.Sprintf("/v1/libraries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLibraryDependenciesRequest generates requests for GetLibraryDependencies
+func NewGetLibraryDependenciesRequest(server string, name string, params *GetLibraryDependenciesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/libraries/%s/dependencies", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2620,9 +3692,9 @@ func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.OnlyArduino != nil {
+		if params.Version != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -2647,8 +3719,8 @@ func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Requ
 	return req, nil
 }
 
-// NewEventsUpdateRequest generates requests for EventsUpdate
-func NewEventsUpdateRequest(server string) (*http.Request, error) {
+// NewGetAIModelsRequest generates requests for GetAIModels
+func NewGetAIModelsRequest(server string, params *GetAIModelsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2656,7 +3728,7 @@ func NewEventsUpdateRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/system/update/events")
+	operationPath := fmt.Sprintf("/v1/models")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2666,6 +3738,60 @@ func NewEventsUpdateRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Bricks != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bricks", runtime.ParamLocationQuery, *params.Bricks); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Runner != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runner", runtime.ParamLocationQuery, *params.Runner); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Modality != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "modality", runtime.ParamLocationQuery, *params.Modality); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2674,16 +3800,23 @@ func NewEventsUpdateRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetVersionsRequest generates requests for GetVersions
-func NewGetVersionsRequest(server string) (*http.Request, error) {
+// NewRemoveAIModelRequest generates requests for RemoveAIModel
+func NewRemoveAIModelRequest(server string, id string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/version")
+	operationPath := fmt.Sprintf("/v1/models/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2693,7 +3826,7 @@ func NewGetVersionsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2701,176 +3834,1610 @@ func NewGetVersionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetAIModelDetailsRequest generates requests for GetAIModelDetails
+func NewGetAIModelDetailsRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/v1/models/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewImportAIModelRequest calls the generic ImportAIModel builder with application/json body
+func NewImportAIModelRequest(server string, id string, params *ImportAIModelParams, body ImportAIModelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportAIModelRequestWithBody(server, id, params, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAppsWithResponse request
-	GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error)
+// NewImportAIModelRequestWithBody generates requests for ImportAIModel with any type of body
+func NewImportAIModelRequestWithBody(server string, id string, params *ImportAIModelParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// CreateAppWithBodyWithResponse request with any body
-	CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+	var pathParam0 string
 
-	CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/models/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Description != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "description", runtime.ParamLocationQuery, *params.Description); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Runner != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runner", runtime.ParamLocationQuery, *params.Runner); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RunnerImage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "runner_image", runtime.ParamLocationQuery, *params.RunnerImage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.InputModality != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "input_modality", runtime.ParamLocationQuery, *params.InputModality); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Bricks != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bricks", runtime.ParamLocationQuery, *params.Bricks); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.InputShape != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "input_shape", runtime.ParamLocationQuery, *params.InputShape); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPropertyKeysRequest generates requests for GetPropertyKeys
+func NewGetPropertyKeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/properties")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeletePropertyRequest generates requests for DeleteProperty
+func NewDeletePropertyRequest(server string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPropertyRequest generates requests for GetProperty
+func NewGetPropertyRequest(server string, key string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePropertyRequest calls the generic UpdateProperty builder with application/json body
+func NewUpdatePropertyRequest(server string, key string, body UpdatePropertyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePropertyRequestWithBody(server, key, "application/json", bodyReader)
+}
+
+// NewUpdatePropertyRequestWithBody generates requests for UpdateProperty with any type of body
+func NewUpdatePropertyRequestWithBody(server string, key string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/properties/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSchemasRequest generates requests for ListSchemas
+func NewListSchemasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/schemas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSchemaRequest generates requests for GetSchema
+func NewGetSchemaRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/schemas/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSystemResourcesRequest generates requests for GetSystemResources
+func NewGetSystemResourcesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/resources")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApplyUpdateRequest generates requests for ApplyUpdate
+func NewApplyUpdateRequest(server string, params *ApplyUpdateParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/update/apply")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OnlyArduino != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCheckUpdateRequest generates requests for CheckUpdate
+func NewCheckUpdateRequest(server string, params *CheckUpdateParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/update/check")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OnlyArduino != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "only-arduino", runtime.ParamLocationQuery, *params.OnlyArduino); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEventsUpdateRequest generates requests for EventsUpdate
+func NewEventsUpdateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/system/update/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetVersionsRequest generates requests for GetVersions
+func NewGetVersionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAppsWithResponse request
+	GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error)
+
+	// CreateAppWithBodyWithResponse request with any body
+	CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+
+	CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error)
+
+	// GetAppsChangesWithResponse request
+	GetAppsChangesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsChangesResp, error)
 
 	// GetAppsEventsWithResponse request
 	GetAppsEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsEventsResp, error)
 
-	// GetAppBrickInstancesWithResponse request
-	GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error)
+	// ImportAppWithBodyWithResponse request with any body
+	ImportAppWithBodyWithResponse(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAppResp, error)
+
+	ImportAppWithResponse(ctx context.Context, params *ImportAppParams, body ImportAppJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportAppResp, error)
+
+	// GetAppBrickInstancesWithResponse request
+	GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error)
+
+	// DeleteAppBrickInstanceWithResponse request
+	DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error)
+
+	// GetAppBrickInstanceByBrickIDWithResponse request
+	GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error)
+
+	// UpdateAppBrickInstanceWithBodyWithResponse request with any body
+	UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+
+	UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+
+	// UpsertAppBrickInstanceWithBodyWithResponse request with any body
+	UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+
+	UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+
+	// GetAppCompatibilityWithResponse request
+	GetAppCompatibilityWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppCompatibilityResp, error)
+
+	// GetAppPortsWithResponse request
+	GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error)
+
+	// AppSketchBuildWithResponse request
+	AppSketchBuildWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchBuildResp, error)
+
+	// AppSketchListLibrariesWithResponse request
+	AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error)
+
+	// AppSketchAddLocalLibraryWithBodyWithResponse request with any body
+	AppSketchAddLocalLibraryWithBodyWithResponse(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppSketchAddLocalLibraryResp, error)
+
+	AppSketchAddLocalLibraryWithResponse(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, body AppSketchAddLocalLibraryJSONRequestBody, reqEditors ...RequestEditorFn) (*AppSketchAddLocalLibraryResp, error)
+
+	// AppSketchUpgradeLibrariesWithResponse request
+	AppSketchUpgradeLibrariesWithResponse(ctx context.Context, appID string, params *AppSketchUpgradeLibrariesParams, reqEditors ...RequestEditorFn) (*AppSketchUpgradeLibrariesResp, error)
+
+	// AppSketchRemoveLibraryWithResponse request
+	AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error)
+
+	// AppSketchAddLibraryWithResponse request
+	AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error)
+
+	// ValidateAppWithResponse request
+	ValidateAppWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*ValidateAppResp, error)
+
+	// DeleteAppWithResponse request
+	DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error)
+
+	// GetAppDetailsWithResponse request
+	GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error)
+
+	// EditAppWithBodyWithResponse request with any body
+	EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+
+	EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+
+	// CloneAppWithBodyWithResponse request with any body
+	CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+
+	CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+
+	// CommitAppWithBodyWithResponse request with any body
+	CommitAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitAppResp, error)
+
+	CommitAppWithResponse(ctx context.Context, id string, body CommitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitAppResp, error)
+
+	// GetAppEventsWithResponse request
+	GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error)
+
+	// ExportAppWithResponse request
+	ExportAppWithResponse(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*ExportAppResp, error)
+
+	// GetAppLogsWithResponse request
+	GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error)
+
+	// PrepareAppWithResponse request
+	PrepareAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PrepareAppResp, error)
+
+	// ListAppSnapshotsWithResponse request
+	ListAppSnapshotsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ListAppSnapshotsResp, error)
+
+	// RestoreAppSnapshotWithResponse request
+	RestoreAppSnapshotWithResponse(ctx context.Context, id string, snapshotID string, reqEditors ...RequestEditorFn) (*RestoreAppSnapshotResp, error)
+
+	// StartAppWithResponse request
+	StartAppWithResponse(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*StartAppResp, error)
+
+	// StopAppWithResponse request
+	StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error)
+
+	// GetBricksWithResponse request
+	GetBricksWithResponse(ctx context.Context, params *GetBricksParams, reqEditors ...RequestEditorFn) (*GetBricksResp, error)
+
+	// GetBrickDetailsWithResponse request
+	GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error)
+
+	// GetConfigWithResponse request
+	GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error)
+
+	// ListLibrariesWithResponse request
+	ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error)
+
+	// GetLibraryDetailsWithResponse request
+	GetLibraryDetailsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetLibraryDetailsResp, error)
+
+	// GetLibraryDependenciesWithResponse request
+	GetLibraryDependenciesWithResponse(ctx context.Context, name string, params *GetLibraryDependenciesParams, reqEditors ...RequestEditorFn) (*GetLibraryDependenciesResp, error)
+
+	// GetAIModelsWithResponse request
+	GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error)
+
+	// RemoveAIModelWithResponse request
+	RemoveAIModelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RemoveAIModelResp, error)
+
+	// GetAIModelDetailsWithResponse request
+	GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error)
+
+	// ImportAIModelWithBodyWithResponse request with any body
+	ImportAIModelWithBodyWithResponse(ctx context.Context, id string, params *ImportAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAIModelResp, error)
+
+	ImportAIModelWithResponse(ctx context.Context, id string, params *ImportAIModelParams, body ImportAIModelJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportAIModelResp, error)
+
+	// GetPropertyKeysWithResponse request
+	GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error)
+
+	// DeletePropertyWithResponse request
+	DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error)
+
+	// GetPropertyWithResponse request
+	GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error)
+
+	// UpdatePropertyWithBodyWithResponse request with any body
+	UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+
+	UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+
+	// ListSchemasWithResponse request
+	ListSchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSchemasResp, error)
+
+	// GetSchemaWithResponse request
+	GetSchemaWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSchemaResp, error)
+
+	// GetSystemResourcesWithResponse request
+	GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error)
+
+	// ApplyUpdateWithResponse request
+	ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error)
+
+	// CheckUpdateWithResponse request
+	CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error)
+
+	// EventsUpdateWithResponse request
+	EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error)
+
+	// GetVersionsWithResponse request
+	GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error)
+}
+
+type GetAppsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppListResponse
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CreateAppResponse
+	JSON400      *BadRequest
+	JSON409      *Conflict
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppsChangesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppsChangesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppsChangesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppsEventsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppsEventsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppsEventsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ImportAppResponse
+	JSON400      *BadRequest
+	JSON409      *Conflict
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppBrickInstancesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppBrickInstancesResult
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppBrickInstancesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppBrickInstancesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppBrickInstanceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppBrickInstanceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppBrickInstanceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppBrickInstanceByBrickIDResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrickInstance
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppBrickInstanceByBrickIDResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppBrickInstanceByBrickIDResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAppBrickInstanceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppBrickInstanceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppBrickInstanceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpsertAppBrickInstanceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpsertAppBrickInstanceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpsertAppBrickInstanceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppCompatibilityResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppCompatibilityResult
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppCompatibilityResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppCompatibilityResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppPortsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppPortResponse
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppPortsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppPortsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppSketchBuildResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchBuildResult
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AppSketchBuildResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchBuildResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppSketchListLibrariesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchListLibraryResponse
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r AppSketchListLibrariesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteAppBrickInstanceWithResponse request
-	DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchListLibrariesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetAppBrickInstanceByBrickIDWithResponse request
-	GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error)
+type AppSketchAddLocalLibraryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SketchAddLibraryResponse
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// UpdateAppBrickInstanceWithBodyWithResponse request with any body
-	UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+// Status returns HTTPResponse.Status
+func (r AppSketchAddLocalLibraryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchAddLocalLibraryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpsertAppBrickInstanceWithBodyWithResponse request with any body
-	UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+type AppSketchUpgradeLibrariesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchUpgradeLibrariesResponse
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error)
+// Status returns HTTPResponse.Status
+func (r AppSketchUpgradeLibrariesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetAppPortsWithResponse request
-	GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchUpgradeLibrariesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// AppSketchListLibrariesWithResponse request
-	AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error)
+type AppSketchRemoveLibraryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchRemoveLibraryResponse
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// AppSketchRemoveLibraryWithResponse request
-	AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error)
+// Status returns HTTPResponse.Status
+func (r AppSketchRemoveLibraryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// AppSketchAddLibraryWithResponse request
-	AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchRemoveLibraryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeleteAppWithResponse request
-	DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error)
+type AppSketchAddLibraryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SketchAddLibraryResponse
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// GetAppDetailsWithResponse request
-	GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error)
+// Status returns HTTPResponse.Status
+func (r AppSketchAddLibraryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSketchAddLibraryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppValidationResult
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppDetailsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDetailedInfo
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppDetailsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppDetailsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDetailedInfo
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r EditAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CloneAppResponse
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON409      *Conflict
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommitAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AppGitCommit
+	JSON400      *BadRequest
+	JSON409      *Conflict
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CommitAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommitAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// EditAppWithBodyWithResponse request with any body
-	EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+type GetAppEventsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *InternalServerError
+}
 
-	EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error)
+// Status returns HTTPResponse.Status
+func (r GetAppEventsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CloneAppWithBodyWithResponse request with any body
-	CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppEventsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error)
+type ExportAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// GetAppEventsWithResponse request
-	GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error)
+// Status returns HTTPResponse.Status
+func (r ExportAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetAppLogsWithResponse request
-	GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// StartAppWithResponse request
-	StartAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartAppResp, error)
+type GetAppLogsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// StopAppWithResponse request
-	StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error)
+// Status returns HTTPResponse.Status
+func (r GetAppLogsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetBricksWithResponse request
-	GetBricksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBricksResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppLogsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetBrickDetailsWithResponse request
-	GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error)
+type PrepareAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// GetConfigWithResponse request
-	GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error)
+// Status returns HTTPResponse.Status
+func (r PrepareAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// ListLibrariesWithResponse request
-	ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r PrepareAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetAIModelsWithResponse request
-	GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error)
+type ListAppSnapshotsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppSnapshotListResponse
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// GetAIModelDetailsWithResponse request
-	GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error)
+// Status returns HTTPResponse.Status
+func (r ListAppSnapshotsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetPropertyKeysWithResponse request
-	GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAppSnapshotsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeletePropertyWithResponse request
-	DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error)
+type RestoreAppSnapshotResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppSnapshot
+	JSON400      *BadRequest
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// GetPropertyWithResponse request
-	GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error)
+// Status returns HTTPResponse.Status
+func (r RestoreAppSnapshotResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdatePropertyWithBodyWithResponse request with any body
-	UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreAppSnapshotResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error)
+type StartAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// GetSystemResourcesWithResponse request
-	GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error)
+// Status returns HTTPResponse.Status
+func (r StartAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// ApplyUpdateWithResponse request
-	ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CheckUpdateWithResponse request
-	CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error)
+type StopAppResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON412      *PreconditionFailed
+	JSON500      *InternalServerError
+}
 
-	// EventsUpdateWithResponse request
-	EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error)
+// Status returns HTTPResponse.Status
+func (r StopAppResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetVersionsWithResponse request
-	GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopAppResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetAppsResp struct {
+type GetBricksResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppListResponse
+	JSON200      *BrickListResult
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppsResp) Status() string {
+func (r GetBricksResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2878,24 +5445,24 @@ func (r GetAppsResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppsResp) StatusCode() int {
+func (r GetBricksResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAppResp struct {
+type GetBrickDetailsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *CreateAppResponse
+	JSON200      *BrickDetailsResult
 	JSON400      *BadRequest
-	JSON409      *Conflict
+	JSON404      *NotFound
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAppResp) Status() string {
+func (r GetBrickDetailsResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2903,21 +5470,22 @@ func (r CreateAppResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppResp) StatusCode() int {
+func (r GetBrickDetailsResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppsEventsResp struct {
+type GetConfigResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ConfigResponse
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppsEventsResp) Status() string {
+func (r GetConfigResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2925,23 +5493,23 @@ func (r GetAppsEventsResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppsEventsResp) StatusCode() int {
+func (r GetConfigResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppBrickInstancesResp struct {
+type ListLibrariesResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppBrickInstancesResult
-	JSON412      *PreconditionFailed
+	JSON200      *LibraryListResponse
+	JSON400      *BadRequest
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppBrickInstancesResp) Status() string {
+func (r ListLibrariesResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2949,23 +5517,23 @@ func (r GetAppBrickInstancesResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppBrickInstancesResp) StatusCode() int {
+func (r ListLibrariesResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppBrickInstanceResp struct {
+type GetLibraryDetailsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON200      *LibraryInfo
+	JSON404      *NotFound
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppBrickInstanceResp) Status() string {
+func (r GetLibraryDetailsResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2973,24 +5541,23 @@ func (r DeleteAppBrickInstanceResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppBrickInstanceResp) StatusCode() int {
+func (r GetLibraryDetailsResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppBrickInstanceByBrickIDResp struct {
+type GetLibraryDependenciesResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BrickInstance
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON200      *LibraryDependencyNode
+	JSON404      *NotFound
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppBrickInstanceByBrickIDResp) Status() string {
+func (r GetLibraryDependenciesResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -2998,23 +5565,22 @@ func (r GetAppBrickInstanceByBrickIDResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppBrickInstanceByBrickIDResp) StatusCode() int {
+func (r GetLibraryDependenciesResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAppBrickInstanceResp struct {
+type GetAIModelsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON200      *AIModelsListResult
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAppBrickInstanceResp) Status() string {
+func (r GetAIModelsResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3022,23 +5588,23 @@ func (r UpdateAppBrickInstanceResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAppBrickInstanceResp) StatusCode() int {
+func (r GetAIModelsResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpsertAppBrickInstanceResp struct {
+type RemoveAIModelResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON404      *NotFound
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r UpsertAppBrickInstanceResp) Status() string {
+func (r RemoveAIModelResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3046,23 +5612,22 @@ func (r UpsertAppBrickInstanceResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpsertAppBrickInstanceResp) StatusCode() int {
+func (r RemoveAIModelResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppPortsResp struct {
+type GetAIModelDetailsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppPortResponse
-	JSON412      *PreconditionFailed
+	JSON200      *AIModelItem
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppPortsResp) Status() string {
+func (r GetAIModelDetailsResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3070,24 +5635,24 @@ func (r GetAppPortsResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppPortsResp) StatusCode() int {
+func (r GetAIModelDetailsResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AppSketchListLibrariesResp struct {
+type ImportAIModelResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SketchListLibraryResponse
+	JSON201      *AIModelItem
 	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON409      *Conflict
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r AppSketchListLibrariesResp) Status() string {
+func (r ImportAIModelResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3095,24 +5660,22 @@ func (r AppSketchListLibrariesResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AppSketchListLibrariesResp) StatusCode() int {
+func (r ImportAIModelResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AppSketchRemoveLibraryResp struct {
+type GetPropertyKeysResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SketchRemoveLibraryResponse
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON200      *PropertyKeysResponse
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r AppSketchRemoveLibraryResp) Status() string {
+func (r GetPropertyKeysResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3120,24 +5683,24 @@ func (r AppSketchRemoveLibraryResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AppSketchRemoveLibraryResp) StatusCode() int {
+func (r GetPropertyKeysResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type AppSketchAddLibraryResp struct {
+type DeletePropertyResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SketchAddLibraryResponse
+	JSON204      *string
 	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON404      *NotFound
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r AppSketchAddLibraryResp) Status() string {
+func (r DeletePropertyResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3145,23 +5708,23 @@ func (r AppSketchAddLibraryResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r AppSketchAddLibraryResp) StatusCode() int {
+func (r DeletePropertyResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppResp struct {
+type GetPropertyResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON404      *NotFound
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppResp) Status() string {
+func (r GetPropertyResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3169,23 +5732,23 @@ func (r DeleteAppResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppResp) StatusCode() int {
+func (r GetPropertyResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppDetailsResp struct {
+type UpdatePropertyResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDetailedInfo
-	JSON412      *PreconditionFailed
+	JSON400      *BadRequest
+	JSON404      *NotFound
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppDetailsResp) Status() string {
+func (r UpdatePropertyResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3193,24 +5756,21 @@ func (r GetAppDetailsResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppDetailsResp) StatusCode() int {
+func (r UpdatePropertyResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type EditAppResp struct {
+type ListSchemasResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDetailedInfo
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+	JSON200      *SchemaListResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r EditAppResp) Status() string {
+func (r ListSchemasResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3218,26 +5778,23 @@ func (r EditAppResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r EditAppResp) StatusCode() int {
+func (r ListSchemasResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CloneAppResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CloneAppResponse
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON409      *Conflict
-	JSON412      *PreconditionFailed
-	JSON500      *InternalServerError
+type GetSchemaResp struct {
+	Body                     []byte
+	HTTPResponse             *http.Response
+	ApplicationschemaJSON200 *map[string]interface{}
+	JSON404                  *NotFound
+	JSON500                  *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r CloneAppResp) Status() string {
+func (r GetSchemaResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3245,21 +5802,21 @@ func (r CloneAppResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CloneAppResp) StatusCode() int {
+func (r GetSchemaResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppEventsResp struct {
+type GetSystemResourcesResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppEventsResp) Status() string {
+func (r GetSystemResourcesResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3267,23 +5824,23 @@ func (r GetAppEventsResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppEventsResp) StatusCode() int {
+func (r GetSystemResourcesResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppLogsResp struct {
+type ApplyUpdateResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON412      *PreconditionFailed
+	JSON204      *NoContent
+	JSON409      *Conflict
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppLogsResp) Status() string {
+func (r ApplyUpdateResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3291,22 +5848,24 @@ func (r GetAppLogsResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppLogsResp) StatusCode() int {
+func (r ApplyUpdateResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StartAppResp struct {
+type CheckUpdateResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON412      *PreconditionFailed
+	JSON200      *UpdateCheckResult
+	JSON204      *NoContent
+	JSON400      *BadRequest
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r StartAppResp) Status() string {
+func (r CheckUpdateResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3314,22 +5873,21 @@ func (r StartAppResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StartAppResp) StatusCode() int {
+func (r CheckUpdateResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type StopAppResp struct {
+type EventsUpdateResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON412      *PreconditionFailed
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r StopAppResp) Status() string {
+func (r EventsUpdateResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3337,22 +5895,22 @@ func (r StopAppResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r StopAppResp) StatusCode() int {
+func (r EventsUpdateResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetBricksResp struct {
+type GetVersionsResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BrickListResult
+	JSON200      *VersionResponse
 	JSON500      *InternalServerError
 }
 
 // Status returns HTTPResponse.Status
-func (r GetBricksResp) Status() string {
+func (r GetVersionsResp) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -3360,727 +5918,1118 @@ func (r GetBricksResp) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetBricksResp) StatusCode() int {
+func (r GetVersionsResp) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
+	return 0
+}
+
+// GetAppsWithResponse request returning *GetAppsResp
+func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error) {
+	rsp, err := c.GetApps(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppsResp(rsp)
+}
+
+// CreateAppWithBodyWithResponse request with arbitrary body returning *CreateAppResp
+func (c *ClientWithResponses) CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
+	rsp, err := c.CreateAppWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
+	rsp, err := c.CreateApp(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAppResp(rsp)
+}
+
+// GetAppsChangesWithResponse request returning *GetAppsChangesResp
+func (c *ClientWithResponses) GetAppsChangesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsChangesResp, error) {
+	rsp, err := c.GetAppsChanges(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppsChangesResp(rsp)
+}
+
+// GetAppsEventsWithResponse request returning *GetAppsEventsResp
+func (c *ClientWithResponses) GetAppsEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsEventsResp, error) {
+	rsp, err := c.GetAppsEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppsEventsResp(rsp)
+}
+
+// ImportAppWithBodyWithResponse request with arbitrary body returning *ImportAppResp
+func (c *ClientWithResponses) ImportAppWithBodyWithResponse(ctx context.Context, params *ImportAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAppResp, error) {
+	rsp, err := c.ImportAppWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportAppResp(rsp)
+}
+
+func (c *ClientWithResponses) ImportAppWithResponse(ctx context.Context, params *ImportAppParams, body ImportAppJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportAppResp, error) {
+	rsp, err := c.ImportApp(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportAppResp(rsp)
+}
+
+// GetAppBrickInstancesWithResponse request returning *GetAppBrickInstancesResp
+func (c *ClientWithResponses) GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error) {
+	rsp, err := c.GetAppBrickInstances(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppBrickInstancesResp(rsp)
+}
+
+// DeleteAppBrickInstanceWithResponse request returning *DeleteAppBrickInstanceResp
+func (c *ClientWithResponses) DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error) {
+	rsp, err := c.DeleteAppBrickInstance(ctx, appID, brickID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppBrickInstanceResp(rsp)
+}
+
+// GetAppBrickInstanceByBrickIDWithResponse request returning *GetAppBrickInstanceByBrickIDResp
+func (c *ClientWithResponses) GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error) {
+	rsp, err := c.GetAppBrickInstanceByBrickID(ctx, appID, brickID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppBrickInstanceByBrickIDResp(rsp)
+}
+
+// UpdateAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpdateAppBrickInstanceResp
+func (c *ClientWithResponses) UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
+	rsp, err := c.UpdateAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppBrickInstanceResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
+	rsp, err := c.UpdateAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAppBrickInstanceResp(rsp)
+}
+
+// UpsertAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpsertAppBrickInstanceResp
+func (c *ClientWithResponses) UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
+	rsp, err := c.UpsertAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertAppBrickInstanceResp(rsp)
+}
+
+func (c *ClientWithResponses) UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
+	rsp, err := c.UpsertAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpsertAppBrickInstanceResp(rsp)
+}
+
+// GetAppCompatibilityWithResponse request returning *GetAppCompatibilityResp
+func (c *ClientWithResponses) GetAppCompatibilityWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppCompatibilityResp, error) {
+	rsp, err := c.GetAppCompatibility(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppCompatibilityResp(rsp)
+}
+
+// GetAppPortsWithResponse request returning *GetAppPortsResp
+func (c *ClientWithResponses) GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error) {
+	rsp, err := c.GetAppPorts(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAppPortsResp(rsp)
+}
+
+// AppSketchBuildWithResponse request returning *AppSketchBuildResp
+func (c *ClientWithResponses) AppSketchBuildWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchBuildResp, error) {
+	rsp, err := c.AppSketchBuild(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSketchBuildResp(rsp)
+}
+
+// AppSketchListLibrariesWithResponse request returning *AppSketchListLibrariesResp
+func (c *ClientWithResponses) AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error) {
+	rsp, err := c.AppSketchListLibraries(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSketchListLibrariesResp(rsp)
 }
 
-type GetBrickDetailsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BrickDetailsResult
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+// AppSketchAddLocalLibraryWithBodyWithResponse request with arbitrary body returning *AppSketchAddLocalLibraryResp
+func (c *ClientWithResponses) AppSketchAddLocalLibraryWithBodyWithResponse(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppSketchAddLocalLibraryResp, error) {
+	rsp, err := c.AppSketchAddLocalLibraryWithBody(ctx, appID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSketchAddLocalLibraryResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetBrickDetailsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) AppSketchAddLocalLibraryWithResponse(ctx context.Context, appID string, params *AppSketchAddLocalLibraryParams, body AppSketchAddLocalLibraryJSONRequestBody, reqEditors ...RequestEditorFn) (*AppSketchAddLocalLibraryResp, error) {
+	rsp, err := c.AppSketchAddLocalLibrary(ctx, appID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAppSketchAddLocalLibraryResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBrickDetailsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AppSketchUpgradeLibrariesWithResponse request returning *AppSketchUpgradeLibrariesResp
+func (c *ClientWithResponses) AppSketchUpgradeLibrariesWithResponse(ctx context.Context, appID string, params *AppSketchUpgradeLibrariesParams, reqEditors ...RequestEditorFn) (*AppSketchUpgradeLibrariesResp, error) {
+	rsp, err := c.AppSketchUpgradeLibraries(ctx, appID, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAppSketchUpgradeLibrariesResp(rsp)
 }
 
-type GetConfigResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ConfigResponse
-	JSON500      *InternalServerError
+// AppSketchRemoveLibraryWithResponse request returning *AppSketchRemoveLibraryResp
+func (c *ClientWithResponses) AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error) {
+	rsp, err := c.AppSketchRemoveLibrary(ctx, appID, libRef, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSketchRemoveLibraryResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetConfigResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AppSketchAddLibraryWithResponse request returning *AppSketchAddLibraryResp
+func (c *ClientWithResponses) AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error) {
+	rsp, err := c.AppSketchAddLibrary(ctx, appID, libRef, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAppSketchAddLibraryResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetConfigResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ValidateAppWithResponse request returning *ValidateAppResp
+func (c *ClientWithResponses) ValidateAppWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*ValidateAppResp, error) {
+	rsp, err := c.ValidateApp(ctx, appID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseValidateAppResp(rsp)
 }
 
-type ListLibrariesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LibraryListResponse
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+// DeleteAppWithResponse request returning *DeleteAppResp
+func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error) {
+	rsp, err := c.DeleteApp(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAppResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListLibrariesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetAppDetailsWithResponse request returning *GetAppDetailsResp
+func (c *ClientWithResponses) GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error) {
+	rsp, err := c.GetAppDetails(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetAppDetailsResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListLibrariesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// EditAppWithBodyWithResponse request with arbitrary body returning *EditAppResp
+func (c *ClientWithResponses) EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
+	rsp, err := c.EditAppWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseEditAppResp(rsp)
 }
 
-type GetAIModelsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AIModelsListResult
-	JSON500      *InternalServerError
+func (c *ClientWithResponses) EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
+	rsp, err := c.EditApp(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditAppResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAIModelsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// CloneAppWithBodyWithResponse request with arbitrary body returning *CloneAppResp
+func (c *ClientWithResponses) CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
+	rsp, err := c.CloneAppWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCloneAppResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAIModelsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
+	rsp, err := c.CloneApp(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseCloneAppResp(rsp)
 }
 
-type GetAIModelDetailsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AIModelItem
-	JSON500      *InternalServerError
+// CommitAppWithBodyWithResponse request with arbitrary body returning *CommitAppResp
+func (c *ClientWithResponses) CommitAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitAppResp, error) {
+	rsp, err := c.CommitAppWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommitAppResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAIModelDetailsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) CommitAppWithResponse(ctx context.Context, id string, body CommitAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitAppResp, error) {
+	rsp, err := c.CommitApp(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseCommitAppResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAIModelDetailsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetAppEventsWithResponse request returning *GetAppEventsResp
+func (c *ClientWithResponses) GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error) {
+	rsp, err := c.GetAppEvents(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetAppEventsResp(rsp)
 }
 
-type GetPropertyKeysResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PropertyKeysResponse
-	JSON500      *InternalServerError
+// ExportAppWithResponse request returning *ExportAppResp
+func (c *ClientWithResponses) ExportAppWithResponse(ctx context.Context, id string, params *ExportAppParams, reqEditors ...RequestEditorFn) (*ExportAppResp, error) {
+	rsp, err := c.ExportApp(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportAppResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPropertyKeysResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetAppLogsWithResponse request returning *GetAppLogsResp
+func (c *ClientWithResponses) GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error) {
+	rsp, err := c.GetAppLogs(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetAppLogsResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPropertyKeysResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PrepareAppWithResponse request returning *PrepareAppResp
+func (c *ClientWithResponses) PrepareAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PrepareAppResp, error) {
+	rsp, err := c.PrepareApp(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePrepareAppResp(rsp)
 }
 
-type DeletePropertyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *string
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+// ListAppSnapshotsWithResponse request returning *ListAppSnapshotsResp
+func (c *ClientWithResponses) ListAppSnapshotsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ListAppSnapshotsResp, error) {
+	rsp, err := c.ListAppSnapshots(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAppSnapshotsResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeletePropertyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// RestoreAppSnapshotWithResponse request returning *RestoreAppSnapshotResp
+func (c *ClientWithResponses) RestoreAppSnapshotWithResponse(ctx context.Context, id string, snapshotID string, reqEditors ...RequestEditorFn) (*RestoreAppSnapshotResp, error) {
+	rsp, err := c.RestoreAppSnapshot(ctx, id, snapshotID, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseRestoreAppSnapshotResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePropertyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StartAppWithResponse request returning *StartAppResp
+func (c *ClientWithResponses) StartAppWithResponse(ctx context.Context, id string, params *StartAppParams, reqEditors ...RequestEditorFn) (*StartAppResp, error) {
+	rsp, err := c.StartApp(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseStartAppResp(rsp)
 }
 
-type GetPropertyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+// StopAppWithResponse request returning *StopAppResp
+func (c *ClientWithResponses) StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error) {
+	rsp, err := c.StopApp(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopAppResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetPropertyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetBricksWithResponse request returning *GetBricksResp
+func (c *ClientWithResponses) GetBricksWithResponse(ctx context.Context, params *GetBricksParams, reqEditors ...RequestEditorFn) (*GetBricksResp, error) {
+	rsp, err := c.GetBricks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetBricksResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPropertyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetBrickDetailsWithResponse request returning *GetBrickDetailsResp
+func (c *ClientWithResponses) GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error) {
+	rsp, err := c.GetBrickDetails(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetBrickDetailsResp(rsp)
 }
 
-type UpdatePropertyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *BadRequest
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+// GetConfigWithResponse request returning *GetConfigResp
+func (c *ClientWithResponses) GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error) {
+	rsp, err := c.GetConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConfigResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePropertyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ListLibrariesWithResponse request returning *ListLibrariesResp
+func (c *ClientWithResponses) ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error) {
+	rsp, err := c.ListLibraries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseListLibrariesResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePropertyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLibraryDetailsWithResponse request returning *GetLibraryDetailsResp
+func (c *ClientWithResponses) GetLibraryDetailsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetLibraryDetailsResp, error) {
+	rsp, err := c.GetLibraryDetails(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetLibraryDetailsResp(rsp)
 }
 
-type GetSystemResourcesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *InternalServerError
+// GetLibraryDependenciesWithResponse request returning *GetLibraryDependenciesResp
+func (c *ClientWithResponses) GetLibraryDependenciesWithResponse(ctx context.Context, name string, params *GetLibraryDependenciesParams, reqEditors ...RequestEditorFn) (*GetLibraryDependenciesResp, error) {
+	rsp, err := c.GetLibraryDependencies(ctx, name, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLibraryDependenciesResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSystemResourcesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetAIModelsWithResponse request returning *GetAIModelsResp
+func (c *ClientWithResponses) GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error) {
+	rsp, err := c.GetAIModels(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetAIModelsResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSystemResourcesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// RemoveAIModelWithResponse request returning *RemoveAIModelResp
+func (c *ClientWithResponses) RemoveAIModelWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RemoveAIModelResp, error) {
+	rsp, err := c.RemoveAIModel(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRemoveAIModelResp(rsp)
 }
 
-type ApplyUpdateResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *NoContent
-	JSON409      *Conflict
-	JSON500      *InternalServerError
+// GetAIModelDetailsWithResponse request returning *GetAIModelDetailsResp
+func (c *ClientWithResponses) GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error) {
+	rsp, err := c.GetAIModelDetails(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAIModelDetailsResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ApplyUpdateResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ImportAIModelWithBodyWithResponse request with arbitrary body returning *ImportAIModelResp
+func (c *ClientWithResponses) ImportAIModelWithBodyWithResponse(ctx context.Context, id string, params *ImportAIModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportAIModelResp, error) {
+	rsp, err := c.ImportAIModelWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseImportAIModelResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApplyUpdateResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) ImportAIModelWithResponse(ctx context.Context, id string, params *ImportAIModelParams, body ImportAIModelJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportAIModelResp, error) {
+	rsp, err := c.ImportAIModel(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseImportAIModelResp(rsp)
 }
 
-type CheckUpdateResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdateCheckResult
-	JSON204      *NoContent
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+// GetPropertyKeysWithResponse request returning *GetPropertyKeysResp
+func (c *ClientWithResponses) GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error) {
+	rsp, err := c.GetPropertyKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPropertyKeysResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r CheckUpdateResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeletePropertyWithResponse request returning *DeletePropertyResp
+func (c *ClientWithResponses) DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error) {
+	rsp, err := c.DeleteProperty(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeletePropertyResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CheckUpdateResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetPropertyWithResponse request returning *GetPropertyResp
+func (c *ClientWithResponses) GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error) {
+	rsp, err := c.GetProperty(ctx, key, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseGetPropertyResp(rsp)
 }
 
-type EventsUpdateResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *InternalServerError
+// UpdatePropertyWithBodyWithResponse request with arbitrary body returning *UpdatePropertyResp
+func (c *ClientWithResponses) UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
+	rsp, err := c.UpdatePropertyWithBody(ctx, key, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePropertyResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r EventsUpdateResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
+	rsp, err := c.UpdateProperty(ctx, key, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdatePropertyResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EventsUpdateResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ListSchemasWithResponse request returning *ListSchemasResp
+func (c *ClientWithResponses) ListSchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSchemasResp, error) {
+	rsp, err := c.ListSchemas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseListSchemasResp(rsp)
 }
 
-type GetVersionsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *VersionResponse
-	JSON500      *InternalServerError
+// GetSchemaWithResponse request returning *GetSchemaResp
+func (c *ClientWithResponses) GetSchemaWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSchemaResp, error) {
+	rsp, err := c.GetSchema(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSchemaResp(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetVersionsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetSystemResourcesWithResponse request returning *GetSystemResourcesResp
+func (c *ClientWithResponses) GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error) {
+	rsp, err := c.GetSystemResources(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseGetSystemResourcesResp(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetVersionsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ApplyUpdateWithResponse request returning *ApplyUpdateResp
+func (c *ClientWithResponses) ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error) {
+	rsp, err := c.ApplyUpdate(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseApplyUpdateResp(rsp)
 }
 
-// GetAppsWithResponse request returning *GetAppsResp
-func (c *ClientWithResponses) GetAppsWithResponse(ctx context.Context, params *GetAppsParams, reqEditors ...RequestEditorFn) (*GetAppsResp, error) {
-	rsp, err := c.GetApps(ctx, params, reqEditors...)
+// CheckUpdateWithResponse request returning *CheckUpdateResp
+func (c *ClientWithResponses) CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error) {
+	rsp, err := c.CheckUpdate(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppsResp(rsp)
+	return ParseCheckUpdateResp(rsp)
 }
 
-// CreateAppWithBodyWithResponse request with arbitrary body returning *CreateAppResp
-func (c *ClientWithResponses) CreateAppWithBodyWithResponse(ctx context.Context, params *CreateAppParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
-	rsp, err := c.CreateAppWithBody(ctx, params, contentType, body, reqEditors...)
+// EventsUpdateWithResponse request returning *EventsUpdateResp
+func (c *ClientWithResponses) EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error) {
+	rsp, err := c.EventsUpdate(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAppResp(rsp)
+	return ParseEventsUpdateResp(rsp)
 }
 
-func (c *ClientWithResponses) CreateAppWithResponse(ctx context.Context, params *CreateAppParams, body CreateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppResp, error) {
-	rsp, err := c.CreateApp(ctx, params, body, reqEditors...)
+// GetVersionsWithResponse request returning *GetVersionsResp
+func (c *ClientWithResponses) GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error) {
+	rsp, err := c.GetVersions(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAppResp(rsp)
+	return ParseGetVersionsResp(rsp)
 }
 
-// GetAppsEventsWithResponse request returning *GetAppsEventsResp
-func (c *ClientWithResponses) GetAppsEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAppsEventsResp, error) {
-	rsp, err := c.GetAppsEvents(ctx, reqEditors...)
+// ParseGetAppsResp parses an HTTP response from a GetAppsWithResponse call
+func ParseGetAppsResp(rsp *http.Response) (*GetAppsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppsEventsResp(rsp)
+
+	response := &GetAppsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetAppBrickInstancesWithResponse request returning *GetAppBrickInstancesResp
-func (c *ClientWithResponses) GetAppBrickInstancesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstancesResp, error) {
-	rsp, err := c.GetAppBrickInstances(ctx, appID, reqEditors...)
+// ParseCreateAppResp parses an HTTP response from a CreateAppWithResponse call
+func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppBrickInstancesResp(rsp)
+
+	response := &CreateAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateAppResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteAppBrickInstanceWithResponse request returning *DeleteAppBrickInstanceResp
-func (c *ClientWithResponses) DeleteAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*DeleteAppBrickInstanceResp, error) {
-	rsp, err := c.DeleteAppBrickInstance(ctx, appID, brickID, reqEditors...)
+// ParseGetAppsChangesResp parses an HTTP response from a GetAppsChangesWithResponse call
+func ParseGetAppsChangesResp(rsp *http.Response) (*GetAppsChangesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAppBrickInstanceResp(rsp)
-}
 
-// GetAppBrickInstanceByBrickIDWithResponse request returning *GetAppBrickInstanceByBrickIDResp
-func (c *ClientWithResponses) GetAppBrickInstanceByBrickIDWithResponse(ctx context.Context, appID string, brickID string, reqEditors ...RequestEditorFn) (*GetAppBrickInstanceByBrickIDResp, error) {
-	rsp, err := c.GetAppBrickInstanceByBrickID(ctx, appID, brickID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppsChangesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAppBrickInstanceByBrickIDResp(rsp)
-}
 
-// UpdateAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpdateAppBrickInstanceResp
-func (c *ClientWithResponses) UpdateAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
-	rsp, err := c.UpdateAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateAppBrickInstanceResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpdateAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppBrickInstanceResp, error) {
-	rsp, err := c.UpdateAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
+// ParseGetAppsEventsResp parses an HTTP response from a GetAppsEventsWithResponse call
+func ParseGetAppsEventsResp(rsp *http.Response) (*GetAppsEventsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAppBrickInstanceResp(rsp)
-}
 
-// UpsertAppBrickInstanceWithBodyWithResponse request with arbitrary body returning *UpsertAppBrickInstanceResp
-func (c *ClientWithResponses) UpsertAppBrickInstanceWithBodyWithResponse(ctx context.Context, appID string, brickID string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
-	rsp, err := c.UpsertAppBrickInstanceWithBody(ctx, appID, brickID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppsEventsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpsertAppBrickInstanceResp(rsp)
-}
 
-func (c *ClientWithResponses) UpsertAppBrickInstanceWithResponse(ctx context.Context, appID string, brickID string, body UpsertAppBrickInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpsertAppBrickInstanceResp, error) {
-	rsp, err := c.UpsertAppBrickInstance(ctx, appID, brickID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpsertAppBrickInstanceResp(rsp)
+
+	return response, nil
 }
 
-// GetAppPortsWithResponse request returning *GetAppPortsResp
-func (c *ClientWithResponses) GetAppPortsWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*GetAppPortsResp, error) {
-	rsp, err := c.GetAppPorts(ctx, appID, reqEditors...)
+// ParseImportAppResp parses an HTTP response from a ImportAppWithResponse call
+func ParseImportAppResp(rsp *http.Response) (*ImportAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAppPortsResp(rsp)
-}
 
-// AppSketchListLibrariesWithResponse request returning *AppSketchListLibrariesResp
-func (c *ClientWithResponses) AppSketchListLibrariesWithResponse(ctx context.Context, appID string, reqEditors ...RequestEditorFn) (*AppSketchListLibrariesResp, error) {
-	rsp, err := c.AppSketchListLibraries(ctx, appID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ImportAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAppSketchListLibrariesResp(rsp)
-}
 
-// AppSketchRemoveLibraryWithResponse request returning *AppSketchRemoveLibraryResp
-func (c *ClientWithResponses) AppSketchRemoveLibraryWithResponse(ctx context.Context, appID string, libRef string, reqEditors ...RequestEditorFn) (*AppSketchRemoveLibraryResp, error) {
-	rsp, err := c.AppSketchRemoveLibrary(ctx, appID, libRef, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ImportAppResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseAppSketchRemoveLibraryResp(rsp)
+
+	return response, nil
 }
 
-// AppSketchAddLibraryWithResponse request returning *AppSketchAddLibraryResp
-func (c *ClientWithResponses) AppSketchAddLibraryWithResponse(ctx context.Context, appID string, libRef string, params *AppSketchAddLibraryParams, reqEditors ...RequestEditorFn) (*AppSketchAddLibraryResp, error) {
-	rsp, err := c.AppSketchAddLibrary(ctx, appID, libRef, params, reqEditors...)
+// ParseGetAppBrickInstancesResp parses an HTTP response from a GetAppBrickInstancesWithResponse call
+func ParseGetAppBrickInstancesResp(rsp *http.Response) (*GetAppBrickInstancesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAppSketchAddLibraryResp(rsp)
-}
 
-// DeleteAppWithResponse request returning *DeleteAppResp
-func (c *ClientWithResponses) DeleteAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteAppResp, error) {
-	rsp, err := c.DeleteApp(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppBrickInstancesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteAppResp(rsp)
-}
 
-// GetAppDetailsWithResponse request returning *GetAppDetailsResp
-func (c *ClientWithResponses) GetAppDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppDetailsResp, error) {
-	rsp, err := c.GetAppDetails(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppBrickInstancesResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAppDetailsResp(rsp)
+
+	return response, nil
 }
 
-// EditAppWithBodyWithResponse request with arbitrary body returning *EditAppResp
-func (c *ClientWithResponses) EditAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
-	rsp, err := c.EditAppWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseDeleteAppBrickInstanceResp parses an HTTP response from a DeleteAppBrickInstanceWithResponse call
+func ParseDeleteAppBrickInstanceResp(rsp *http.Response) (*DeleteAppBrickInstanceResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEditAppResp(rsp)
-}
 
-func (c *ClientWithResponses) EditAppWithResponse(ctx context.Context, id string, body EditAppJSONRequestBody, reqEditors ...RequestEditorFn) (*EditAppResp, error) {
-	rsp, err := c.EditApp(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAppBrickInstanceResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseEditAppResp(rsp)
-}
 
-// CloneAppWithBodyWithResponse request with arbitrary body returning *CloneAppResp
-func (c *ClientWithResponses) CloneAppWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
-	rsp, err := c.CloneAppWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCloneAppResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CloneAppWithResponse(ctx context.Context, id string, body CloneAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneAppResp, error) {
-	rsp, err := c.CloneApp(ctx, id, body, reqEditors...)
+// ParseGetAppBrickInstanceByBrickIDResp parses an HTTP response from a GetAppBrickInstanceByBrickIDWithResponse call
+func ParseGetAppBrickInstanceByBrickIDResp(rsp *http.Response) (*GetAppBrickInstanceByBrickIDResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCloneAppResp(rsp)
-}
 
-// GetAppEventsWithResponse request returning *GetAppEventsResp
-func (c *ClientWithResponses) GetAppEventsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAppEventsResp, error) {
-	rsp, err := c.GetAppEvents(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppBrickInstanceByBrickIDResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAppEventsResp(rsp)
-}
 
-// GetAppLogsWithResponse request returning *GetAppLogsResp
-func (c *ClientWithResponses) GetAppLogsWithResponse(ctx context.Context, id string, params *GetAppLogsParams, reqEditors ...RequestEditorFn) (*GetAppLogsResp, error) {
-	rsp, err := c.GetAppLogs(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrickInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAppLogsResp(rsp)
-}
 
-// StartAppWithResponse request returning *StartAppResp
-func (c *ClientWithResponses) StartAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StartAppResp, error) {
-	rsp, err := c.StartApp(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseStartAppResp(rsp)
+	return response, nil
 }
 
-// StopAppWithResponse request returning *StopAppResp
-func (c *ClientWithResponses) StopAppWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopAppResp, error) {
-	rsp, err := c.StopApp(ctx, id, reqEditors...)
+// ParseUpdateAppBrickInstanceResp parses an HTTP response from a UpdateAppBrickInstanceWithResponse call
+func ParseUpdateAppBrickInstanceResp(rsp *http.Response) (*UpdateAppBrickInstanceResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStopAppResp(rsp)
-}
 
-// GetBricksWithResponse request returning *GetBricksResp
-func (c *ClientWithResponses) GetBricksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBricksResp, error) {
-	rsp, err := c.GetBricks(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAppBrickInstanceResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetBricksResp(rsp)
-}
 
-// GetBrickDetailsWithResponse request returning *GetBrickDetailsResp
-func (c *ClientWithResponses) GetBrickDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetBrickDetailsResp, error) {
-	rsp, err := c.GetBrickDetails(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetBrickDetailsResp(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// GetConfigWithResponse request returning *GetConfigResp
-func (c *ClientWithResponses) GetConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetConfigResp, error) {
-	rsp, err := c.GetConfig(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetConfigResp(rsp)
+
+	return response, nil
 }
 
-// ListLibrariesWithResponse request returning *ListLibrariesResp
-func (c *ClientWithResponses) ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResp, error) {
-	rsp, err := c.ListLibraries(ctx, params, reqEditors...)
+// ParseUpsertAppBrickInstanceResp parses an HTTP response from a UpsertAppBrickInstanceWithResponse call
+func ParseUpsertAppBrickInstanceResp(rsp *http.Response) (*UpsertAppBrickInstanceResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListLibrariesResp(rsp)
-}
 
-// GetAIModelsWithResponse request returning *GetAIModelsResp
-func (c *ClientWithResponses) GetAIModelsWithResponse(ctx context.Context, params *GetAIModelsParams, reqEditors ...RequestEditorFn) (*GetAIModelsResp, error) {
-	rsp, err := c.GetAIModels(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpsertAppBrickInstanceResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAIModelsResp(rsp)
-}
 
-// GetAIModelDetailsWithResponse request returning *GetAIModelDetailsResp
-func (c *ClientWithResponses) GetAIModelDetailsWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetAIModelDetailsResp, error) {
-	rsp, err := c.GetAIModelDetails(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAIModelDetailsResp(rsp)
+
+	return response, nil
 }
 
-// GetPropertyKeysWithResponse request returning *GetPropertyKeysResp
-func (c *ClientWithResponses) GetPropertyKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertyKeysResp, error) {
-	rsp, err := c.GetPropertyKeys(ctx, reqEditors...)
+// ParseGetAppCompatibilityResp parses an HTTP response from a GetAppCompatibilityWithResponse call
+func ParseGetAppCompatibilityResp(rsp *http.Response) (*GetAppCompatibilityResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPropertyKeysResp(rsp)
-}
 
-// DeletePropertyWithResponse request returning *DeletePropertyResp
-func (c *ClientWithResponses) DeletePropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*DeletePropertyResp, error) {
-	rsp, err := c.DeleteProperty(ctx, key, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppCompatibilityResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeletePropertyResp(rsp)
-}
 
-// GetPropertyWithResponse request returning *GetPropertyResp
-func (c *ClientWithResponses) GetPropertyWithResponse(ctx context.Context, key string, reqEditors ...RequestEditorFn) (*GetPropertyResp, error) {
-	rsp, err := c.GetProperty(ctx, key, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppCompatibilityResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetPropertyResp(rsp)
+
+	return response, nil
 }
 
-// UpdatePropertyWithBodyWithResponse request with arbitrary body returning *UpdatePropertyResp
-func (c *ClientWithResponses) UpdatePropertyWithBodyWithResponse(ctx context.Context, key string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
-	rsp, err := c.UpdatePropertyWithBody(ctx, key, contentType, body, reqEditors...)
+// ParseGetAppPortsResp parses an HTTP response from a GetAppPortsWithResponse call
+func ParseGetAppPortsResp(rsp *http.Response) (*GetAppPortsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePropertyResp(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePropertyWithResponse(ctx context.Context, key string, body UpdatePropertyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertyResp, error) {
-	rsp, err := c.UpdateProperty(ctx, key, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAppPortsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdatePropertyResp(rsp)
-}
 
-// GetSystemResourcesWithResponse request returning *GetSystemResourcesResp
-func (c *ClientWithResponses) GetSystemResourcesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSystemResourcesResp, error) {
-	rsp, err := c.GetSystemResources(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppPortResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetSystemResourcesResp(rsp)
+
+	return response, nil
 }
 
-// ApplyUpdateWithResponse request returning *ApplyUpdateResp
-func (c *ClientWithResponses) ApplyUpdateWithResponse(ctx context.Context, params *ApplyUpdateParams, reqEditors ...RequestEditorFn) (*ApplyUpdateResp, error) {
-	rsp, err := c.ApplyUpdate(ctx, params, reqEditors...)
+// ParseAppSketchBuildResp parses an HTTP response from a AppSketchBuildWithResponse call
+func ParseAppSketchBuildResp(rsp *http.Response) (*AppSketchBuildResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApplyUpdateResp(rsp)
-}
 
-// CheckUpdateWithResponse request returning *CheckUpdateResp
-func (c *ClientWithResponses) CheckUpdateWithResponse(ctx context.Context, params *CheckUpdateParams, reqEditors ...RequestEditorFn) (*CheckUpdateResp, error) {
-	rsp, err := c.CheckUpdate(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AppSketchBuildResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCheckUpdateResp(rsp)
-}
 
-// EventsUpdateWithResponse request returning *EventsUpdateResp
-func (c *ClientWithResponses) EventsUpdateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EventsUpdateResp, error) {
-	rsp, err := c.EventsUpdate(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SketchBuildResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseEventsUpdateResp(rsp)
-}
 
-// GetVersionsWithResponse request returning *GetVersionsResp
-func (c *ClientWithResponses) GetVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionsResp, error) {
-	rsp, err := c.GetVersions(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetVersionsResp(rsp)
+	return response, nil
 }
 
-// ParseGetAppsResp parses an HTTP response from a GetAppsWithResponse call
-func ParseGetAppsResp(rsp *http.Response) (*GetAppsResp, error) {
+// ParseAppSketchListLibrariesResp parses an HTTP response from a AppSketchListLibrariesWithResponse call
+func ParseAppSketchListLibrariesResp(rsp *http.Response) (*AppSketchListLibrariesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppsResp{
+	response := &AppSketchListLibrariesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppListResponse
+		var dest SketchListLibraryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4093,22 +7042,22 @@ func ParseGetAppsResp(rsp *http.Response) (*GetAppsResp, error) {
 	return response, nil
 }
 
-// ParseCreateAppResp parses an HTTP response from a CreateAppWithResponse call
-func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
+// ParseAppSketchAddLocalLibraryResp parses an HTTP response from a AppSketchAddLocalLibraryWithResponse call
+func ParseAppSketchAddLocalLibraryResp(rsp *http.Response) (*AppSketchAddLocalLibraryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAppResp{
+	response := &AppSketchAddLocalLibraryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CreateAppResponse
+		var dest SketchAddLibraryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4121,12 +7070,12 @@ func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON412 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4140,20 +7089,41 @@ func ParseCreateAppResp(rsp *http.Response) (*CreateAppResp, error) {
 	return response, nil
 }
 
-// ParseGetAppsEventsResp parses an HTTP response from a GetAppsEventsWithResponse call
-func ParseGetAppsEventsResp(rsp *http.Response) (*GetAppsEventsResp, error) {
+// ParseAppSketchUpgradeLibrariesResp parses an HTTP response from a AppSketchUpgradeLibrariesWithResponse call
+func ParseAppSketchUpgradeLibrariesResp(rsp *http.Response) (*AppSketchUpgradeLibrariesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppsEventsResp{
+	response := &AppSketchUpgradeLibrariesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SketchUpgradeLibrariesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4166,27 +7136,34 @@ func ParseGetAppsEventsResp(rsp *http.Response) (*GetAppsEventsResp, error) {
 	return response, nil
 }
 
-// ParseGetAppBrickInstancesResp parses an HTTP response from a GetAppBrickInstancesWithResponse call
-func ParseGetAppBrickInstancesResp(rsp *http.Response) (*GetAppBrickInstancesResp, error) {
+// ParseAppSketchRemoveLibraryResp parses an HTTP response from a AppSketchRemoveLibraryWithResponse call
+func ParseAppSketchRemoveLibraryResp(rsp *http.Response) (*AppSketchRemoveLibraryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppBrickInstancesResp{
+	response := &AppSketchRemoveLibraryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppBrickInstancesResult
+		var dest SketchRemoveLibraryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4206,20 +7183,27 @@ func ParseGetAppBrickInstancesResp(rsp *http.Response) (*GetAppBrickInstancesRes
 	return response, nil
 }
 
-// ParseDeleteAppBrickInstanceResp parses an HTTP response from a DeleteAppBrickInstanceWithResponse call
-func ParseDeleteAppBrickInstanceResp(rsp *http.Response) (*DeleteAppBrickInstanceResp, error) {
+// ParseAppSketchAddLibraryResp parses an HTTP response from a AppSketchAddLibraryWithResponse call
+func ParseAppSketchAddLibraryResp(rsp *http.Response) (*AppSketchAddLibraryResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppBrickInstanceResp{
+	response := &AppSketchAddLibraryResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SketchAddLibraryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4246,33 +7230,33 @@ func ParseDeleteAppBrickInstanceResp(rsp *http.Response) (*DeleteAppBrickInstanc
 	return response, nil
 }
 
-// ParseGetAppBrickInstanceByBrickIDResp parses an HTTP response from a GetAppBrickInstanceByBrickIDWithResponse call
-func ParseGetAppBrickInstanceByBrickIDResp(rsp *http.Response) (*GetAppBrickInstanceByBrickIDResp, error) {
+// ParseValidateAppResp parses an HTTP response from a ValidateAppWithResponse call
+func ParseValidateAppResp(rsp *http.Response) (*ValidateAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppBrickInstanceByBrickIDResp{
+	response := &ValidateAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BrickInstance
+		var dest AppValidationResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
@@ -4281,27 +7265,20 @@ func ParseGetAppBrickInstanceByBrickIDResp(rsp *http.Response) (*GetAppBrickInst
 		}
 		response.JSON412 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest InternalServerError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUpdateAppBrickInstanceResp parses an HTTP response from a UpdateAppBrickInstanceWithResponse call
-func ParseUpdateAppBrickInstanceResp(rsp *http.Response) (*UpdateAppBrickInstanceResp, error) {
+// ParseDeleteAppResp parses an HTTP response from a DeleteAppWithResponse call
+func ParseDeleteAppResp(rsp *http.Response) (*DeleteAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAppBrickInstanceResp{
+	response := &DeleteAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4333,26 +7310,26 @@ func ParseUpdateAppBrickInstanceResp(rsp *http.Response) (*UpdateAppBrickInstanc
 	return response, nil
 }
 
-// ParseUpsertAppBrickInstanceResp parses an HTTP response from a UpsertAppBrickInstanceWithResponse call
-func ParseUpsertAppBrickInstanceResp(rsp *http.Response) (*UpsertAppBrickInstanceResp, error) {
+// ParseGetAppDetailsResp parses an HTTP response from a GetAppDetailsWithResponse call
+func ParseGetAppDetailsResp(rsp *http.Response) (*GetAppDetailsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpsertAppBrickInstanceResp{
+	response := &GetAppDetailsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDetailedInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
@@ -4373,27 +7350,34 @@ func ParseUpsertAppBrickInstanceResp(rsp *http.Response) (*UpsertAppBrickInstanc
 	return response, nil
 }
 
-// ParseGetAppPortsResp parses an HTTP response from a GetAppPortsWithResponse call
-func ParseGetAppPortsResp(rsp *http.Response) (*GetAppPortsResp, error) {
+// ParseEditAppResp parses an HTTP response from a EditAppWithResponse call
+func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppPortsResp{
+	response := &EditAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppPortResponse
+		var dest AppDetailedInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4413,26 +7397,26 @@ func ParseGetAppPortsResp(rsp *http.Response) (*GetAppPortsResp, error) {
 	return response, nil
 }
 
-// ParseAppSketchListLibrariesResp parses an HTTP response from a AppSketchListLibrariesWithResponse call
-func ParseAppSketchListLibrariesResp(rsp *http.Response) (*AppSketchListLibrariesResp, error) {
+// ParseCloneAppResp parses an HTTP response from a CloneAppWithResponse call
+func ParseCloneAppResp(rsp *http.Response) (*CloneAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppSketchListLibrariesResp{
+	response := &CloneAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SketchListLibraryResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CloneAppResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4441,6 +7425,20 @@ func ParseAppSketchListLibrariesResp(rsp *http.Response) (*AppSketchListLibrarie
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4460,26 +7458,26 @@ func ParseAppSketchListLibrariesResp(rsp *http.Response) (*AppSketchListLibrarie
 	return response, nil
 }
 
-// ParseAppSketchRemoveLibraryResp parses an HTTP response from a AppSketchRemoveLibraryWithResponse call
-func ParseAppSketchRemoveLibraryResp(rsp *http.Response) (*AppSketchRemoveLibraryResp, error) {
+// ParseCommitAppResp parses an HTTP response from a CommitAppWithResponse call
+func ParseCommitAppResp(rsp *http.Response) (*CommitAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppSketchRemoveLibraryResp{
+	response := &CommitAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SketchRemoveLibraryResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppGitCommit
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -4488,6 +7486,13 @@ func ParseAppSketchRemoveLibraryResp(rsp *http.Response) (*AppSketchRemoveLibrar
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4507,27 +7512,46 @@ func ParseAppSketchRemoveLibraryResp(rsp *http.Response) (*AppSketchRemoveLibrar
 	return response, nil
 }
 
-// ParseAppSketchAddLibraryResp parses an HTTP response from a AppSketchAddLibraryWithResponse call
-func ParseAppSketchAddLibraryResp(rsp *http.Response) (*AppSketchAddLibraryResp, error) {
+// ParseGetAppEventsResp parses an HTTP response from a GetAppEventsWithResponse call
+func ParseGetAppEventsResp(rsp *http.Response) (*GetAppEventsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AppSketchAddLibraryResp{
+	response := &GetAppEventsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SketchAddLibraryResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportAppResp parses an HTTP response from a ExportAppWithResponse call
+func ParseExportAppResp(rsp *http.Response) (*ExportAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4554,15 +7578,15 @@ func ParseAppSketchAddLibraryResp(rsp *http.Response) (*AppSketchAddLibraryResp,
 	return response, nil
 }
 
-// ParseDeleteAppResp parses an HTTP response from a DeleteAppWithResponse call
-func ParseDeleteAppResp(rsp *http.Response) (*DeleteAppResp, error) {
+// ParseGetAppLogsResp parses an HTTP response from a GetAppLogsWithResponse call
+func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppResp{
+	response := &GetAppLogsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -4594,22 +7618,55 @@ func ParseDeleteAppResp(rsp *http.Response) (*DeleteAppResp, error) {
 	return response, nil
 }
 
-// ParseGetAppDetailsResp parses an HTTP response from a GetAppDetailsWithResponse call
-func ParseGetAppDetailsResp(rsp *http.Response) (*GetAppDetailsResp, error) {
+// ParsePrepareAppResp parses an HTTP response from a PrepareAppWithResponse call
+func ParsePrepareAppResp(rsp *http.Response) (*PrepareAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PrepareAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAppSnapshotsResp parses an HTTP response from a ListAppSnapshotsWithResponse call
+func ParseListAppSnapshotsResp(rsp *http.Response) (*ListAppSnapshotsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppDetailsResp{
+	response := &ListAppSnapshotsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDetailedInfo
+		var dest AppSnapshotListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4634,22 +7691,22 @@ func ParseGetAppDetailsResp(rsp *http.Response) (*GetAppDetailsResp, error) {
 	return response, nil
 }
 
-// ParseEditAppResp parses an HTTP response from a EditAppWithResponse call
-func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
+// ParseRestoreAppSnapshotResp parses an HTTP response from a RestoreAppSnapshotWithResponse call
+func ParseRestoreAppSnapshotResp(rsp *http.Response) (*RestoreAppSnapshotResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &EditAppResp{
+	response := &RestoreAppSnapshotResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDetailedInfo
+		var dest AppSnapshot
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4662,6 +7719,13 @@ func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4681,48 +7745,53 @@ func ParseEditAppResp(rsp *http.Response) (*EditAppResp, error) {
 	return response, nil
 }
 
-// ParseCloneAppResp parses an HTTP response from a CloneAppWithResponse call
-func ParseCloneAppResp(rsp *http.Response) (*CloneAppResp, error) {
+// ParseStartAppResp parses an HTTP response from a StartAppWithResponse call
+func ParseStartAppResp(rsp *http.Response) (*StartAppResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CloneAppResp{
+	response := &StartAppResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CloneAppResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON412 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON500 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest Conflict
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
+	return response, nil
+}
 
+// ParseStopAppResp parses an HTTP response from a StopAppWithResponse call
+func ParseStopAppResp(rsp *http.Response) (*StopAppResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopAppResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
 		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4742,20 +7811,27 @@ func ParseCloneAppResp(rsp *http.Response) (*CloneAppResp, error) {
 	return response, nil
 }
 
-// ParseGetAppEventsResp parses an HTTP response from a GetAppEventsWithResponse call
-func ParseGetAppEventsResp(rsp *http.Response) (*GetAppEventsResp, error) {
+// ParseGetBricksResp parses an HTTP response from a GetBricksWithResponse call
+func ParseGetBricksResp(rsp *http.Response) (*GetBricksResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppEventsResp{
+	response := &GetBricksResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrickListResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4768,20 +7844,27 @@ func ParseGetAppEventsResp(rsp *http.Response) (*GetAppEventsResp, error) {
 	return response, nil
 }
 
-// ParseGetAppLogsResp parses an HTTP response from a GetAppLogsWithResponse call
-func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
+// ParseGetBrickDetailsResp parses an HTTP response from a GetBrickDetailsWithResponse call
+func ParseGetBrickDetailsResp(rsp *http.Response) (*GetBrickDetailsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppLogsResp{
+	response := &GetBrickDetailsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrickDetailsResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4789,12 +7872,12 @@ func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4808,26 +7891,26 @@ func ParseGetAppLogsResp(rsp *http.Response) (*GetAppLogsResp, error) {
 	return response, nil
 }
 
-// ParseStartAppResp parses an HTTP response from a StartAppWithResponse call
-func ParseStartAppResp(rsp *http.Response) (*StartAppResp, error) {
+// ParseGetConfigResp parses an HTTP response from a GetConfigWithResponse call
+func ParseGetConfigResp(rsp *http.Response) (*GetConfigResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StartAppResp{
+	response := &GetConfigResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfigResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4841,26 +7924,33 @@ func ParseStartAppResp(rsp *http.Response) (*StartAppResp, error) {
 	return response, nil
 }
 
-// ParseStopAppResp parses an HTTP response from a StopAppWithResponse call
-func ParseStopAppResp(rsp *http.Response) (*StopAppResp, error) {
+// ParseListLibrariesResp parses an HTTP response from a ListLibrariesWithResponse call
+func ParseListLibrariesResp(rsp *http.Response) (*ListLibrariesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StopAppResp{
+	response := &ListLibrariesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest PreconditionFailed
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryListResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -4874,27 +7964,34 @@ func ParseStopAppResp(rsp *http.Response) (*StopAppResp, error) {
 	return response, nil
 }
 
-// ParseGetBricksResp parses an HTTP response from a GetBricksWithResponse call
-func ParseGetBricksResp(rsp *http.Response) (*GetBricksResp, error) {
+// ParseGetLibraryDetailsResp parses an HTTP response from a GetLibraryDetailsWithResponse call
+func ParseGetLibraryDetailsResp(rsp *http.Response) (*GetLibraryDetailsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBricksResp{
+	response := &GetLibraryDetailsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BrickListResult
+		var dest LibraryInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4907,34 +8004,27 @@ func ParseGetBricksResp(rsp *http.Response) (*GetBricksResp, error) {
 	return response, nil
 }
 
-// ParseGetBrickDetailsResp parses an HTTP response from a GetBrickDetailsWithResponse call
-func ParseGetBrickDetailsResp(rsp *http.Response) (*GetBrickDetailsResp, error) {
+// ParseGetLibraryDependenciesResp parses an HTTP response from a GetLibraryDependenciesWithResponse call
+func ParseGetLibraryDependenciesResp(rsp *http.Response) (*GetLibraryDependenciesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBrickDetailsResp{
+	response := &GetLibraryDependenciesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BrickDetailsResult
+		var dest LibraryDependencyNode
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4954,22 +8044,22 @@ func ParseGetBrickDetailsResp(rsp *http.Response) (*GetBrickDetailsResp, error)
 	return response, nil
 }
 
-// ParseGetConfigResp parses an HTTP response from a GetConfigWithResponse call
-func ParseGetConfigResp(rsp *http.Response) (*GetConfigResp, error) {
+// ParseGetAIModelsResp parses an HTTP response from a GetAIModelsWithResponse call
+func ParseGetAIModelsResp(rsp *http.Response) (*GetAIModelsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetConfigResp{
+	response := &GetAIModelsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ConfigResponse
+		var dest AIModelsListResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4987,33 +8077,33 @@ func ParseGetConfigResp(rsp *http.Response) (*GetConfigResp, error) {
 	return response, nil
 }
 
-// ParseListLibrariesResp parses an HTTP response from a ListLibrariesWithResponse call
-func ParseListLibrariesResp(rsp *http.Response) (*ListLibrariesResp, error) {
+// ParseRemoveAIModelResp parses an HTTP response from a RemoveAIModelWithResponse call
+func ParseRemoveAIModelResp(rsp *http.Response) (*RemoveAIModelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListLibrariesResp{
+	response := &RemoveAIModelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LibraryListResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5027,22 +8117,22 @@ func ParseListLibrariesResp(rsp *http.Response) (*ListLibrariesResp, error) {
 	return response, nil
 }
 
-// ParseGetAIModelsResp parses an HTTP response from a GetAIModelsWithResponse call
-func ParseGetAIModelsResp(rsp *http.Response) (*GetAIModelsResp, error) {
+// ParseGetAIModelDetailsResp parses an HTTP response from a GetAIModelDetailsWithResponse call
+func ParseGetAIModelDetailsResp(rsp *http.Response) (*GetAIModelDetailsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAIModelsResp{
+	response := &GetAIModelDetailsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AIModelsListResult
+		var dest AIModelItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5060,26 +8150,40 @@ func ParseGetAIModelsResp(rsp *http.Response) (*GetAIModelsResp, error) {
 	return response, nil
 }
 
-// ParseGetAIModelDetailsResp parses an HTTP response from a GetAIModelDetailsWithResponse call
-func ParseGetAIModelDetailsResp(rsp *http.Response) (*GetAIModelDetailsResp, error) {
+// ParseImportAIModelResp parses an HTTP response from a ImportAIModelWithResponse call
+func ParseImportAIModelResp(rsp *http.Response) (*ImportAIModelResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAIModelDetailsResp{
+	response := &ImportAIModelResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
 		var dest AIModelItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -5253,6 +8357,72 @@ func ParseUpdatePropertyResp(rsp *http.Response) (*UpdatePropertyResp, error) {
 	return response, nil
 }
 
+// ParseListSchemasResp parses an HTTP response from a ListSchemasWithResponse call
+func ParseListSchemasResp(rsp *http.Response) (*ListSchemasResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSchemasResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSchemaResp parses an HTTP response from a GetSchemaWithResponse call
+func ParseGetSchemaResp(rsp *http.Response) (*GetSchemaResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSchemaResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationschemaJSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetSystemResourcesResp parses an HTTP response from a GetSystemResourcesWithResponse call
 func ParseGetSystemResourcesResp(rsp *http.Response) (*GetSystemResourcesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -65,7 +65,7 @@ func setupTestBrick(t *testing.T) (*client.CreateAppResp, *client.ClientWithResp
 func TestBricksList(t *testing.T) {
 	httpClient := GetHttpclient(t)
 
-	response, err := httpClient.GetBricksWithResponse(t.Context(), func(ctx context.Context, req *http.Request) error { return nil })
+	response, err := httpClient.GetBricksWithResponse(t.Context(), nil, func(ctx context.Context, req *http.Request) error { return nil })
 	require.NoError(t, err)
 	require.NotEmpty(t, response.JSON200.Bricks)
 	cfg, err := config.NewFromEnv()
@@ -18,12 +18,14 @@ package app
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 
 	"github.com/arduino/go-paths-helper"
 	yaml "github.com/goccy/go-yaml"
 
 	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 )
 
 // ArduinoApp holds all the files composing an app
@@ -33,11 +35,16 @@ type ArduinoApp struct {
 	MainSketchPath *paths.Path
 	FullPath       *paths.Path // FullPath is the path to the App folder
 	Descriptor     AppDescriptor
+	// ReadOnly apps belong to a read-only app root: they can be run, cloned and exported.
+	ReadOnly bool
+
+	// stateDir replaces the .cache folder of the apps of a read-only app root.
+	stateDir *paths.Path
 }
 
 // Load creates an App instance by reading all the files composing an app and grouping them
 // by file type.
-func Load(appPath string) (ArduinoApp, error) {
+func Load(appPath string, cfg config.Configuration) (ArduinoApp, error) {
 	path := paths.New(appPath)
 	if path == nil {
 		return ArduinoApp{}, errors.New("empty app path")
@@ -59,6 +66,10 @@ func Load(appPath string) (ArduinoApp, error) {
 		FullPath:   path,
 		Descriptor: AppDescriptor{},
 	}
+	if stateDir := readOnlyStateDir(cfg, path); stateDir != nil {
+		app.ReadOnly = true
+		app.stateDir = stateDir
+	}
 
 	if descriptorFile := app.GetDescriptorPath(); descriptorFile.Exist() {
 		desc, err := ParseDescriptorFile(descriptorFile)
@@ -107,6 +118,9 @@ func (a *ArduinoApp) GetDescriptorPath() *paths.Path {
 var ErrInvalidApp = fmt.Errorf("invalid app")
 
 func (a *ArduinoApp) Save() error {
+	if err := a.CheckWritable(); err != nil {
+		return err
+	}
 	if err := a.Descriptor.IsValid(); err != nil {
 		return fmt.Errorf("%w: %v", ErrInvalidApp, err)
 	}
@@ -122,9 +136,18 @@ func (a *ArduinoApp) writeApp() error {
 		return errors.New("app descriptor file path is not set")
 	}
 
-	out, err := yaml.Marshal(a.Descriptor)
+	// Hand-maintained descriptors are patched in place to preserve comments and
+	// formatting, falling back to a full rewrite when a patch is not possible.
+	out, err := a.patchedDescriptor(descriptorPath)
 	if err != nil {
-		return fmt.Errorf("cannot marshal app descriptor: %w", err)
+		if descriptorPath.Exist() {
+			slog.Warn("unable to patch the app descriptor, rewriting it: comments and formatting are lost",
+				slog.String("path", descriptorPath.String()), slog.String("error", err.Error()))
+		}
+		out, err = yaml.Marshal(a.Descriptor)
+		if err != nil {
+			return fmt.Errorf("cannot marshal app descriptor: %w", err)
+		}
 	}
 
 	if err := fatomic.WriteFile(descriptorPath.String(), out, os.FileMode(0644)); err != nil {
@@ -133,11 +156,28 @@ func (a *ArduinoApp) writeApp() error {
 	return nil
 }
 
+func (a *ArduinoApp) patchedDescriptor(descriptorPath *paths.Path) ([]byte, error) {
+	content, err := descriptorPath.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	content, _, _, err = MigrateDescriptor(content)
+	if err != nil {
+		return nil, err
+	}
+	return patchDescriptor(content, a.Descriptor)
+}
+
 func (a *ArduinoApp) SketchBuildPath() *paths.Path {
-	return a.FullPath.Join(".cache", "sketch")
+	return a.ProvisioningStateDir().Join("sketch")
 }
 
+// ProvisioningStateDir is the .cache folder of the app or, for the apps of a read-only
+// app root, a folder in the data folder.
 func (a *ArduinoApp) ProvisioningStateDir() *paths.Path {
+	if a.stateDir != nil {
+		return a.stateDir
+	}
 	return a.FullPath.Join(".cache")
 }
 
@@ -22,30 +22,32 @@ import (
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
 	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 )
 
 func TestLoad(t *testing.T) {
 	t.Run("it fails if the app path is empty", func(t *testing.T) {
-		app, err := Load("")
+		app, err := Load("", config.Configuration{})
 		assert.Error(t, err)
 		assert.Empty(t, app)
 		assert.Contains(t, err.Error(), "empty app path")
 	})
 
 	t.Run("it fails if the app path exist but it's a file", func(t *testing.T) {
-		_, err := Load("testdata/app.yaml")
+		_, err := Load("testdata/app.yaml", config.Configuration{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "app path must be a directory")
 	})
 
 	t.Run("it fails if the app path does not exist", func(t *testing.T) {
-		_, err := Load("testdata/this-folder-does-not-exist")
+		_, err := Load("testdata/this-folder-does-not-exist", config.Configuration{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "app path is not valid")
 	})
 
 	t.Run("it loads an app correctly", func(t *testing.T) {
-		app, err := Load("testdata/AppSimple")
+		app, err := Load("testdata/AppSimple", config.Configuration{})
 		assert.NoError(t, err)
 		assert.NotEmpty(t, app)
 
@@ -61,7 +63,7 @@ func TestMissingDescriptor(t *testing.T) {
 	appFolderPath := paths.New("testdata", "MissingDescriptor")
 
 	// Load app
-	app, err := Load(appFolderPath.String())
+	app, err := Load(appFolderPath.String(), config.Configuration{})
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "descriptor app.yaml file missing from app")
 	assert.Empty(t, app)
@@ -71,7 +73,7 @@ func TestMissingMains(t *testing.T) {
 	appFolderPath := paths.New("testdata", "MissingMains")
 
 	// Load app
-	app, err := Load(appFolderPath.String())
+	app, err := Load(appFolderPath.String(), config.Configuration{})
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "main python file and sketch file missing from app")
 	assert.Empty(t, app)
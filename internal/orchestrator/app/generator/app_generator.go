@@ -73,7 +73,10 @@ func GenerateApp(basePath *paths.Path, app app.AppDescriptor, options Opts) erro
 func generateAppYaml(basePath *paths.Path, app app.AppDescriptor) error {
 	appYamlTmpl := template.Must(
 		template.New("app.yaml").
-			Funcs(template.FuncMap{"joinInts": formatPorts}).
+			Funcs(template.FuncMap{
+				"joinInts":      formatPorts,
+				"formatVersion": formatVersion,
+			}).
 			ParseFS(fsApp, path.Join(templateRoot, "app.yaml.template")),
 	)
 
@@ -182,3 +185,7 @@ func formatPorts(ports []int) string {
 	}
 	return strings.Join(s, ", ")
 }
+
+func formatVersion() int {
+	return app.CurrentFormatVersion
+}
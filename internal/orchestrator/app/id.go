@@ -18,6 +18,7 @@ package app
 import (
 	"encoding/base64"
 	"errors"
+	"slices"
 	"strings"
 
 	"github.com/arduino/go-paths-helper"
@@ -32,12 +33,18 @@ type ID struct {
 	encodedID            string
 	isFromKnownLocaltion bool
 	isExample            bool
+	isReadOnly           bool
 }
 
 func (id ID) IsExample() bool {
 	return id.isExample
 }
 
+// IsReadOnly reports whether the app belongs to a read-only app root.
+func (id ID) IsReadOnly() bool {
+	return id.isReadOnly
+}
+
 func (id ID) IsApp() bool {
 	return !id.isExample
 }
@@ -60,6 +67,7 @@ func (id ID) Equal(other ID) bool {
 	return id.path.EqualsTo(other.path) &&
 		id.isFromKnownLocaltion == other.isFromKnownLocaltion &&
 		id.isExample == other.isExample &&
+		id.isReadOnly == other.isReadOnly &&
 		id.encodedID == other.encodedID
 }
 
@@ -92,16 +100,17 @@ func (p *IDProvider) IDFromPath(path *paths.Path) (ID, error) {
 		id                  string
 		isFromKnownLocation bool
 		isExample           bool
+		isReadOnly          bool
 	)
 	switch {
-	case strings.HasPrefix(path.String(), p.cfg.AppsDir().String()):
+	case isInside(path, p.cfg.AppsDir()):
 		rel, err := path.RelFrom(p.cfg.AppsDir())
 		if err != nil {
 			return ID{}, ErrInvalidID
 		}
 		id = "user:" + rel.String()
 		isFromKnownLocation = true
-	case strings.HasPrefix(path.String(), p.cfg.ExamplesDir().String()):
+	case isInside(path, p.cfg.ExamplesDir()):
 		rel, err := path.RelFrom(p.cfg.ExamplesDir())
 		if err != nil {
 			return ID{}, ErrInvalidID
@@ -111,6 +120,19 @@ func (p *IDProvider) IDFromPath(path *paths.Path) (ID, error) {
 		isExample = true
 	default:
 		id = path.String()
+		for _, root := range p.cfg.AppRoots() {
+			if !isInside(path, root.Path) {
+				continue
+			}
+			rel, err := path.RelFrom(root.Path)
+			if err != nil {
+				return ID{}, ErrInvalidID
+			}
+			id = root.Prefix + ":" + rel.String()
+			isFromKnownLocation = true
+			isReadOnly = root.ReadOnly
+			break
+		}
 	}
 
 	return ID{
@@ -118,9 +140,15 @@ func (p *IDProvider) IDFromPath(path *paths.Path) (ID, error) {
 		encodedID:            base64.RawURLEncoding.EncodeToString([]byte(id)),
 		isFromKnownLocaltion: isFromKnownLocation,
 		isExample:            isExample,
+		isReadOnly:           isReadOnly,
 	}, nil
 }
 
+func isInside(path, dir *paths.Path) bool {
+	inside, err := path.IsInsideDir(dir)
+	return err == nil && inside
+}
+
 // ParseID parses a string into an ID.
 // It accepts both absolute paths and relative paths.
 func (p *IDProvider) ParseID(id string) (ID, error) {
@@ -132,7 +160,7 @@ func (p *IDProvider) parseID(id string) (ID, error) {
 
 	prefix, appPath, found := strings.Cut(id, ":")
 	if found {
-		var isExample bool
+		var isExample, isReadOnly bool
 		switch prefix {
 		case "user":
 			path = p.cfg.AppsDir().Join(appPath)
@@ -140,13 +168,23 @@ func (p *IDProvider) parseID(id string) (ID, error) {
 			path = p.cfg.ExamplesDir().Join(appPath)
 			isExample = true
 		default:
-			return ID{}, ErrInvalidID
+			idx := slices.IndexFunc(p.cfg.AppRoots(), func(r config.AppRoot) bool { return r.Prefix == prefix })
+			if idx == -1 {
+				return ID{}, ErrInvalidID
+			}
+			root := p.cfg.AppRoots()[idx]
+			path = root.Path.Join(appPath)
+			if inside, err := path.IsInsideDir(root.Path); err != nil || !inside {
+				return ID{}, ErrInvalidID
+			}
+			isReadOnly = root.ReadOnly
 		}
 		return ID{
 			path:                 path,
 			encodedID:            base64.RawURLEncoding.EncodeToString([]byte(id)),
 			isFromKnownLocaltion: true,
 			isExample:            isExample,
+			isReadOnly:           isReadOnly,
 		}, nil
 	}
 
@@ -139,3 +139,65 @@ func TestParseID(t *testing.T) {
 		})
 	}
 }
+
+func TestAppRootsID(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	t.Setenv("ARDUINO_APP_CLI__APPS_DIR", tmp.Join("apps").String())
+	t.Setenv("ARDUINO_APP_CLI__DATA_DIR", tmp.Join("data").String())
+	t.Setenv("ARDUINO_APP_CLI__APP_ROOTS", "usb="+tmp.Join("usb").String()+",ro,watch; team="+tmp.Join("team").String())
+
+	orchestratorConfig, err := config.NewFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, []config.AppRoot{
+		{Prefix: "usb", Path: tmp.Join("usb"), ReadOnly: true, Watch: true},
+		{Prefix: "team", Path: tmp.Join("team")},
+	}, orchestratorConfig.AppRoots())
+	require.NoError(t, tmp.Join("usb", "usb-app").MkdirAll())
+	require.NoError(t, tmp.Join("team", "team-app").MkdirAll())
+
+	idProvider := NewAppIDProvider(orchestratorConfig)
+
+	usbID, err := idProvider.IDFromPath(tmp.Join("usb", "usb-app"))
+	require.NoError(t, err)
+	require.Equal(t, f.Must(idProvider.ParseID("usb:usb-app")), usbID)
+	require.True(t, usbID.IsReadOnly())
+	require.False(t, usbID.IsExample())
+
+	teamID, err := idProvider.ParseID("team:team-app")
+	require.NoError(t, err)
+	require.Equal(t, tmp.Join("team", "team-app"), teamID.ToPath())
+	require.False(t, teamID.IsReadOnly())
+
+	_, err = idProvider.ParseID("unknown:app")
+	require.ErrorIs(t, err, ErrInvalidID)
+	_, err = idProvider.ParseID("team:../apps/escape")
+	require.ErrorIs(t, err, ErrInvalidID)
+
+	for _, roots := range []string{"usb", "user=/apps", "usb=relative/path", "usb=/a;usb=/b", "usb=/a,rw", "Bad Prefix=/a"} {
+		t.Setenv("ARDUINO_APP_CLI__APP_ROOTS", roots)
+		_, err := config.NewFromEnv()
+		require.Error(t, err, roots)
+	}
+
+	// Roots overlapping the standard locations or each other would give two ids to the same app.
+	for _, roots := range []string{
+		"usb=" + tmp.Join("apps").String(),
+		"usb=" + tmp.Join("apps", "usb").String(),
+		"usb=" + tmp.String(),
+		"usb=" + tmp.Join("data", "examples", "usb").String(),
+		"usb=" + tmp.Join("usb").String() + ";team=" + tmp.Join("usb", "team").String(),
+	} {
+		t.Setenv("ARDUINO_APP_CLI__APP_ROOTS", roots)
+		_, err := config.NewFromEnv()
+		require.ErrorContains(t, err, "overlaps", roots)
+	}
+
+	// A root sharing the name prefix of the apps folder is not inside it.
+	t.Setenv("ARDUINO_APP_CLI__APP_ROOTS", "usb="+tmp.Join("apps-usb").String())
+	orchestratorConfig, err = config.NewFromEnv()
+	require.NoError(t, err)
+	require.NoError(t, tmp.Join("apps-usb", "usb-app").MkdirAll())
+	usbID, err = NewAppIDProvider(orchestratorConfig).IDFromPath(tmp.Join("apps-usb", "usb-app"))
+	require.NoError(t, err)
+	require.Equal(t, f.Must(NewAppIDProvider(orchestratorConfig).ParseID("usb:usb-app")), usbID)
+}
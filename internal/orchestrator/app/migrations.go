@@ -0,0 +1,190 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+// CurrentFormatVersion is the version of the app.yaml format supported by this CLI.
+// Descriptors without the `format_version` key have version 0.
+const CurrentFormatVersion = 1
+
+// FormatTooNewError is returned when a descriptor has been written for a newer CLI.
+type FormatTooNewError struct {
+	Version int
+}
+
+func (e *FormatTooNewError) Error() string {
+	return fmt.Sprintf("app.yaml format version %d is not supported, the maximum supported version is %d: update arduino-app-cli to use this app", e.Version, CurrentFormatVersion)
+}
+
+type migration struct {
+	description string
+	migrate     func(content []byte) ([]byte, error)
+}
+
+// migrations[i] upgrades a descriptor from format version i to i+1. The migrations
+// work on the raw content so that comments and formatting are preserved, the
+// `format_version` key is updated after each migration.
+var migrations = []migration{
+	{
+		description: "add the format_version key",
+		migrate:     func(content []byte) ([]byte, error) { return content, nil },
+	},
+}
+
+// MigrateDescriptor upgrades the content of a descriptor to the current format version.
+// It returns the migrated content, the starting format version and the descriptions of
+// the applied migrations.
+func MigrateDescriptor(content []byte) ([]byte, int, []string, error) {
+	if len(bytes.TrimSpace(content)) == 0 {
+		return content, CurrentFormatVersion, nil, nil
+	}
+	version, err := descriptorFormatVersion(content)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if version > CurrentFormatVersion {
+		return nil, version, nil, &FormatTooNewError{Version: version}
+	}
+
+	var applied []string
+	for v := version; v < CurrentFormatVersion; v++ {
+		m := migrations[v]
+		if content, err = m.migrate(content); err != nil {
+			return nil, version, nil, fmt.Errorf("cannot %s: %w", m.description, err)
+		}
+		if content, err = setFormatVersion(content, v+1); err != nil {
+			return nil, version, nil, fmt.Errorf("cannot set format version: %w", err)
+		}
+		applied = append(applied, m.description)
+	}
+	return content, version, applied, nil
+}
+
+type MigrationResult struct {
+	DescriptorPath *paths.Path
+	BackupPath     *paths.Path
+	FromVersion    int
+	ToVersion      int
+	Migrations     []string
+}
+
+// Migrate upgrades the descriptor of the app in the given folder to the current format
+// version. The original descriptor is kept in a backup file in the state folder of the app.
+// The apps of a read-only app root are not migrated.
+func Migrate(appPath *paths.Path, cfg config.Configuration) (MigrationResult, error) {
+	if absPath, err := appPath.Abs(); err == nil && readOnlyStateDir(cfg, absPath) != nil {
+		return MigrationResult{}, fmt.Errorf("%w: %s", ErrReadOnly, appPath)
+	}
+	a := ArduinoApp{FullPath: appPath}
+	descriptorPath := a.GetDescriptorPath()
+	content, err := descriptorPath.ReadFile()
+	if err != nil {
+		return MigrationResult{}, fmt.Errorf("cannot read app descriptor: %w", err)
+	}
+	migrated, from, applied, err := MigrateDescriptor(content)
+	result := MigrationResult{
+		DescriptorPath: descriptorPath,
+		FromVersion:    from,
+		ToVersion:      from,
+		Migrations:     applied,
+	}
+	if err != nil {
+		return result, err
+	}
+	if len(applied) == 0 {
+		return result, nil
+	}
+
+	if err := a.ProvisioningStateDir().MkdirAll(); err != nil {
+		return result, fmt.Errorf("cannot create the app state folder: %w", err)
+	}
+	backupPath := a.ProvisioningStateDir().Join(fmt.Sprintf("%s.v%d.bak", descriptorPath.Base(), from))
+	if err := fatomic.WriteFile(backupPath.String(), content, os.FileMode(0644)); err != nil {
+		return result, fmt.Errorf("cannot write backup of the app descriptor: %w", err)
+	}
+	if err := fatomic.WriteFile(descriptorPath.String(), migrated, os.FileMode(0644)); err != nil {
+		return result, fmt.Errorf("cannot write app descriptor: %w", err)
+	}
+	result.BackupPath = backupPath
+	result.ToVersion = CurrentFormatVersion
+	return result, nil
+}
+
+func descriptorFormatVersion(content []byte) (int, error) {
+	file, err := parser.ParseBytes(content, 0)
+	if err != nil {
+		return 0, err
+	}
+	if len(file.Docs) == 0 {
+		return 0, nil
+	}
+	root, ok := file.Docs[0].Body.(*ast.MappingNode)
+	if !ok {
+		if value, isValue := file.Docs[0].Body.(*ast.MappingValueNode); isValue {
+			root = &ast.MappingNode{Values: []*ast.MappingValueNode{value}}
+		} else {
+			return 0, nil
+		}
+	}
+	for _, v := range root.Values {
+		if v.Key.GetToken().Value != "format_version" {
+			continue
+		}
+		version, err := strconv.Atoi(v.Value.GetToken().Value)
+		if err != nil || version < 0 {
+			return 0, fmt.Errorf("invalid format_version %q", v.Value.GetToken().Value)
+		}
+		return version, nil
+	}
+	return 0, nil
+}
+
+func setFormatVersion(content []byte, version int) ([]byte, error) {
+	file, err := parser.ParseBytes(content, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Docs) != 1 || file.Docs[0].Body == nil {
+		return nil, errCannotPatch
+	}
+	p := newDescriptorPatcher(content)
+	root, ok := p.mappingEntries(file.Docs[0].Body, len(p.lines))
+	if !ok || len(root) == 0 {
+		return nil, errCannotPatch
+	}
+	if e := findEntry(root, "format_version"); e != nil {
+		err = p.replaceEntry(e, version)
+	} else {
+		err = p.insertEntry(root[0].start, "format_version", version, root[0].indent)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p.apply(), nil
+}
@@ -0,0 +1,80 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func TestMigrateDescriptor(t *testing.T) {
+	require.Len(t, migrations, CurrentFormatVersion)
+
+	t.Run("legacy descriptor", func(t *testing.T) {
+		content := "# My app\nname: My app # the name\nbricks:\n  - arduino:web_ui\n"
+		migrated, from, applied, err := MigrateDescriptor([]byte(content))
+		require.NoError(t, err)
+		require.Equal(t, 0, from)
+		require.Equal(t, []string{"add the format_version key"}, applied)
+		require.Equal(t, "# My app\nformat_version: 1\nname: My app # the name\nbricks:\n  - arduino:web_ui\n", string(migrated))
+	})
+
+	t.Run("current descriptor", func(t *testing.T) {
+		content := "name: My app\nformat_version: 1\n"
+		migrated, from, applied, err := MigrateDescriptor([]byte(content))
+		require.NoError(t, err)
+		require.Equal(t, CurrentFormatVersion, from)
+		require.Empty(t, applied)
+		require.Equal(t, content, string(migrated))
+	})
+
+	t.Run("too new descriptor", func(t *testing.T) {
+		_, _, _, err := MigrateDescriptor([]byte("format_version: 99\nname: My app\n"))
+		var tooNew *FormatTooNewError
+		require.ErrorAs(t, err, &tooNew)
+		require.Equal(t, 99, tooNew.Version)
+	})
+
+	t.Run("invalid format version", func(t *testing.T) {
+		_, _, _, err := MigrateDescriptor([]byte("format_version: latest\nname: My app\n"))
+		require.ErrorContains(t, err, `invalid format_version "latest"`)
+	})
+}
+
+func TestMigrate(t *testing.T) {
+	appDir := paths.New(t.TempDir())
+	content := []byte("name: My app\nports: []\n")
+	require.NoError(t, appDir.Join("app.yaml").WriteFile(content))
+
+	res, err := Migrate(appDir, config.Configuration{})
+	require.NoError(t, err)
+	require.Equal(t, 0, res.FromVersion)
+	require.Equal(t, CurrentFormatVersion, res.ToVersion)
+	require.Equal(t, appDir.Join(".cache", "app.yaml.v0.bak"), res.BackupPath)
+	require.Equal(t, content, f.Must(res.BackupPath.ReadFile()))
+	require.Equal(t, "format_version: 1\nname: My app\nports: []\n", string(f.Must(appDir.Join("app.yaml").ReadFile())))
+
+	// Migrating again is a no-op.
+	res, err = Migrate(appDir, config.Configuration{})
+	require.NoError(t, err)
+	require.Nil(t, res.BackupPath)
+	require.Empty(t, res.Migrations)
+}
@@ -16,6 +16,7 @@
 package app
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -24,31 +25,54 @@ import (
 	"github.com/arduino/go-paths-helper"
 	"github.com/goccy/go-yaml"
 	"github.com/goccy/go-yaml/ast"
+
+	"github.com/arduino/arduino-app-cli/internal/schema"
 )
 
 type Brick struct {
 	ID        string            `yaml:"-"` // Ignores this field, to be handled manually
-	Model     string            `yaml:"model,omitempty"`
-	Variables map[string]string `yaml:"variables,omitempty"`
+	Model     string            `yaml:"model,omitempty" description:"The id of the AI model used by the brick."`
+	Variables map[string]string `yaml:"variables,omitempty" description:"The values of the brick variables."`
 }
 
 type AppDescriptor struct {
-	Name            string   `yaml:"name"`
-	Description     string   `yaml:"description"`
-	Ports           []int    `yaml:"ports"`
-	Bricks          []Brick  `yaml:"bricks"`
-	Icon            string   `yaml:"icon,omitempty"`
-	RequiredDevices []string `yaml:"required_devices,omitempty"`
+	FormatVersion   int      `yaml:"format_version,omitempty" description:"The version of the app.yaml format, older files are upgraded automatically."`
+	Name            string   `yaml:"name" required:"true" description:"The name of the app."`
+	Description     string   `yaml:"description" description:"A short description of the app."`
+	Ports           []int    `yaml:"ports" description:"The ports exposed by the app."`
+	Bricks          []Brick  `yaml:"bricks" description:"The bricks used by the app, optionally with their model and variables."`
+	Icon            string   `yaml:"icon,omitempty" description:"A single emoji representing the app."`
+	RequiredDevices []string `yaml:"required_devices,omitempty" description:"The device classes the app needs attached to the board."`
+	Dev             bool     `yaml:"dev,omitempty" description:"If true, app start from the command line watches the app by default, restarting the Python main whenever a file in the python folder changes."`
+	FlashMode       string   `yaml:"flash_mode,omitempty" enum:"ram,flash" description:"Where the sketch is deployed: ram (the default) is lost when the board is powered off, flash persists."`
+}
+
+const (
+	// FlashModeRAM deploys the sketch in the RAM of the MCU, it is lost on power loss.
+	FlashModeRAM = "ram"
+	// FlashModeFlash deploys the sketch in the flash of the MCU, it survives power loss.
+	FlashModeFlash = "flash"
+)
+
+// SketchFlashMode returns where the sketch of the app is deployed, defaulting to FlashModeRAM.
+func (d AppDescriptor) SketchFlashMode() string {
+	if d.FlashMode == "" {
+		return FlashModeRAM
+	}
+	return d.FlashMode
 }
 
 func (d AppDescriptor) MarshalYAML() (any, error) {
 	type raw struct {
+		FormatVersion   int                `yaml:"format_version,omitempty"`
 		Name            string             `yaml:"name"`
 		Description     string             `yaml:"description"`
 		Ports           []int              `yaml:"ports"`
 		Bricks          []map[string]Brick `yaml:"bricks"`
 		Icon            string             `yaml:"icon,omitempty"`
 		RequiredDevices []string           `yaml:"required_devices,omitempty"`
+		Dev             bool               `yaml:"dev,omitempty"`
+		FlashMode       string             `yaml:"flash_mode,omitempty"`
 	}
 
 	bricks := make([]map[string]Brick, len(d.Bricks))
@@ -56,12 +80,15 @@ func (d AppDescriptor) MarshalYAML() (any, error) {
 		bricks[i] = map[string]Brick{brick.ID: brick}
 	}
 	return &raw{
+		FormatVersion:   d.FormatVersion,
 		Name:            d.Name,
 		Description:     d.Description,
 		Ports:           d.Ports,
 		Bricks:          bricks,
 		Icon:            d.Icon,
 		RequiredDevices: d.RequiredDevices,
+		Dev:             d.Dev,
+		FlashMode:       d.FlashMode,
 	}, nil
 }
 
@@ -107,13 +134,16 @@ func (md *Brick) UnmarshalYAML(node ast.Node) error {
 
 // ParseAppFile reads an app file
 func ParseDescriptorFile(file *paths.Path) (AppDescriptor, error) {
-	f, err := file.Open()
+	content, err := file.ReadFile()
 	if err != nil {
 		return AppDescriptor{}, fmt.Errorf("cannot open file: %w", err)
 	}
-	defer f.Close()
+	content, _, _, err = MigrateDescriptor(content)
+	if err != nil {
+		return AppDescriptor{}, err
+	}
 	descriptor := AppDescriptor{}
-	if err := yaml.NewDecoder(f).Decode(&descriptor); err != nil {
+	if err := yaml.NewDecoder(bytes.NewReader(content)).Decode(&descriptor); err != nil {
 		// FIXME: probably we don't want to accept empty app.yaml files.
 		if errors.Is(err, io.EOF) {
 			return descriptor, nil
@@ -125,6 +155,10 @@ func ParseDescriptorFile(file *paths.Path) (AppDescriptor, error) {
 		return AppDescriptor{}, fmt.Errorf("application name is empty")
 	}
 
+	if err := schema.ValidateYAML(schema.App, content); err != nil {
+		return AppDescriptor{}, fmt.Errorf("invalid descriptor: %w", err)
+	}
+
 	return descriptor, descriptor.IsValid()
 }
 
@@ -135,6 +169,9 @@ func (a *AppDescriptor) IsValid() error {
 			allErrors = errors.Join(allErrors, fmt.Errorf("icon %q is not a valid single emoji", a.Icon))
 		}
 	}
+	if a.FlashMode != "" && a.FlashMode != FlashModeRAM && a.FlashMode != FlashModeFlash {
+		allErrors = errors.Join(allErrors, fmt.Errorf("flash_mode %q is not valid, expected %s or %s", a.FlashMode, FlashModeRAM, FlashModeFlash))
+	}
 	return allErrors
 }
 
@@ -21,6 +21,8 @@ import (
 
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 )
 
 func TestAppParser(t *testing.T) {
@@ -62,6 +64,11 @@ func TestAppParser(t *testing.T) {
 	appPath = paths.New("testdata", "wrong-app.yaml")
 	app, err = ParseDescriptorFile(appPath)
 	require.Error(t, err)
+
+	// Test a case that is decoded but does not match the schema.
+	appPath = paths.New("testdata", "schema-mismatch-app.yaml")
+	_, err = ParseDescriptorFile(appPath)
+	require.ErrorContains(t, err, "invalid descriptor: does not match the app schema: at '/bricks/0'")
 }
 
 func TestIsSingleEmoji(t *testing.T) {
@@ -115,7 +122,7 @@ bricks:
 	err = os.WriteFile(appYaml.String(), []byte(appDescriptor), 0600)
 	require.NoError(t, err)
 
-	app, err := Load(tempDir)
+	app, err := Load(tempDir, config.Configuration{})
 	require.NoError(t, err)
 	require.Equal(t, "Test App", app.Name)
 	require.Equal(t, 1, len(app.Descriptor.Bricks))
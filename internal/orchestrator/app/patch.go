@@ -0,0 +1,491 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+)
+
+var errCannotPatch = errors.New("cannot patch descriptor")
+
+// patchDescriptor updates the content of an existing app descriptor to match the given
+// descriptor. Only the lines of the entries that changed are rewritten, so that comments,
+// formatting, key order and unknown keys of the rest of the file are preserved.
+func patchDescriptor(content []byte, desc AppDescriptor) ([]byte, error) {
+	var current AppDescriptor
+	if err := yaml.Unmarshal(content, &current); err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseBytes(content, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Docs) != 1 || file.Docs[0].Body == nil {
+		return nil, errCannotPatch
+	}
+
+	p := newDescriptorPatcher(content)
+	root, ok := p.mappingEntries(file.Docs[0].Body, len(p.lines))
+	if !ok || len(root) == 0 {
+		return nil, errCannotPatch
+	}
+	rootEnd := root[len(root)-1].end
+	rootIndent := root[0].indent
+
+	// Bricks first: keys appended at the end of the file must follow the appended bricks.
+	if !slices.EqualFunc(current.Bricks, desc.Bricks, bricksEqual) {
+		if err := p.patchBricks(findEntry(root, "bricks"), current.Bricks, desc.Bricks, rootEnd, rootIndent); err != nil {
+			return nil, err
+		}
+	}
+	type field struct {
+		key       string
+		changed   bool
+		value     any
+		omitEmpty bool
+		empty     bool
+	}
+	fields := []field{
+		{key: "format_version", changed: current.FormatVersion != desc.FormatVersion, value: desc.FormatVersion, omitEmpty: true, empty: desc.FormatVersion == 0},
+		{key: "name", changed: current.Name != desc.Name, value: desc.Name, empty: desc.Name == ""},
+		{key: "description", changed: current.Description != desc.Description, value: desc.Description, empty: desc.Description == ""},
+		{key: "icon", changed: current.Icon != desc.Icon, value: desc.Icon, omitEmpty: true, empty: desc.Icon == ""},
+		{key: "ports", changed: !slices.Equal(current.Ports, desc.Ports), value: ports(desc.Ports), empty: len(desc.Ports) == 0},
+		{key: "required_devices", changed: !slices.Equal(current.RequiredDevices, desc.RequiredDevices), value: desc.RequiredDevices, omitEmpty: true, empty: len(desc.RequiredDevices) == 0},
+		{key: "dev", changed: current.Dev != desc.Dev, value: desc.Dev, omitEmpty: true, empty: !desc.Dev},
+		{key: "flash_mode", changed: current.FlashMode != desc.FlashMode, value: desc.FlashMode, omitEmpty: true, empty: desc.FlashMode == ""},
+	}
+	for _, f := range fields {
+		if !f.changed {
+			continue
+		}
+		e := findEntry(root, f.key)
+		switch {
+		case e == nil && f.empty:
+		case e == nil:
+			at := rootEnd
+			if f.key == "format_version" {
+				at = root[0].start
+			}
+			if err := p.insertEntry(at, f.key, f.value, rootIndent); err != nil {
+				return nil, err
+			}
+		case f.empty && f.omitEmpty:
+			p.remove(e.start, e.end)
+		default:
+			if err := p.replaceEntry(e, f.value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	patched := p.apply()
+
+	// Make sure the patched file describes exactly the requested descriptor.
+	var check AppDescriptor
+	if err := yaml.Unmarshal(patched, &check); err != nil {
+		return nil, err
+	}
+	want, err := yaml.Marshal(desc)
+	if err != nil {
+		return nil, err
+	}
+	got, err := yaml.Marshal(check)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(want, got) {
+		return nil, errCannotPatch
+	}
+	return patched, nil
+}
+
+// ports avoids rendering a nil slice as null.
+func ports(p []int) []int {
+	if p == nil {
+		return []int{}
+	}
+	return p
+}
+
+func bricksEqual(a, b Brick) bool {
+	return a.ID == b.ID && a.Model == b.Model && maps.Equal(a.Variables, b.Variables)
+}
+
+// brickValue renders a brick without configuration as a plain id.
+func brickValue(b Brick) any {
+	if b.Model == "" && len(b.Variables) == 0 {
+		return b.ID
+	}
+	return map[string]Brick{b.ID: b}
+}
+
+type descriptorPatcher struct {
+	lines []string
+	edits []lineEdit
+}
+
+func newDescriptorPatcher(content []byte) *descriptorPatcher {
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return &descriptorPatcher{lines: lines}
+}
+
+// lineEdit replaces the lines in the [start, end) range, 0-based, with the given lines.
+type lineEdit struct {
+	start, end int
+	lines      []string
+}
+
+// entry is a key of a block mapping, spanning the [start, end) lines.
+type entry struct {
+	key    string
+	value  ast.Node
+	indent int
+	start  int
+	end    int
+}
+
+// item is an element of a block sequence, spanning the [start, end) lines.
+type item struct {
+	value  ast.Node
+	indent int
+	start  int
+	end    int
+}
+
+func findEntry(entries []entry, key string) *entry {
+	idx := slices.IndexFunc(entries, func(e entry) bool { return e.key == key })
+	if idx == -1 {
+		return nil
+	}
+	return &entries[idx]
+}
+
+func (p *descriptorPatcher) replace(start, end int, lines []string) {
+	p.edits = append(p.edits, lineEdit{start: start, end: end, lines: lines})
+}
+
+// remove deletes the lines in the [start, end) range together with the comments right above
+// them, which describe the removed node. The blank lines separating the node from its siblings
+// are removed too, so that the remaining ones keep the same layout.
+func (p *descriptorPatcher) remove(start, end int) {
+	indent := indentOf(p.lines[start])
+	for start > 0 && isCommentLine(p.lines[start-1]) && indentOf(p.lines[start-1]) == indent {
+		start--
+	}
+	switch {
+	case start > 0 && isBlankLine(p.lines[start-1]):
+		for start > 0 && isBlankLine(p.lines[start-1]) {
+			start--
+		}
+	case start == 0 || indentOf(p.lines[start-1]) < indent:
+		// The first node of a block: the blank lines after it would follow the parent.
+		for end < len(p.lines) && isBlankLine(p.lines[end]) {
+			end++
+		}
+	}
+	p.replace(start, end, nil)
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+func isBlankLine(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func isCommentLine(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), "#")
+}
+
+func (p *descriptorPatcher) apply() []byte {
+	// Inserts at the same position keep the order in which they have been registered.
+	edits := slices.Clone(p.edits)
+	slices.Reverse(edits)
+	sort.SliceStable(edits, func(i, j int) bool {
+		if edits[i].start != edits[j].start {
+			return edits[i].start > edits[j].start
+		}
+		return edits[i].end > edits[j].end
+	})
+	lines := slices.Clone(p.lines)
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		lines[len(lines)-1] += "\n"
+	}
+	for _, e := range edits {
+		lines = slices.Replace(lines, e.start, e.end, e.lines...)
+	}
+	return []byte(strings.Join(lines, ""))
+}
+
+// trimEnd excludes the trailing blank and comment lines, which belong to the next node.
+func (p *descriptorPatcher) trimEnd(start, end int) int {
+	for end-1 > start {
+		line := strings.TrimSpace(p.lines[end-1])
+		if line != "" && !strings.HasPrefix(line, "#") {
+			break
+		}
+		end--
+	}
+	return end
+}
+
+func (p *descriptorPatcher) mappingEntries(node ast.Node, limit int) ([]entry, bool) {
+	var values []*ast.MappingValueNode
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		if n.IsFlowStyle {
+			return nil, false
+		}
+		values = n.Values
+	case *ast.MappingValueNode:
+		values = []*ast.MappingValueNode{n}
+	default:
+		return nil, false
+	}
+	entries := make([]entry, len(values))
+	for i, v := range values {
+		pos := v.Key.GetToken().Position
+		entries[i] = entry{key: v.Key.GetToken().Value, value: v.Value, indent: pos.Column - 1, start: pos.Line - 1}
+		if i > 0 && entries[i].start <= entries[i-1].start {
+			return nil, false
+		}
+	}
+	for i := range entries {
+		end := limit
+		if i+1 < len(entries) {
+			end = entries[i+1].start
+		}
+		entries[i].end = p.trimEnd(entries[i].start, end)
+	}
+	return entries, true
+}
+
+func (p *descriptorPatcher) sequenceItems(node ast.Node, limit int) ([]item, bool) {
+	seq, ok := node.(*ast.SequenceNode)
+	if !ok || seq.IsFlowStyle || len(seq.Entries) != len(seq.Values) {
+		return nil, false
+	}
+	items := make([]item, len(seq.Entries))
+	for i, e := range seq.Entries {
+		pos := e.Start.Position
+		items[i] = item{value: e.Value, indent: pos.Column - 1, start: pos.Line - 1}
+		if i > 0 && items[i].start <= items[i-1].start {
+			return nil, false
+		}
+	}
+	for i := range items {
+		end := limit
+		if i+1 < len(items) {
+			end = items[i+1].start
+		}
+		items[i].end = p.trimEnd(items[i].start, end)
+	}
+	return items, true
+}
+
+func (p *descriptorPatcher) replaceEntry(e *entry, value any) error {
+	lines, err := renderEntry(e.key, value, e.indent)
+	if err != nil {
+		return err
+	}
+	p.replace(e.start, e.end, lines)
+	return nil
+}
+
+func (p *descriptorPatcher) insertEntry(at int, key string, value any, indent int) error {
+	lines, err := renderEntry(key, value, indent)
+	if err != nil {
+		return err
+	}
+	p.replace(at, at, lines)
+	return nil
+}
+
+func (p *descriptorPatcher) patchBricks(e *entry, current, desired []Brick, rootEnd, rootIndent int) error {
+	values := make([]any, len(desired))
+	for i, b := range desired {
+		values[i] = brickValue(b)
+	}
+	if e == nil {
+		return p.insertEntry(rootEnd, "bricks", values, rootIndent)
+	}
+
+	items, ok := p.sequenceItems(e.value, e.end)
+	if !ok || len(items) != len(current) || len(desired) == 0 || !sameRelativeOrder(current, desired) {
+		return p.replaceEntry(e, values)
+	}
+
+	for i, cur := range current {
+		idx := slices.IndexFunc(desired, func(b Brick) bool { return b.ID == cur.ID })
+		if idx == -1 {
+			p.remove(items[i].start, items[i].end)
+			continue
+		}
+		if bricksEqual(cur, desired[idx]) {
+			continue
+		}
+		if err := p.patchBrick(items[i], cur, desired[idx]); err != nil {
+			return err
+		}
+	}
+
+	var added []string
+	for _, b := range desired {
+		if slices.ContainsFunc(current, func(c Brick) bool { return c.ID == b.ID }) {
+			continue
+		}
+		lines, err := renderItem(brickValue(b), items[0].indent)
+		if err != nil {
+			return err
+		}
+		added = append(added, lines...)
+	}
+	if len(added) > 0 {
+		at := items[len(items)-1].end
+		p.replace(at, at, added)
+	}
+	return nil
+}
+
+func (p *descriptorPatcher) patchBrick(it item, current, desired Brick) error {
+	replaceItem := func() error {
+		lines, err := renderItem(brickValue(desired), it.indent)
+		if err != nil {
+			return err
+		}
+		p.replace(it.start, it.end, lines)
+		return nil
+	}
+
+	brick, ok := p.mappingEntries(it.value, it.end)
+	if !ok || len(brick) != 1 {
+		return replaceItem()
+	}
+	details, ok := p.mappingEntries(brick[0].value, it.end)
+	if !ok || len(details) == 0 {
+		return replaceItem()
+	}
+	detailsIndent := details[0].indent
+
+	if current.Model != desired.Model {
+		switch e := findEntry(details, "model"); {
+		case e == nil:
+			if err := p.insertEntry(details[0].start, "model", desired.Model, detailsIndent); err != nil {
+				return err
+			}
+		case desired.Model == "":
+			p.remove(e.start, e.end)
+		default:
+			if err := p.replaceEntry(e, desired.Model); err != nil {
+				return err
+			}
+		}
+	}
+
+	if maps.Equal(current.Variables, desired.Variables) {
+		return nil
+	}
+	e := findEntry(details, "variables")
+	if e == nil {
+		return p.insertEntry(details[len(details)-1].end, "variables", desired.Variables, detailsIndent)
+	}
+	if len(desired.Variables) == 0 {
+		p.remove(e.start, e.end)
+		return nil
+	}
+	variables, ok := p.mappingEntries(e.value, e.end)
+	if !ok || len(variables) == 0 {
+		return p.replaceEntry(e, desired.Variables)
+	}
+	for _, v := range variables {
+		value, found := desired.Variables[v.key]
+		if !found {
+			p.remove(v.start, v.end)
+			continue
+		}
+		if current.Variables[v.key] == value {
+			continue
+		}
+		if err := p.replaceEntry(&v, value); err != nil {
+			return err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(desired.Variables)) {
+		if findEntry(variables, name) != nil {
+			continue
+		}
+		if err := p.insertEntry(variables[len(variables)-1].end, name, desired.Variables[name], variables[0].indent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sameRelativeOrder reports whether the bricks present in both lists appear in the same order.
+func sameRelativeOrder(current, desired []Brick) bool {
+	keep := func(list, other []Brick) []string {
+		var ids []string
+		for _, b := range list {
+			if slices.ContainsFunc(other, func(o Brick) bool { return o.ID == b.ID }) {
+				ids = append(ids, b.ID)
+			}
+		}
+		return ids
+	}
+	return slices.Equal(keep(current, desired), keep(desired, current))
+}
+
+func renderEntry(key string, value any, indent int) ([]string, error) {
+	out, err := yaml.Marshal(yaml.MapSlice{{Key: key, Value: value}})
+	if err != nil {
+		return nil, err
+	}
+	return indentLines(out, indent), nil
+}
+
+func renderItem(value any, indent int) ([]string, error) {
+	out, err := yaml.Marshal([]any{value})
+	if err != nil {
+		return nil, err
+	}
+	return indentLines(out, indent), nil
+}
+
+func indentLines(out []byte, indent int) []string {
+	lines := strings.SplitAfter(string(out), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	prefix := strings.Repeat(" ", indent)
+	for i := range lines {
+		lines[i] = prefix + lines[i]
+	}
+	return lines
+}
@@ -0,0 +1,184 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+)
+
+const handMaintainedDescriptor = `# My app, maintained by hand.
+name: Hand made   # the app name
+description: An app with comments
+
+# Exposed ports
+ports:
+  - 8080
+
+bricks:
+  # The UI
+  - arduino:web_ui
+
+  # The detector
+  - arduino:object_detection:
+      model: yolox-object-detection # default model
+      variables:
+        CONFIDENCE: "0.5"   # keep it low
+        CUSTOM_MODEL_PATH: /models
+
+x-notes: unknown keys are kept
+`
+
+func TestPatchDescriptor(t *testing.T) {
+	load := func(t *testing.T) AppDescriptor {
+		var desc AppDescriptor
+		require.NoError(t, yaml.Unmarshal([]byte(handMaintainedDescriptor), &desc))
+		return desc
+	}
+
+	t.Run("unchanged descriptor", func(t *testing.T) {
+		out, err := patchDescriptor([]byte(handMaintainedDescriptor), load(t))
+		require.NoError(t, err)
+		require.Equal(t, handMaintainedDescriptor, string(out))
+	})
+
+	t.Run("change name and add icon", func(t *testing.T) {
+		desc := load(t)
+		desc.Name = "Renamed"
+		desc.Icon = "🚀"
+		out, err := patchDescriptor([]byte(handMaintainedDescriptor), desc)
+		require.NoError(t, err)
+		require.Equal(t, `# My app, maintained by hand.
+name: Renamed
+description: An app with comments
+
+# Exposed ports
+ports:
+  - 8080
+
+bricks:
+  # The UI
+  - arduino:web_ui
+
+  # The detector
+  - arduino:object_detection:
+      model: yolox-object-detection # default model
+      variables:
+        CONFIDENCE: "0.5"   # keep it low
+        CUSTOM_MODEL_PATH: /models
+
+x-notes: unknown keys are kept
+icon: 🚀
+`, string(out))
+	})
+
+	t.Run("update brick variables and model", func(t *testing.T) {
+		desc := load(t)
+		desc.Bricks[1].Model = "face-detection"
+		desc.Bricks[1].Variables = map[string]string{"CONFIDENCE": "0.5", "EXTRA": "1"}
+		out, err := patchDescriptor([]byte(handMaintainedDescriptor), desc)
+		require.NoError(t, err)
+		require.Equal(t, `# My app, maintained by hand.
+name: Hand made   # the app name
+description: An app with comments
+
+# Exposed ports
+ports:
+  - 8080
+
+bricks:
+  # The UI
+  - arduino:web_ui
+
+  # The detector
+  - arduino:object_detection:
+      model: face-detection
+      variables:
+        CONFIDENCE: "0.5"   # keep it low
+        EXTRA: "1"
+
+x-notes: unknown keys are kept
+`, string(out))
+	})
+
+	t.Run("add and remove bricks", func(t *testing.T) {
+		desc := load(t)
+		desc.Bricks = []Brick{
+			desc.Bricks[1],
+			{ID: "arduino:dbstorage_sqlstore"},
+			{ID: "arduino:camera", Variables: map[string]string{"DEVICE": "/dev/video0"}},
+		}
+		out, err := patchDescriptor([]byte(handMaintainedDescriptor), desc)
+		require.NoError(t, err)
+		require.Equal(t, `# My app, maintained by hand.
+name: Hand made   # the app name
+description: An app with comments
+
+# Exposed ports
+ports:
+  - 8080
+
+bricks:
+  # The detector
+  - arduino:object_detection:
+      model: yolox-object-detection # default model
+      variables:
+        CONFIDENCE: "0.5"   # keep it low
+        CUSTOM_MODEL_PATH: /models
+  - arduino:dbstorage_sqlstore
+  - arduino:camera:
+      variables:
+        DEVICE: /dev/video0
+
+x-notes: unknown keys are kept
+`, string(out))
+	})
+
+	t.Run("remove a brick with its comments", func(t *testing.T) {
+		desc := load(t)
+		desc.Bricks = desc.Bricks[:1]
+		out, err := patchDescriptor([]byte(handMaintainedDescriptor), desc)
+		require.NoError(t, err)
+		require.Equal(t, `# My app, maintained by hand.
+name: Hand made   # the app name
+description: An app with comments
+
+# Exposed ports
+ports:
+  - 8080
+
+bricks:
+  # The UI
+  - arduino:web_ui
+
+x-notes: unknown keys are kept
+`, string(out))
+	})
+
+	t.Run("reordered bricks rewrite only the bricks entry", func(t *testing.T) {
+		desc := load(t)
+		desc.Bricks[0], desc.Bricks[1] = desc.Bricks[1], desc.Bricks[0]
+		out, err := patchDescriptor([]byte(handMaintainedDescriptor), desc)
+		require.NoError(t, err)
+		require.Contains(t, string(out), "# My app, maintained by hand.\nname: Hand made   # the app name\n")
+		require.Contains(t, string(out), "\nx-notes: unknown keys are kept\n")
+		var got AppDescriptor
+		require.NoError(t, yaml.Unmarshal(out, &got))
+		require.Equal(t, desc, got)
+	})
+}
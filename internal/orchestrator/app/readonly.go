@@ -0,0 +1,56 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+// ErrReadOnly is returned when modifying an app of a read-only app root.
+var ErrReadOnly = errors.New("the app belongs to a read-only app root")
+
+// readOnlyStateDir returns the folder holding the provisioning state of an app of a
+// read-only app root, or nil if the app is not in a read-only root. The folder of these
+// apps is not writable, so their state is kept in the data folder.
+func readOnlyStateDir(cfg config.Configuration, appPath *paths.Path) *paths.Path {
+	for _, root := range cfg.AppRoots() {
+		if !root.ReadOnly {
+			continue
+		}
+		if inside, err := appPath.IsInsideDir(root.Path); err != nil || !inside {
+			continue
+		}
+		rel, err := appPath.RelFrom(root.Path)
+		if err != nil {
+			continue
+		}
+		return cfg.DataDir().Join("read-only-apps", root.Prefix).JoinPath(rel)
+	}
+	return nil
+}
+
+// CheckWritable returns an ErrReadOnly if the app belongs to a read-only app root.
+func (a *ArduinoApp) CheckWritable() error {
+	if a.ReadOnly {
+		return fmt.Errorf("%w: %s", ErrReadOnly, a.FullPath)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func TestReadOnlyAppRoot(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	t.Setenv("ARDUINO_APP_CLI__APPS_DIR", tmp.Join("apps").String())
+	t.Setenv("ARDUINO_APP_CLI__DATA_DIR", tmp.Join("data").String())
+	t.Setenv("ARDUINO_APP_CLI__APP_ROOTS", "usb="+tmp.Join("usb").String()+",ro;team="+tmp.Join("team").String())
+	cfg, err := config.NewFromEnv()
+	require.NoError(t, err)
+
+	for _, dir := range []*paths.Path{tmp.Join("usb", "usb-app"), tmp.Join("team", "team-app")} {
+		require.NoError(t, dir.Join("python").MkdirAll())
+		require.NoError(t, dir.Join("python", "main.py").WriteFile(nil))
+		require.NoError(t, dir.Join("app.yaml").WriteFile([]byte("name: app\n")))
+	}
+
+	t.Run("read-only root", func(t *testing.T) {
+		usbApp, err := Load(tmp.Join("usb", "usb-app").String(), cfg)
+		require.NoError(t, err)
+		require.True(t, usbApp.ReadOnly)
+		require.Equal(t, cfg.DataDir().Join("read-only-apps", "usb", "usb-app").String(), usbApp.ProvisioningStateDir().String())
+		require.DirExists(t, usbApp.ProvisioningStateDir().String())
+		require.NoDirExists(t, usbApp.FullPath.Join(".cache").String())
+
+		require.ErrorIs(t, usbApp.Save(), ErrReadOnly)
+		_, err = Migrate(usbApp.FullPath, cfg)
+		require.ErrorIs(t, err, ErrReadOnly)
+	})
+
+	t.Run("writable root", func(t *testing.T) {
+		teamApp, err := Load(tmp.Join("team", "team-app").String(), cfg)
+		require.NoError(t, err)
+		require.False(t, teamApp.ReadOnly)
+		require.Equal(t, teamApp.FullPath.Join(".cache").String(), teamApp.ProvisioningStateDir().String())
+		require.NoError(t, teamApp.CheckWritable())
+	})
+}
@@ -0,0 +1,418 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/codeclysm/extract/v4"
+	"github.com/goccy/go-yaml"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+var (
+	ErrInvalidBundle      = errors.New("invalid app bundle")
+	ErrIncompatibleBundle = errors.New("app bundle not compatible with the installed bricks and models")
+)
+
+type BundleFormat string
+
+const (
+	BundleFormatZip   BundleFormat = "zip"
+	BundleFormatTarGz BundleFormat = "tar.gz"
+)
+
+const (
+	bundleManifestVersion  = 1
+	bundleManifestFileName = "manifest.yaml"
+	bundleAppDir           = "app"
+)
+
+// BundleFormatFromFileName returns the bundle format matching the extension of the given file name.
+func BundleFormatFromFileName(name string) (BundleFormat, error) {
+	switch {
+	case strings.HasSuffix(name, ".zip"):
+		return BundleFormatZip, nil
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return BundleFormatTarGz, nil
+	}
+	return "", fmt.Errorf("unsupported bundle format for %q, use .zip or .tar.gz", name)
+}
+
+// AppBundleManifest describes what an exported app needs to run.
+type AppBundleManifest struct {
+	ManifestVersion  int      `yaml:"manifest_version" json:"manifest_version"`
+	Name             string   `yaml:"name" json:"name"`
+	RunnerVersion    string   `yaml:"runner_version" json:"runner_version"`
+	AppFormatVersion int      `yaml:"app_format_version" json:"app_format_version"`
+	Bricks           []string `yaml:"bricks" json:"bricks"`
+	Models           []string `yaml:"models,omitempty" json:"models,omitempty"`
+	SketchLibraries  []string `yaml:"sketch_libraries,omitempty" json:"sketch_libraries,omitempty"`
+	IncludesData     bool     `yaml:"includes_data" json:"includes_data"`
+}
+
+type ExportAppRequest struct {
+	Format      BundleFormat
+	IncludeData bool
+}
+
+// ExportApp writes the app as a bundle, made of a manifest and of the app folder without
+// the .cache folder and, unless requested, without the data folder.
+func ExportApp(ctx context.Context, userApp app.ArduinoApp, req ExportAppRequest, w io.Writer, cfg config.Configuration) (AppBundleManifest, error) {
+	manifest := AppBundleManifest{
+		ManifestVersion:  bundleManifestVersion,
+		Name:             userApp.Name,
+		RunnerVersion:    cfg.RunnerVersion,
+		AppFormatVersion: app.CurrentFormatVersion,
+		Bricks:           []string{},
+		IncludesData:     req.IncludeData,
+	}
+	for _, b := range userApp.Descriptor.Bricks {
+		manifest.Bricks = append(manifest.Bricks, b.ID)
+		if b.Model != "" && !slices.Contains(manifest.Models, b.Model) {
+			manifest.Models = append(manifest.Models, b.Model)
+		}
+	}
+	// The libraries referenced by a folder outside the app are bundled in its libraries folder.
+	var externalLibs []externalLibrary
+	if userApp.MainSketchPath != nil && userApp.MainSketchPath.Join("sketch.yaml").Exist() {
+		libs, err := ListSketchLibraries(ctx, userApp)
+		if err != nil {
+			return AppBundleManifest{}, fmt.Errorf("unable to list sketch libraries: %w", err)
+		}
+		for _, l := range libs {
+			if l.Path != "" {
+				dir := paths.New(l.Path)
+				if !dir.IsAbs() {
+					dir = userApp.MainSketchPath.JoinPath(dir)
+				}
+				if !isInsideApp(userApp, dir) {
+					bundled := userApp.FullPath.Join(vendoredLibrariesDir, dir.Base())
+					if bundled.Exist() {
+						return AppBundleManifest{}, fmt.Errorf("unable to bundle library %s: %s already exists", l.Name, bundled)
+					}
+					rel, err := userApp.MainSketchPath.RelTo(bundled)
+					if err != nil {
+						return AppBundleManifest{}, err
+					}
+					externalLibs = append(externalLibs, externalLibrary{dir: dir, original: l.Path, bundled: filepath.ToSlash(rel.String())})
+					l.Path = filepath.ToSlash(rel.String())
+				}
+			}
+			manifest.SketchLibraries = append(manifest.SketchLibraries, l.String())
+		}
+	}
+
+	exported := func(file *paths.Path) bool {
+		rel, err := userApp.FullPath.RelTo(file)
+		if err != nil {
+			return false
+		}
+		return rel.String() != ".cache" && (req.IncludeData || rel.String() != "data")
+	}
+	files, err := userApp.FullPath.ReadDirRecursiveFiltered(exported, exported)
+	if err != nil {
+		return AppBundleManifest{}, fmt.Errorf("unable to read app directory: %w", err)
+	}
+	files.Sort()
+
+	var bw bundleWriter
+	switch req.Format {
+	case BundleFormatZip:
+		bw = &zipBundleWriter{w: zip.NewWriter(w)}
+	case BundleFormatTarGz:
+		gz := gzip.NewWriter(w)
+		bw = &tarBundleWriter{gz: gz, w: tar.NewWriter(gz)}
+	default:
+		return AppBundleManifest{}, fmt.Errorf("unsupported bundle format %q", req.Format)
+	}
+
+	manifestContent, err := yaml.Marshal(manifest)
+	if err != nil {
+		return AppBundleManifest{}, err
+	}
+	if err := bw.addFile(bundleManifestFileName, 0644, manifestContent); err != nil {
+		return AppBundleManifest{}, err
+	}
+	addToBundle := func(file *paths.Path, rel string) error {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		name := bundleAppDir + "/" + rel
+		info, err := file.Lstat()
+		if err != nil {
+			return err
+		}
+		switch {
+		case info.IsDir():
+			err = bw.addDir(name + "/")
+		case info.Mode().IsRegular():
+			var content []byte
+			if content, err = file.ReadFile(); err == nil {
+				if len(externalLibs) > 0 && userApp.MainSketchPath != nil && file.EqualsTo(userApp.MainSketchPath.Join("sketch.yaml")) {
+					content = rewriteSketchLibraryDirs(content, externalLibs)
+				}
+				err = bw.addFile(name, info.Mode().Perm(), content)
+			}
+		default:
+			slog.Warn("skipping non regular file from app bundle", slog.String("file", file.String()))
+		}
+		if err != nil {
+			return fmt.Errorf("unable to add %s to the bundle: %w", rel, err)
+		}
+		return nil
+	}
+	for _, file := range files {
+		rel, err := userApp.FullPath.RelTo(file)
+		if err != nil {
+			return AppBundleManifest{}, err
+		}
+		if err := addToBundle(file, filepath.ToSlash(rel.String())); err != nil {
+			return AppBundleManifest{}, err
+		}
+	}
+	for _, lib := range externalLibs {
+		libFiles, err := lib.dir.ReadDirRecursive()
+		if err != nil {
+			return AppBundleManifest{}, fmt.Errorf("unable to read library %s: %w", lib.dir, err)
+		}
+		libFiles.Sort()
+		root := vendoredLibrariesDir + "/" + lib.dir.Base()
+		if err := addToBundle(lib.dir, root); err != nil {
+			return AppBundleManifest{}, err
+		}
+		for _, file := range libFiles {
+			rel, err := lib.dir.RelTo(file)
+			if err != nil {
+				return AppBundleManifest{}, err
+			}
+			if err := addToBundle(file, root+"/"+filepath.ToSlash(rel.String())); err != nil {
+				return AppBundleManifest{}, err
+			}
+		}
+	}
+	if err := bw.Close(); err != nil {
+		return AppBundleManifest{}, err
+	}
+	return manifest, nil
+}
+
+// externalLibrary is a sketch library referenced by a folder outside the app.
+type externalLibrary struct {
+	dir      *paths.Path
+	original string
+	bundled  string
+}
+
+// rewriteSketchLibraryDirs points the library folders of the sketch project file to the
+// copies of the external libraries made in the bundle.
+func rewriteSketchLibraryDirs(content []byte, libs []externalLibrary) []byte {
+	lines := strings.SplitAfter(string(content), "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		value, ok := strings.CutPrefix(trimmed, "- dir:")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		for _, lib := range libs {
+			if value == lib.original {
+				indent := line[:strings.Index(line, "-")]
+				lines[i] = indent + "- dir: " + lib.bundled + line[len(strings.TrimRight(line, "\r\n")):]
+				break
+			}
+		}
+	}
+	return []byte(strings.Join(lines, ""))
+}
+
+type bundleWriter interface {
+	addDir(name string) error
+	addFile(name string, perm fs.FileMode, content []byte) error
+	Close() error
+}
+
+type zipBundleWriter struct {
+	w *zip.Writer
+}
+
+func (z *zipBundleWriter) addDir(name string) error {
+	_, err := z.w.Create(name)
+	return err
+}
+
+func (z *zipBundleWriter) addFile(name string, perm fs.FileMode, content []byte) error {
+	header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+	header.SetMode(perm)
+	f, err := z.w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+func (z *zipBundleWriter) Close() error {
+	return z.w.Close()
+}
+
+type tarBundleWriter struct {
+	gz *gzip.Writer
+	w  *tar.Writer
+}
+
+func (t *tarBundleWriter) addDir(name string) error {
+	return t.w.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: name, Mode: 0755})
+}
+
+func (t *tarBundleWriter) addFile(name string, perm fs.FileMode, content []byte) error {
+	if err := t.w.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: int64(perm), Size: int64(len(content))}); err != nil {
+		return err
+	}
+	_, err := t.w.Write(content)
+	return err
+}
+
+func (t *tarBundleWriter) Close() error {
+	if err := t.w.Close(); err != nil {
+		return err
+	}
+	return t.gz.Close()
+}
+
+type ImportAppRequest struct {
+	Name *string
+	// Force imports the app even if some of its bricks or models are not available.
+	Force bool
+}
+
+type ImportAppResponse struct {
+	ID       app.ID            `json:"id"`
+	Manifest AppBundleManifest `json:"manifest"`
+	Warnings []string          `json:"warnings"`
+}
+
+// ImportApp extracts an app bundle into the apps folder, after checking that the bricks
+// and models listed in its manifest are available.
+func ImportApp(
+	ctx context.Context,
+	bundle io.Reader,
+	req ImportAppRequest,
+	bricksIndex *bricksindex.BricksIndex,
+	modelsIndex *modelsindex.ModelsIndex,
+	idProvider *app.IDProvider,
+	cfg config.Configuration,
+) (ImportAppResponse, error) {
+	if err := cfg.AppsDir().MkdirAll(); err != nil {
+		return ImportAppResponse{}, err
+	}
+	tmpDir, err := paths.MkTempDir(cfg.AppsDir().String(), ".import-")
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("unable to create temporary directory: %w", err)
+	}
+	defer func() { _ = tmpDir.RemoveAll() }()
+
+	if err := extract.Archive(ctx, bundle, tmpDir.String(), nil); err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+
+	content, err := tmpDir.Join(bundleManifestFileName).ReadFile()
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: missing %s", ErrInvalidBundle, bundleManifestFileName)
+	}
+	var manifest AppBundleManifest
+	if err := yaml.Unmarshal(content, &manifest); err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: invalid manifest: %w", ErrInvalidBundle, err)
+	}
+	if manifest.ManifestVersion > bundleManifestVersion {
+		return ImportAppResponse{}, fmt.Errorf("%w: manifest version %d is not supported", ErrInvalidBundle, manifest.ManifestVersion)
+	}
+
+	appDir := tmpDir.Join(bundleAppDir)
+	importedApp, err := app.Load(appDir.String(), cfg)
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+	}
+
+	warnings := checkBundledApp(importedApp.Descriptor, bricksIndex, modelsIndex)
+	if len(warnings) > 0 && !req.Force {
+		return ImportAppResponse{}, fmt.Errorf("%w: %s", ErrIncompatibleBundle, strings.Join(warnings, "; "))
+	}
+	if manifest.RunnerVersion != "" && manifest.RunnerVersion != cfg.RunnerVersion {
+		warnings = append(warnings, fmt.Sprintf("the app has been exported with runner version %s, the installed one is %s", manifest.RunnerVersion, cfg.RunnerVersion))
+	}
+
+	name := importedApp.Name
+	if req.Name != nil && *req.Name != "" {
+		name = *req.Name
+	}
+	dstPath, exist := findAppPathByName(name, cfg)
+	if exist {
+		return ImportAppResponse{}, ErrAppAlreadyExists
+	}
+	if name != importedApp.Name {
+		importedApp.Descriptor.Name = name
+		if err := importedApp.Save(); err != nil {
+			return ImportAppResponse{}, fmt.Errorf("unable to rename the app: %w", err)
+		}
+	}
+	if err := appDir.Rename(dstPath); err != nil {
+		return ImportAppResponse{}, fmt.Errorf("unable to move the app into the apps folder: %w", err)
+	}
+
+	id, err := idProvider.IDFromPath(dstPath)
+	if err != nil {
+		return ImportAppResponse{}, fmt.Errorf("failed to get app id: %w", err)
+	}
+	return ImportAppResponse{ID: id, Manifest: manifest, Warnings: warnings}, nil
+}
+
+// checkBundledApp returns the bricks and models used by the app missing from the local indexes.
+// They come from the app descriptor: the manifest is informational and may not match the app.
+func checkBundledApp(descriptor app.AppDescriptor, bricksIndex *bricksindex.BricksIndex, modelsIndex *modelsindex.ModelsIndex) []string {
+	var problems []string
+	var models []string
+	for _, b := range descriptor.Bricks {
+		if _, found := bricksIndex.FindBrickByID(b.ID); !found {
+			problems = append(problems, fmt.Sprintf("brick %s is not available", b.ID))
+		}
+		if b.Model != "" && !slices.Contains(models, b.Model) {
+			models = append(models, b.Model)
+		}
+	}
+	for _, id := range models {
+		if _, found := modelsIndex.GetModelByID(id); !found {
+			problems = append(problems, fmt.Sprintf("model %s is not available", id))
+		}
+	}
+	return problems
+}
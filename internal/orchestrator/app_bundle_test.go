@@ -0,0 +1,166 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func TestExportImportApp(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	bricksIndex := &bricksindex.BricksIndex{
+		Bricks: []bricksindex.Brick{{ID: "arduino:object_detection"}},
+	}
+	modelsDir := paths.New(t.TempDir())
+	require.NoError(t, modelsDir.Join("models-list.yaml").WriteFile([]byte(`
+models:
+- face-detection:
+    runner: brick
+    name: Face detection
+    bricks:
+    - arduino:object_detection
+`)))
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(modelsDir)
+	require.NoError(t, err)
+
+	appDir := paths.New(t.TempDir(), "my-app")
+	require.NoError(t, appDir.Join("python").MkdirAll())
+	require.NoError(t, appDir.Join("python", "main.py").WriteFile([]byte("print('hello')\n")))
+	require.NoError(t, appDir.Join("app.yaml").WriteFile([]byte(`# Exported app
+name: My app
+bricks:
+  - arduino:object_detection:
+      model: face-detection
+`)))
+	require.NoError(t, appDir.Join("data").MkdirAll())
+	require.NoError(t, appDir.Join("data", "db.sqlite").WriteFile([]byte("data")))
+	userApp := f.Must(app.Load(appDir.String(), cfg))
+	require.NoError(t, userApp.ProvisioningStateDir().Join("app-compose.yaml").WriteFile([]byte("cache")))
+
+	t.Run("export", func(t *testing.T) {
+		var bundle bytes.Buffer
+		manifest, err := ExportApp(t.Context(), userApp, ExportAppRequest{Format: BundleFormatZip}, &bundle, cfg)
+		require.NoError(t, err)
+		require.Equal(t, AppBundleManifest{
+			ManifestVersion:  1,
+			Name:             "My app",
+			RunnerVersion:    cfg.RunnerVersion,
+			AppFormatVersion: app.CurrentFormatVersion,
+			Bricks:           []string{"arduino:object_detection"},
+			Models:           []string{"face-detection"},
+		}, manifest)
+
+		zr, err := zip.NewReader(bytes.NewReader(bundle.Bytes()), int64(bundle.Len()))
+		require.NoError(t, err)
+		names := f.Map(zr.File, func(f *zip.File) string { return f.Name })
+		require.Equal(t, []string{"manifest.yaml", "app/app.yaml", "app/python/", "app/python/main.py"}, names)
+
+		bundle.Reset()
+		manifest, err = ExportApp(t.Context(), userApp, ExportAppRequest{Format: BundleFormatTarGz, IncludeData: true}, &bundle, cfg)
+		require.NoError(t, err)
+		require.True(t, manifest.IncludesData)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		var bundle bytes.Buffer
+		_, err := ExportApp(t.Context(), userApp, ExportAppRequest{Format: BundleFormatTarGz, IncludeData: true}, &bundle, cfg)
+		require.NoError(t, err)
+
+		res, err := ImportApp(t.Context(), bytes.NewReader(bundle.Bytes()), ImportAppRequest{}, bricksIndex, modelsIndex, idProvider, cfg)
+		require.NoError(t, err)
+		require.Empty(t, res.Warnings)
+		require.Equal(t, cfg.AppsDir().Join("my-app"), res.ID.ToPath())
+		require.Equal(t, "data", string(f.Must(res.ID.ToPath().Join("data", "db.sqlite").ReadFile())))
+		require.Equal(t, f.Must(appDir.Join("app.yaml").ReadFile()), f.Must(res.ID.ToPath().Join("app.yaml").ReadFile()))
+
+		// Importing again conflicts with the existing app, unless it is renamed.
+		_, err = ImportApp(t.Context(), bytes.NewReader(bundle.Bytes()), ImportAppRequest{}, bricksIndex, modelsIndex, idProvider, cfg)
+		require.ErrorIs(t, err, ErrAppAlreadyExists)
+		res, err = ImportApp(t.Context(), bytes.NewReader(bundle.Bytes()), ImportAppRequest{Name: f.Ptr("Other app")}, bricksIndex, modelsIndex, idProvider, cfg)
+		require.NoError(t, err)
+		imported := f.Must(app.Load(res.ID.ToPath().String(), cfg))
+		require.Equal(t, "Other app", imported.Name)
+	})
+
+	t.Run("import with missing bricks and models", func(t *testing.T) {
+		var bundle bytes.Buffer
+		_, err := ExportApp(t.Context(), userApp, ExportAppRequest{Format: BundleFormatZip}, &bundle, cfg)
+		require.NoError(t, err)
+
+		emptyBricks := &bricksindex.BricksIndex{}
+		_, err = ImportApp(t.Context(), bytes.NewReader(bundle.Bytes()), ImportAppRequest{Name: f.Ptr("Missing")}, emptyBricks, modelsIndex, idProvider, cfg)
+		require.ErrorIs(t, err, ErrIncompatibleBundle)
+		require.ErrorContains(t, err, "brick arduino:object_detection is not available")
+		require.NoDirExists(t, cfg.AppsDir().Join("missing").String())
+
+		res, err := ImportApp(t.Context(), bytes.NewReader(bundle.Bytes()), ImportAppRequest{Name: f.Ptr("Missing"), Force: true}, emptyBricks, modelsIndex, idProvider, cfg)
+		require.NoError(t, err)
+		require.Equal(t, []string{"brick arduino:object_detection is not available"}, res.Warnings)
+	})
+
+	t.Run("import with a manifest not matching the app", func(t *testing.T) {
+		var bundle bytes.Buffer
+		zw := zip.NewWriter(&bundle)
+		for name, content := range map[string]string{
+			"manifest.yaml":      "manifest_version: 1\nname: Tampered\nbricks: []\n",
+			"app/app.yaml":       "name: Tampered\nbricks:\n  - arduino:object_detection:\n      model: unknown-model\n",
+			"app/python/main.py": "print('hello')\n",
+		} {
+			w, err := zw.Create(name)
+			require.NoError(t, err)
+			_, err = w.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+
+		_, err := ImportApp(t.Context(), bytes.NewReader(bundle.Bytes()), ImportAppRequest{}, &bricksindex.BricksIndex{}, modelsIndex, idProvider, cfg)
+		require.ErrorIs(t, err, ErrIncompatibleBundle)
+		require.ErrorContains(t, err, "brick arduino:object_detection is not available")
+		require.ErrorContains(t, err, "model unknown-model is not available")
+	})
+
+	t.Run("invalid bundle", func(t *testing.T) {
+		_, err := ImportApp(t.Context(), bytes.NewReader([]byte("not a bundle")), ImportAppRequest{}, bricksIndex, modelsIndex, idProvider, cfg)
+		require.ErrorIs(t, err, ErrInvalidBundle)
+	})
+}
+
+func TestFindAppPathsSkipsHiddenFolders(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	id := createApp(t, "app1", false, idProvider, cfg)
+
+	// An app being imported is extracted in a hidden temporary folder of the apps folder.
+	importing := cfg.AppsDir().Join(".import-1234", "app")
+	require.NoError(t, importing.MkdirAll())
+	require.NoError(t, importing.Join("app.yaml").WriteFile([]byte("name: Importing\n")))
+
+	appPaths, err := findAppPaths(cfg.AppsDir())
+	require.NoError(t, err)
+	require.Equal(t, paths.PathList{id.ToPath()}, appPaths)
+}
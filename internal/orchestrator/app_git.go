@@ -0,0 +1,234 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+var (
+	ErrNotGitRepository = errors.New("app is not in a git repository")
+	ErrNothingToCommit  = errors.New("nothing to commit")
+)
+
+// appGitIgnore is the .gitignore generated for new app repositories, it
+// excludes the runtime state of the app.
+const appGitIgnore = `.cache/
+data/
+`
+
+// defaultGitAuthor is used to sign the commits when the repository has no author configured.
+var defaultGitAuthor = object.Signature{Name: "arduino-app-cli"}
+
+type AppGitInfo struct {
+	Branch     string        `json:"branch,omitempty" description:"current branch, empty when HEAD is detached"`
+	Dirty      bool          `json:"dirty" description:"true if the app folder has uncommitted changes"`
+	LastCommit *AppGitCommit `json:"last_commit,omitempty"`
+}
+
+type AppGitCommit struct {
+	Hash    string    `json:"hash" required:"true"`
+	Message string    `json:"message"`
+	Author  string    `json:"author"`
+	Date    time.Time `json:"date"`
+}
+
+// openAppRepository opens the git repository containing the app, that can be
+// the app folder itself or any of its parents. It returns also the app path
+// relative to the repository root.
+func openAppRepository(appPath *paths.Path) (*git.Repository, string, error) {
+	repo, err := git.PlainOpenWithOptions(appPath.String(), &git.PlainOpenOptions{DetectDotGit: true})
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		return nil, "", ErrNotGitRepository
+	} else if err != nil {
+		return nil, "", err
+	}
+	wt, err := repo.Worktree()
+	if errors.Is(err, git.ErrIsBareRepository) {
+		return nil, "", ErrNotGitRepository
+	} else if err != nil {
+		return nil, "", err
+	}
+	rel, err := paths.New(wt.Filesystem.Root()).RelTo(appPath)
+	if err != nil {
+		return nil, "", err
+	}
+	return repo, filepath.ToSlash(rel.String()), nil
+}
+
+// AppGitStatus returns the git status of the app, or nil if the app is not in a git repository.
+func AppGitStatus(userApp app.ArduinoApp) (*AppGitInfo, error) {
+	repo, rel, err := openAppRepository(userApp.FullPath)
+	if errors.Is(err, ErrNotGitRepository) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	res := &AppGitInfo{}
+	head, err := repo.Head()
+	switch {
+	case errors.Is(err, plumbing.ErrReferenceNotFound):
+		// No commits yet: HEAD points to an unborn branch.
+		if ref, err := repo.Storer.Reference(plumbing.HEAD); err == nil && ref.Type() == plumbing.SymbolicReference {
+			res.Branch = ref.Target().Short()
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if head.Name().IsBranch() {
+			res.Branch = head.Name().Short()
+		}
+		commit, err := repo.CommitObject(head.Hash())
+		if err != nil {
+			return nil, err
+		}
+		res.LastCommit = toAppGitCommit(commit)
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	status, err := wt.Status()
+	if err != nil {
+		return nil, err
+	}
+	for file, s := range status {
+		if !isPathInApp(file, rel) {
+			continue
+		}
+		if s.Worktree != git.Unmodified || s.Staging != git.Unmodified {
+			res.Dirty = true
+			break
+		}
+	}
+	return res, nil
+}
+
+// InitAppGitRepository creates a git repository in the app folder, with a
+// .gitignore excluding the app runtime state.
+func InitAppGitRepository(appPath *paths.Path) error {
+	if _, err := git.PlainInit(appPath.String(), false); err != nil {
+		return fmt.Errorf("failed to initialize git repository: %w", err)
+	}
+	gitignore := appPath.Join(".gitignore")
+	if gitignore.Exist() {
+		return nil
+	}
+	return gitignore.WriteFile([]byte(appGitIgnore))
+}
+
+type CommitAppRequest struct {
+	Message     string
+	AuthorName  string
+	AuthorEmail string
+}
+
+// CommitApp stages all the changes of the app folder and commits them.
+func CommitApp(userApp app.ArduinoApp, req CommitAppRequest) (AppGitCommit, error) {
+	if err := userApp.CheckWritable(); err != nil {
+		return AppGitCommit{}, err
+	}
+	if strings.TrimSpace(req.Message) == "" {
+		return AppGitCommit{}, fmt.Errorf("commit message cannot be empty")
+	}
+	repo, rel, err := openAppRepository(userApp.FullPath)
+	if err != nil {
+		return AppGitCommit{}, err
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return AppGitCommit{}, err
+	}
+	if err := wt.AddWithOptions(&git.AddOptions{Path: rel}); err != nil {
+		return AppGitCommit{}, fmt.Errorf("failed to stage app changes: %w", err)
+	}
+
+	author, err := commitAuthor(repo, req)
+	if err != nil {
+		return AppGitCommit{}, err
+	}
+	hash, err := wt.Commit(req.Message, &git.CommitOptions{Author: author})
+	if errors.Is(err, git.ErrEmptyCommit) {
+		return AppGitCommit{}, ErrNothingToCommit
+	} else if err != nil {
+		return AppGitCommit{}, fmt.Errorf("failed to commit app changes: %w", err)
+	}
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return AppGitCommit{}, err
+	}
+	return *toAppGitCommit(commit), nil
+}
+
+func commitAuthor(repo *git.Repository, req CommitAppRequest) (*object.Signature, error) {
+	author := defaultGitAuthor
+	if cfg, err := repo.ConfigScoped(gitconfig.SystemScope); err != nil {
+		return nil, err
+	} else if cfg.User.Name != "" {
+		author = object.Signature{Name: cfg.User.Name, Email: cfg.User.Email}
+	}
+	if req.AuthorName != "" {
+		author = object.Signature{Name: req.AuthorName, Email: req.AuthorEmail}
+	}
+	author.When = time.Now()
+	return &author, nil
+}
+
+// cloneAppRepository clones the app from a local bare git repository.
+func cloneAppRepository(repository, dst *paths.Path) error {
+	repo, err := git.PlainOpen(repository.String())
+	if err != nil {
+		return fmt.Errorf("failed to open git repository %s: %w", repository, err)
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+	if !cfg.Core.IsBare {
+		return fmt.Errorf("%s is not a bare git repository", repository)
+	}
+	if _, err := git.PlainClone(dst.String(), false, &git.CloneOptions{URL: repository.String()}); err != nil {
+		return fmt.Errorf("failed to clone git repository: %w", err)
+	}
+	return nil
+}
+
+func isPathInApp(file, appRelPath string) bool {
+	return appRelPath == "." || file == appRelPath || strings.HasPrefix(file, appRelPath+"/")
+}
+
+func toAppGitCommit(c *object.Commit) *AppGitCommit {
+	return &AppGitCommit{
+		Hash:    c.Hash.String(),
+		Message: strings.TrimSpace(c.Message),
+		Author:  c.Author.Name,
+		Date:    c.Author.When,
+	}
+}
@@ -0,0 +1,96 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+func TestAppGit(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "Git app", GitInit: true}, idProvider, &bricksindex.BricksIndex{}, cfg)
+	require.NoError(t, err)
+	appPath := resp.ID.ToPath()
+	require.Equal(t, appGitIgnore, string(f.Must(appPath.Join(".gitignore").ReadFile())))
+	userApp := f.Must(app.Load(appPath.String(), cfg))
+
+	t.Run("status of a new repository", func(t *testing.T) {
+		info, err := AppGitStatus(userApp)
+		require.NoError(t, err)
+		require.Equal(t, &AppGitInfo{Branch: "master", Dirty: true}, info)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		commit, err := CommitApp(userApp, CommitAppRequest{Message: "Initial commit", AuthorName: "Tester"})
+		require.NoError(t, err)
+		require.Equal(t, "Initial commit", commit.Message)
+		require.Equal(t, "Tester", commit.Author)
+
+		info, err := AppGitStatus(userApp)
+		require.NoError(t, err)
+		require.False(t, info.Dirty)
+		require.Equal(t, commit, *info.LastCommit)
+
+		// The runtime state of the app is ignored.
+		require.NoError(t, appPath.Join("data").MkdirAll())
+		require.NoError(t, appPath.Join("data", "db.sqlite").WriteFile([]byte("data")))
+		_, err = CommitApp(userApp, CommitAppRequest{Message: "Nothing"})
+		require.ErrorIs(t, err, ErrNothingToCommit)
+
+		require.NoError(t, appPath.Join("python", "main.py").WriteFile([]byte("print('changed')\n")))
+		info, err = AppGitStatus(userApp)
+		require.NoError(t, err)
+		require.True(t, info.Dirty)
+		_, err = CommitApp(userApp, CommitAppRequest{Message: "Change main.py"})
+		require.NoError(t, err)
+	})
+
+	t.Run("app outside a repository", func(t *testing.T) {
+		resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "No git"}, idProvider, &bricksindex.BricksIndex{}, cfg)
+		require.NoError(t, err)
+		noGitApp := f.Must(app.Load(resp.ID.ToPath().String(), cfg))
+		info, err := AppGitStatus(noGitApp)
+		require.NoError(t, err)
+		require.Nil(t, info)
+		_, err = CommitApp(noGitApp, CommitAppRequest{Message: "Commit"})
+		require.ErrorIs(t, err, ErrNotGitRepository)
+	})
+
+	t.Run("clone from a bare repository", func(t *testing.T) {
+		bare := paths.New(t.TempDir(), "remote-app.git")
+		_, err := git.PlainClone(bare.String(), true, &git.CloneOptions{URL: appPath.String()})
+		require.NoError(t, err)
+
+		res, err := CloneApp(t.Context(), CloneAppRequest{FromRepository: bare}, idProvider, cfg)
+		require.NoError(t, err)
+		require.Equal(t, cfg.AppsDir().Join("remote-app-copy0"), res.ID.ToPath())
+		require.Equal(t, "print('changed')\n", string(f.Must(res.ID.ToPath().Join("python", "main.py").ReadFile())))
+
+		_, err = CloneApp(t.Context(), CloneAppRequest{FromRepository: appPath, Name: f.Ptr("Not bare")}, idProvider, cfg)
+		require.ErrorContains(t, err, "is not a bare git repository")
+		require.NoDirExists(t, cfg.AppsDir().Join("not-bare").String())
+	})
+}
@@ -0,0 +1,65 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+type AppMigrationResult struct {
+	Path        string   `json:"path"`
+	FromVersion int      `json:"from_version"`
+	ToVersion   int      `json:"to_version"`
+	Migrations  []string `json:"migrations,omitempty"`
+	Backup      string   `json:"backup,omitempty"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// MigrateApps upgrades the app.yaml of the given apps to the current format version,
+// keeping a backup of the original descriptors. When no app is given, all the apps in
+// the apps folder are migrated.
+func MigrateApps(appPaths paths.PathList, cfg config.Configuration) ([]AppMigrationResult, error) {
+	if len(appPaths) == 0 {
+		var err error
+		if appPaths, err = findAppPaths(cfg.AppsDir()); err != nil {
+			return nil, fmt.Errorf("unable to list apps: %w", err)
+		}
+	}
+
+	results := make([]AppMigrationResult, 0, len(appPaths))
+	for _, appPath := range appPaths {
+		res, err := app.Migrate(appPath, cfg)
+		result := AppMigrationResult{
+			Path:        appPath.String(),
+			FromVersion: res.FromVersion,
+			ToVersion:   res.ToVersion,
+			Migrations:  res.Migrations,
+		}
+		if res.BackupPath != nil {
+			result.Backup = res.BackupPath.String()
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -0,0 +1,413 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/containerd/errdefs"
+	"github.com/docker/cli/cli/command"
+	"github.com/goccy/go-yaml"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/helpers"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+)
+
+// MissingDependenciesError is returned when an app is started offline and some of the
+// images, models, platforms or libraries it needs are not available on the board.
+type MissingDependenciesError struct {
+	Missing []string
+}
+
+func (e *MissingDependenciesError) Error() string {
+	return fmt.Sprintf(
+		"unable to start the app offline, missing %s: run `arduino-app-cli app prepare` while online",
+		strings.Join(e.Missing, ", "),
+	)
+}
+
+// StartAppOptions are the options of StartApp and RestartApp.
+type StartAppOptions struct {
+	// ForceUpload compiles and uploads the sketch even if it did not change since the last upload.
+	ForceUpload bool
+	// Offline never downloads anything: the start fails with a MissingDependenciesError if
+	// something the app needs is not available on the board.
+	Offline bool
+}
+
+// PrepareApp downloads everything the app needs to start without network access: the
+// platforms, tools and libraries of the sketch profile, the models and the container images.
+func PrepareApp(
+	ctx context.Context,
+	docker command.Cli,
+	provisioner *Provision,
+	modelsIndex *modelsindex.ModelsIndex,
+	bricksIndex *bricksindex.BricksIndex,
+	userApp app.ArduinoApp,
+	cfg config.Configuration,
+	staticStore *store.StaticStore,
+) iter.Seq[StreamMessage] {
+	return func(yield func(StreamMessage) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		callbackWriter := NewCallbackWriter(func(line string) {
+			if !yield(StreamMessage{data: line}) {
+				cancel()
+				return
+			}
+		})
+		if !yield(StreamMessage{data: fmt.Sprintf("Preparing app %q", userApp.Name)}) {
+			return
+		}
+
+		if userApp.MainSketchPath != nil {
+			if !yield(StreamMessage{progress: &Progress{Name: "sketch platforms and libraries", Progress: 0.0}}) {
+				return
+			}
+			if err := prepareSketch(ctx, &userApp, callbackWriter); err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
+		}
+
+		if userApp.MainPythonFile != nil {
+			if !yield(StreamMessage{progress: &Progress{Name: "models", Progress: 30.0}}) {
+				return
+			}
+			modelPaths, err := fetchAppModels(ctx, userApp, modelsIndex, sharedModelCache(cfg))
+			if err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
+			envs := getAppEnvironmentVariables(userApp, bricksIndex, modelsIndex)
+			resolveModelPaths(envs, userApp, modelsIndex, modelPaths)
+			if err := provisioner.App(ctx, bricksIndex, &userApp, cfg, envs, staticStore); err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
+
+			if !yield(StreamMessage{progress: &Progress{Name: "images", Progress: 50.0}}) {
+				return
+			}
+			dockerParser := NewDockerProgressParser(200)
+			dockerWriter := NewCallbackWriter(func(line string) {
+				if percentage, ok := dockerParser.Parse(line); ok {
+					// assumption: docker pull progress goes from 50 to 100% of the total progress
+					if !yield(StreamMessage{progress: &Progress{Name: "images", Progress: float32(50.0 + percentage/2.0)}}) {
+						cancel()
+					}
+					return
+				}
+				if !yield(StreamMessage{data: line}) {
+					cancel()
+				}
+			})
+			commands := appComposeCommand(&userApp, "pull")
+			slog.Debug("pulling app images", slog.String("command", strings.Join(commands, " ")))
+			process, err := paths.NewProcess(envs.AsList(), commands...)
+			if err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
+			process.RedirectStderrTo(dockerWriter)
+			process.RedirectStdoutTo(dockerWriter)
+			if err := process.RunWithinContext(ctx); err != nil {
+				yield(StreamMessage{error: fmt.Errorf("pulling the images of the app: %w", err)})
+				return
+			}
+		}
+		_ = yield(StreamMessage{progress: &Progress{Name: "", Progress: 100.0}})
+	}
+}
+
+// prepareSketch downloads the platforms, tools and libraries of the default profile of the sketch.
+func prepareSketch(ctx context.Context, arduinoApp *app.ArduinoApp, w io.Writer) error {
+	// The libraries of the profile are looked up in the library index.
+	if err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		return ensureLibrariesIndex(ctx, s)
+	}); err != nil {
+		return err
+	}
+
+	req, err := sketchProfileRequest(ctx, arduinoApp, w)
+	if err != nil {
+		return err
+	}
+	var initErrors []string
+	callback := req.InitCallback
+	req.InitCallback = func(r *rpc.InitResponse) error {
+		if e := r.GetError(); e != nil {
+			initErrors = append(initErrors, e.GetMessage())
+		}
+		return callback(r)
+	}
+	return arduinocli.Shared().Run(ctx, req, func(s *arduinocli.Session) error {
+		if len(initErrors) > 0 {
+			s.Discard()
+			return fmt.Errorf("unable to fetch the sketch dependencies: %s", strings.Join(initErrors, "; "))
+		}
+		return nil
+	})
+}
+
+// missingAppDependencies returns what the app needs to start and is not available on the
+// board, without downloading anything.
+func missingAppDependencies(
+	ctx context.Context,
+	docker command.Cli,
+	provisioner *Provision,
+	modelsIndex *modelsindex.ModelsIndex,
+	bricksIndex *bricksindex.BricksIndex,
+	userApp *app.ArduinoApp,
+	cfg config.Configuration,
+	staticStore *store.StaticStore,
+) ([]string, error) {
+	var missing []string
+	if userApp.MainSketchPath != nil {
+		items, err := missingSketchDependencies(ctx, userApp)
+		if err != nil {
+			return nil, err
+		}
+		missing = append(missing, items...)
+	}
+
+	if userApp.MainPythonFile != nil {
+		modelPaths, missingModels := cachedAppModels(*userApp, modelsIndex, sharedModelCache(cfg))
+		for _, id := range missingModels {
+			missing = append(missing, "model "+id)
+		}
+
+		envs := getAppEnvironmentVariables(*userApp, bricksIndex, modelsIndex)
+		resolveModelPaths(envs, *userApp, modelsIndex, modelPaths)
+		if err := provisioner.App(ctx, bricksIndex, userApp, cfg, envs, staticStore); err != nil {
+			return nil, err
+		}
+		images, err := appComposeImages(ctx, userApp, envs)
+		if err != nil {
+			return nil, err
+		}
+		for _, image := range images {
+			if _, err := docker.Client().ImageInspect(ctx, image); errdefs.IsNotFound(err) {
+				missing = append(missing, "image "+image)
+			} else if err != nil {
+				return nil, fmt.Errorf("inspecting image %s: %w", image, err)
+			}
+		}
+	}
+	return missing, nil
+}
+
+// missingSketchDependencies returns the platforms and libraries of the default profile of the
+// sketch that are not installed. The profile instance is not initialized, since its init
+// downloads whatever is missing: the profile is compared with the installed platforms and with
+// the profiles cache of arduino-cli instead. The tools come with their platform.
+func missingSketchDependencies(ctx context.Context, arduinoApp *app.ArduinoApp) ([]string, error) {
+	srv := arduinocli.Shared().Server()
+	sketchPath := arduinoApp.MainSketchPath
+	sketchResp, err := srv.LoadSketch(ctx, &rpc.LoadSketchRequest{SketchPath: sketchPath.String()})
+	if err != nil {
+		return nil, err
+	}
+	profile := sketchResp.GetSketch().GetDefaultProfile().GetName()
+	if profile == "" {
+		return nil, fmt.Errorf("sketch %q has no default profile", sketchPath)
+	}
+	platforms, err := sketchProfilePlatforms(sketchPath, profile)
+	if err != nil {
+		return nil, err
+	}
+	libsResp, err := srv.ProfileLibList(ctx, &rpc.ProfileLibListRequest{SketchPath: sketchPath.String(), ProfileName: profile})
+	if err != nil {
+		return nil, err
+	}
+	dataDir, err := arduinocli.Shared().DataDir(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profilesCache := paths.New(dataDir, "internal")
+
+	var missing []string
+	err = arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		searchResp, err := s.Server.PlatformSearch(ctx, &rpc.PlatformSearchRequest{Instance: s.Instance})
+		if err != nil {
+			return err
+		}
+		installed := map[string]bool{}
+		for _, platform := range searchResp.GetSearchOutput() {
+			if platform.GetInstalledVersion() != "" {
+				installed[platform.GetMetadata().GetId()] = true
+			}
+		}
+		for _, platform := range platforms {
+			if platform.Version == "" && !installed[platform.ID] ||
+				platform.Version != "" && !profilesCacheHas(profilesCache, platform.profilesCacheDirName(), "") {
+				missing = append(missing, "platform "+platform.String())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lib := range f.Map(libsResp.GetLibraries(), rpcProfileLibReferenceToLibReleaseID) {
+		if lib.Path != "" {
+			libDir := paths.New(lib.Path)
+			if !libDir.IsAbs() {
+				libDir = sketchPath.JoinPath(libDir)
+			}
+			if !libDir.IsDir() {
+				missing = append(missing, "library "+lib.String())
+			}
+		} else if !profilesCacheHas(profilesCache, profilesCacheDirName(lib.String(), lib.String()), lib.Name) {
+			missing = append(missing, "library "+lib.String())
+		}
+	}
+	return missing, nil
+}
+
+// profilePlatform is a platform of a sketch profile. Without a version the platform installed
+// in the system is used.
+type profilePlatform struct {
+	ID       string
+	Version  string
+	IndexURL string
+}
+
+func (p profilePlatform) String() string {
+	if p.Version == "" {
+		return p.ID
+	}
+	return p.ID + "@" + p.Version
+}
+
+// profilesCacheDirName returns the name of the profiles cache folder of the platform: the
+// index url of the platform is part of the hash, not of the name.
+func (p profilePlatform) profilesCacheDirName() string {
+	ref := p.String()
+	if p.IndexURL != "" {
+		ref += " (" + p.IndexURL + ")"
+	}
+	return profilesCacheDirName(p.String(), ref)
+}
+
+// sketchProfilePlatforms reads the platforms of a profile from the sketch project file: they
+// are not returned by the arduino-cli RPCs.
+func sketchProfilePlatforms(sketchPath *paths.Path, profile string) ([]profilePlatform, error) {
+	projectFile := sketchPath.Join("sketch.yaml")
+	if !projectFile.Exist() {
+		projectFile = sketchPath.Join("sketch.yml")
+	}
+	content, err := projectFile.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var project struct {
+		Profiles map[string]struct {
+			Platforms []struct {
+				Platform         string `yaml:"platform"`
+				PlatformIndexURL string `yaml:"platform_index_url"`
+			} `yaml:"platforms"`
+		} `yaml:"profiles"`
+	}
+	if err := yaml.Unmarshal(content, &project); err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", projectFile, err)
+	}
+	var platforms []profilePlatform
+	for _, p := range project.Profiles[profile].Platforms {
+		// The platform is in the form `packager:arch` or `packager:arch (version)`.
+		id, version, _ := strings.Cut(strings.TrimSpace(p.Platform), " ")
+		version = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(version), "("), ")")
+		platforms = append(platforms, profilePlatform{ID: id, Version: version, IndexURL: strings.TrimSpace(p.PlatformIndexURL)})
+	}
+	return platforms, nil
+}
+
+// profilesCacheHas reports if the profiles cache of arduino-cli contains the given folder;
+// the libraries are in a sub folder named as the library.
+func profilesCacheHas(profilesCache *paths.Path, dirName string, subDir string) bool {
+	return profilesCache.Join(dirName, subDir).IsDir()
+}
+
+// profilesCacheDirName returns the name of a folder of the profiles cache as arduino-cli
+// computes it: the name followed by the first 16 hex digits of the sha256 of the reference.
+func profilesCacheDirName(name string, ref string) string {
+	sum := sha256.Sum256([]byte(ref))
+	return sanitizeProfileCacheName(name + "_" + hex.EncodeToString(sum[:])[:16])
+}
+
+// sanitizeProfileCacheName replaces the characters that arduino-cli does not use in the
+// names of the profiles cache folders and truncates the names to 64 characters.
+func sanitizeProfileCacheName(name string) string {
+	var b strings.Builder
+	for i, c := range name {
+		if c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 0 && (c == '-' || c == '.') {
+			b.WriteRune(c)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	sanitized := b.String()
+	if len(sanitized) > 64 {
+		sanitized = sanitized[:64]
+	}
+	return sanitized
+}
+
+// appComposeImages returns the images used by the services of the compose project of the app.
+func appComposeImages(ctx context.Context, userApp *app.ArduinoApp, envs helpers.EnvVars) ([]string, error) {
+	process, err := paths.NewProcess(envs.AsList(), appComposeCommand(userApp, "config", "--images")...)
+	if err != nil {
+		return nil, err
+	}
+	stdout, stderr, err := process.RunAndCaptureOutput(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing the images of the app: %w: %s", err, strings.TrimSpace(string(stderr)))
+	}
+	var images []string
+	for line := range strings.Lines(string(stdout)) {
+		if image := strings.TrimSpace(line); image != "" {
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
+
+// appComposeCommand returns the docker compose command line for the compose project of the app.
+func appComposeCommand(userApp *app.ArduinoApp, args ...string) []string {
+	commands := []string{"docker", "compose", "-f", userApp.AppComposeFilePath().String()}
+	if overrideComposeFile := userApp.AppComposeOverrideFilePath(); overrideComposeFile.Exist() {
+		commands = append(commands, "-f", overrideComposeFile.String())
+	}
+	return append(commands, args...)
+}
@@ -0,0 +1,109 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelcache"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func TestCachedAppModels(t *testing.T) {
+	dir := paths.New(t.TempDir())
+	require.NoError(t, dir.Join("models-list.yaml").WriteFile([]byte(`
+models:
+  - builtin-model:
+      runner: brick
+      bricks:
+        - arduino:object_detection
+  - cached-model:
+      runner: brick
+      download:
+        url: cached.eim
+        sha256: AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA
+  - missing-model:
+      runner: brick
+      download:
+        url: missing.eim
+        sha256: bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb
+`)))
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(dir)
+	require.NoError(t, err)
+
+	cache := modelcache.New(dir.Join("cache"), nil)
+	cachedPath, err := cache.Path(strings.Repeat("a", 64))
+	require.NoError(t, err)
+	require.NoError(t, cachedPath.Parent().MkdirAll())
+	require.NoError(t, cachedPath.WriteFile([]byte("model")))
+
+	userApp := app.ArduinoApp{Descriptor: app.AppDescriptor{Bricks: []app.Brick{
+		{ID: "arduino:object_detection", Model: "builtin-model"},
+		{ID: "arduino:image_classification", Model: "cached-model"},
+		{ID: "arduino:keyword_spotting", Model: "missing-model"},
+		{ID: "arduino:audio_classification", Model: "missing-model"},
+	}}}
+	modelPaths, missing := cachedAppModels(userApp, modelsIndex, cache)
+	require.Equal(t, map[string]*paths.Path{"cached-model": cachedPath}, modelPaths)
+	require.Equal(t, []string{"missing-model"}, missing)
+}
+
+func TestSketchProfilePlatforms(t *testing.T) {
+	sketchPath := paths.New(t.TempDir())
+	require.NoError(t, sketchPath.Join("sketch.yaml").WriteFile([]byte(`
+profiles:
+  default:
+    fqbn: arduino:zephyr:unoq
+    platforms:
+      - platform: arduino:zephyr
+      - platform: arduino:mbed (4.1.5)
+        platform_index_url: https://example.com/package_index.json
+default_profile: default
+`)))
+	platforms, err := sketchProfilePlatforms(sketchPath, "default")
+	require.NoError(t, err)
+	require.Equal(t, []profilePlatform{{ID: "arduino:zephyr"}, {ID: "arduino:mbed", Version: "4.1.5", IndexURL: "https://example.com/package_index.json"}}, platforms)
+}
+
+func TestProfilesCacheDirName(t *testing.T) {
+	// The expected names are the ones of the folders created by arduino-cli.
+	mbed := profilePlatform{ID: "arduino:mbed", Version: "4.1.5"}
+	require.Equal(t, "arduino_mbed_4.1.5_a292050b13d2f614", mbed.profilesCacheDirName())
+	mbed.IndexURL = "https://example.com/package_index.json"
+	require.Equal(t, "arduino_mbed_4.1.5_68716674518aab3a", mbed.profilesCacheDirName())
+	require.Equal(t, "MsgPack_0.4.2_a0d4adc5044d022c", profilesCacheDirName("MsgPack@0.4.2", "MsgPack@0.4.2"))
+
+	longName := "Adafruit BusIO Register Library For Very Long Library Names@1.16.1"
+	require.Equal(t, "Adafruit_BusIO_Register_Library_For_Very_Long_Library_Names_1.16", profilesCacheDirName(longName, longName))
+}
+
+func TestProfilesCacheHas(t *testing.T) {
+	profilesCache := paths.New(t.TempDir())
+	require.NoError(t, profilesCache.Join("arduino_mbed_4.1.5_a292050b13d2f614").MkdirAll())
+	require.NoError(t, profilesCache.Join("MsgPack_0.4.2_a0d4adc5044d022c", "MsgPack").MkdirAll())
+	require.NoError(t, profilesCache.Join("DebugLog_0.8.4_0123456789abcdef").MkdirAll())
+
+	require.True(t, profilesCacheHas(profilesCache, "arduino_mbed_4.1.5_a292050b13d2f614", ""))
+	require.False(t, profilesCacheHas(profilesCache, "arduino_mbed_4.1.6_a292050b13d2f614", ""))
+	require.True(t, profilesCacheHas(profilesCache, "MsgPack_0.4.2_a0d4adc5044d022c", "MsgPack"))
+	// An interrupted install leaves the folder without the library.
+	require.False(t, profilesCacheHas(profilesCache, "DebugLog_0.8.4_0123456789abcdef", "DebugLog"))
+}
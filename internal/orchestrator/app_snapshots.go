@@ -0,0 +1,280 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// MaxAppSnapshots is the number of snapshots kept for each app, the oldest
+// ones are removed when a new snapshot is taken.
+const MaxAppSnapshots = 20
+
+const (
+	snapshotsDirName     = "snapshots"
+	snapshotMetadataFile = "snapshot.yaml"
+	snapshotIDLayout     = "20060102T150405.000000000Z"
+)
+
+// snapshotFiles are the app files and folders saved in a snapshot, relative to the app folder.
+var snapshotFiles = []string{"app.yaml", "app.yml", "sketch/sketch.yaml", "python"}
+
+type AppSnapshot struct {
+	ID        string    `json:"id" yaml:"id" required:"true"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at" required:"true"`
+	Reason    string    `json:"reason,omitempty" yaml:"reason,omitempty" description:"the operation that triggered the snapshot"`
+	Files     []string  `json:"files" yaml:"files" description:"app files and folders saved in the snapshot"`
+	Hash      string    `json:"-" yaml:"hash"`
+}
+
+func appSnapshotsDir(userApp app.ArduinoApp) *paths.Path {
+	return userApp.ProvisioningStateDir().Join(snapshotsDirName)
+}
+
+// SnapshotApp saves a copy of the app descriptor, of the sketch project file and of
+// the python folder. If nothing changed since the latest snapshot, no new snapshot
+// is taken and the latest one is returned.
+func SnapshotApp(userApp app.ArduinoApp, reason string) (AppSnapshot, error) {
+	snapshot, _, err := takeAppSnapshot(userApp, reason)
+	if err != nil {
+		return AppSnapshot{}, err
+	}
+	if err := pruneAppSnapshots(userApp); err != nil {
+		return AppSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// RunWithAppSnapshot snapshots the app before calling fn, keeping the snapshot only if fn
+// reports that it succeeded. fn is called even if the snapshot cannot be taken.
+func RunWithAppSnapshot(userApp app.ArduinoApp, reason string, fn func() bool) error {
+	snapshot, created, err := takeAppSnapshot(userApp, reason)
+	if err != nil {
+		fn()
+		return err
+	}
+	if !fn() {
+		if created {
+			return appSnapshotsDir(userApp).Join(snapshot.ID).RemoveAll()
+		}
+		return nil
+	}
+	return pruneAppSnapshots(userApp)
+}
+
+// takeAppSnapshot saves a new snapshot of the app, unless nothing changed since the latest
+// one, and reports whether the snapshot has been created.
+func takeAppSnapshot(userApp app.ArduinoApp, reason string) (AppSnapshot, bool, error) {
+	var files []string
+	for _, name := range snapshotFiles {
+		if userApp.FullPath.Join(name).Exist() {
+			files = append(files, name)
+		}
+	}
+	hash, err := hashAppFiles(userApp.FullPath, files)
+	if err != nil {
+		return AppSnapshot{}, false, fmt.Errorf("failed to hash app files: %w", err)
+	}
+
+	snapshots, err := ListAppSnapshots(userApp)
+	if err != nil {
+		return AppSnapshot{}, false, err
+	}
+	if len(snapshots) > 0 && snapshots[0].Hash == hash {
+		return snapshots[0], false, nil
+	}
+
+	now := time.Now().UTC()
+	snapshot := AppSnapshot{
+		ID:        now.Format(snapshotIDLayout),
+		CreatedAt: now,
+		Reason:    reason,
+		Files:     files,
+		Hash:      hash,
+	}
+	dir := appSnapshotsDir(userApp).Join(snapshot.ID)
+	if dir.Exist() {
+		return AppSnapshot{}, false, fmt.Errorf("snapshot %s already exists", snapshot.ID)
+	}
+	if err := copyAppEntries(userApp.FullPath, dir, files); err != nil {
+		_ = dir.RemoveAll()
+		return AppSnapshot{}, false, fmt.Errorf("failed to copy app files: %w", err)
+	}
+	metadata, err := yaml.Marshal(snapshot)
+	if err != nil {
+		_ = dir.RemoveAll()
+		return AppSnapshot{}, false, err
+	}
+	if err := dir.Join(snapshotMetadataFile).WriteFile(metadata); err != nil {
+		_ = dir.RemoveAll()
+		return AppSnapshot{}, false, err
+	}
+	return snapshot, true, nil
+}
+
+// pruneAppSnapshots removes the oldest snapshots of the app beyond the retention limit.
+func pruneAppSnapshots(userApp app.ArduinoApp) error {
+	snapshots, err := ListAppSnapshots(userApp)
+	if err != nil {
+		return err
+	}
+	for _, old := range snapshots[min(len(snapshots), MaxAppSnapshots):] {
+		if err := appSnapshotsDir(userApp).Join(old.ID).RemoveAll(); err != nil {
+			return fmt.Errorf("failed to remove old snapshot %s: %w", old.ID, err)
+		}
+	}
+	return nil
+}
+
+// ListAppSnapshots returns the snapshots of the app, the most recent first.
+func ListAppSnapshots(userApp app.ArduinoApp) ([]AppSnapshot, error) {
+	dir := appSnapshotsDir(userApp)
+	if !dir.IsDir() {
+		return []AppSnapshot{}, nil
+	}
+	dirs, err := dir.ReadDir(paths.FilterDirectories())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshots: %w", err)
+	}
+	res := make([]AppSnapshot, 0, len(dirs))
+	for _, d := range dirs {
+		snapshot, err := loadAppSnapshot(d)
+		if err != nil {
+			// Skip incomplete snapshots.
+			continue
+		}
+		res = append(res, snapshot)
+	}
+	slices.SortFunc(res, func(a, b AppSnapshot) int { return strings.Compare(b.ID, a.ID) })
+	return res, nil
+}
+
+// RestoreAppSnapshot replaces the app files with the ones saved in the given snapshot.
+// The current state of the app is snapshotted before, so that the restore can be undone.
+func RestoreAppSnapshot(userApp app.ArduinoApp, id string) (AppSnapshot, error) {
+	if err := userApp.CheckWritable(); err != nil {
+		return AppSnapshot{}, err
+	}
+	if id == "" || strings.ContainsAny(id, `/\`) || strings.Contains(id, "..") {
+		return AppSnapshot{}, ErrSnapshotNotFound
+	}
+	dir := appSnapshotsDir(userApp).Join(id)
+	snapshot, err := loadAppSnapshot(dir)
+	if err != nil {
+		return AppSnapshot{}, ErrSnapshotNotFound
+	}
+
+	if _, err := SnapshotApp(userApp, "restore "+id); err != nil {
+		return AppSnapshot{}, fmt.Errorf("failed to snapshot the app before restoring: %w", err)
+	}
+
+	for _, name := range snapshotFiles {
+		current := userApp.FullPath.Join(name)
+		if !slices.Contains(snapshot.Files, name) {
+			// The descriptor may be named app.yml in the snapshot and app.yaml
+			// now, or vice versa: keep only one of them.
+			if strings.HasPrefix(name, "app.") && current.Exist() {
+				if err := current.Remove(); err != nil {
+					return AppSnapshot{}, err
+				}
+			}
+			continue
+		}
+		if err := current.RemoveAll(); err != nil {
+			return AppSnapshot{}, fmt.Errorf("failed to remove %s: %w", name, err)
+		}
+	}
+	if err := copyAppEntries(dir, userApp.FullPath, snapshot.Files); err != nil {
+		return AppSnapshot{}, fmt.Errorf("failed to restore app files: %w", err)
+	}
+	return snapshot, nil
+}
+
+func loadAppSnapshot(dir *paths.Path) (AppSnapshot, error) {
+	content, err := dir.Join(snapshotMetadataFile).ReadFile()
+	if err != nil {
+		return AppSnapshot{}, err
+	}
+	var snapshot AppSnapshot
+	if err := yaml.Unmarshal(content, &snapshot); err != nil {
+		return AppSnapshot{}, err
+	}
+	if snapshot.ID != dir.Base() {
+		return AppSnapshot{}, fmt.Errorf("snapshot id mismatch: %s", snapshot.ID)
+	}
+	return snapshot, nil
+}
+
+func copyAppEntries(src, dst *paths.Path, names []string) error {
+	for _, name := range names {
+		from, to := src.Join(name), dst.Join(name)
+		if err := to.Parent().MkdirAll(); err != nil {
+			return err
+		}
+		if from.IsDir() {
+			if err := from.CopyDirTo(to); err != nil {
+				return err
+			}
+		} else if err := from.CopyTo(to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hashAppFiles computes a digest of the content of the given app files and folders.
+func hashAppFiles(base *paths.Path, names []string) (string, error) {
+	h := sha256.New()
+	for _, name := range names {
+		p := base.Join(name)
+		files := paths.PathList{p}
+		if p.IsDir() {
+			var err error
+			files, err = p.ReadDirRecursiveFiltered(nil, paths.FilterOutDirectories())
+			if err != nil {
+				return "", err
+			}
+			files.Sort()
+		}
+		for _, file := range files {
+			rel, err := base.RelTo(file)
+			if err != nil {
+				return "", err
+			}
+			content, err := file.ReadFile()
+			if err != nil {
+				return "", err
+			}
+			_, _ = io.WriteString(h, fmt.Sprintf("%s\x00%d\x00", rel.String(), len(content)))
+			_, _ = h.Write(content)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,100 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+func TestAppSnapshots(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "Snapshot app"}, idProvider, &bricksindex.BricksIndex{}, cfg)
+	require.NoError(t, err)
+	appPath := resp.ID.ToPath()
+	userApp := f.Must(app.Load(appPath.String(), cfg))
+	originalDescriptor := f.Must(appPath.Join("app.yaml").ReadFile())
+	originalMain := f.Must(appPath.Join("python", "main.py").ReadFile())
+
+	first, err := SnapshotApp(userApp, "first")
+	require.NoError(t, err)
+	require.Equal(t, []string{"app.yaml", "sketch/sketch.yaml", "python"}, first.Files)
+
+	// Nothing changed: the latest snapshot is reused.
+	again, err := SnapshotApp(userApp, "again")
+	require.NoError(t, err)
+	require.Equal(t, first.ID, again.ID)
+
+	require.NoError(t, appPath.Join("app.yaml").WriteFile([]byte("name: Edited\n")))
+	require.NoError(t, appPath.Join("python", "main.py").Remove())
+	require.NoError(t, appPath.Join("python", "other.py").WriteFile([]byte("print('other')\n")))
+
+	restored, err := RestoreAppSnapshot(userApp, first.ID)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, restored.ID)
+	require.Equal(t, originalDescriptor, f.Must(appPath.Join("app.yaml").ReadFile()))
+	require.Equal(t, originalMain, f.Must(appPath.Join("python", "main.py").ReadFile()))
+	require.NoFileExists(t, appPath.Join("python", "other.py").String())
+
+	// The state before the restore has been snapshotted too.
+	snapshots, err := ListAppSnapshots(userApp)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, "restore "+first.ID, snapshots[0].Reason)
+	require.Equal(t, first.ID, snapshots[1].ID)
+
+	_, err = RestoreAppSnapshot(userApp, "missing")
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+	_, err = RestoreAppSnapshot(userApp, "../..")
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+
+	t.Run("run with snapshot", func(t *testing.T) {
+		before := f.Must(ListAppSnapshots(userApp))
+		require.NoError(t, appPath.Join("python", "main.py").WriteFile([]byte("print('failed')\n")))
+
+		// The snapshot of a failed operation is dropped.
+		require.NoError(t, RunWithAppSnapshot(userApp, "failed", func() bool { return false }))
+		require.Equal(t, before, f.Must(ListAppSnapshots(userApp)))
+
+		require.NoError(t, RunWithAppSnapshot(userApp, "succeeded", func() bool { return true }))
+		after := f.Must(ListAppSnapshots(userApp))
+		require.Len(t, after, len(before)+1)
+		require.Equal(t, "succeeded", after[0].Reason)
+
+		// Nothing changed: the latest snapshot is kept even if the operation fails.
+		require.NoError(t, RunWithAppSnapshot(userApp, "failed again", func() bool { return false }))
+		require.Equal(t, after, f.Must(ListAppSnapshots(userApp)))
+	})
+
+	t.Run("retention", func(t *testing.T) {
+		for i := range MaxAppSnapshots + 5 {
+			require.NoError(t, appPath.Join("python", "main.py").WriteFile([]byte{byte(i)}))
+			_, err := SnapshotApp(userApp, "edit")
+			require.NoError(t, err)
+		}
+		snapshots, err := ListAppSnapshots(userApp)
+		require.NoError(t, err)
+		require.Len(t, snapshots, MaxAppSnapshots)
+		require.Len(t, f.Must(appSnapshotsDir(userApp).ReadDir()), MaxAppSnapshots)
+	})
+}
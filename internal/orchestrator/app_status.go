@@ -97,7 +97,7 @@ func parseDockerStatusEvent(ctx context.Context, cfg config.Configuration, docke
 		}
 
 		// FIXME: create an helper function to transform an app.ArduinoApp into an ortchestrator.AppInfo
-		app, err := app.Load(appStatus.AppPath.String())
+		app, err := app.Load(appStatus.AppPath.String(), cfg)
 		if err != nil {
 			slog.Warn("error loading app", "appPath", appStatus.AppPath.String(), "error", err)
 			return AppInfo{}, err
@@ -118,6 +118,7 @@ func parseDockerStatusEvent(ctx context.Context, cfg config.Configuration, docke
 			Status:      appStatus.Status,
 			Example:     id.IsExample(),
 			Default:     isDefault,
+			ReadOnly:    id.IsReadOnly(),
 		}, nil
 
 	}
@@ -0,0 +1,208 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+var ErrTemplateNotFound = errors.New("app template not found")
+
+type AppTemplateSource string
+
+const (
+	// AppTemplateSourceExample templates are the example apps, named `examples:<app>`.
+	AppTemplateSourceExample AppTemplateSource = "example"
+	// AppTemplateSourceBrick templates are the code examples of the bricks, named `<brick-id>/<example>`.
+	AppTemplateSourceBrick AppTemplateSource = "brick"
+	// AppTemplateSourceUser templates are the app folders in the user templates directory, named as the folder.
+	AppTemplateSourceUser AppTemplateSource = "user"
+)
+
+const examplesTemplatePrefix = "examples:"
+
+type AppTemplate struct {
+	Name   string            `json:"name"`
+	Source AppTemplateSource `json:"source"`
+	// Path is the app folder of example and user templates, and the python file of brick templates.
+	Path   *paths.Path `json:"-"`
+	Bricks []string    `json:"bricks,omitempty"`
+}
+
+// Template placeholders replaced in the text files of the app created from a template.
+const (
+	TemplateAppName        = "${APP_NAME}"
+	TemplateAppDescription = "${APP_DESCRIPTION}"
+	TemplateAppPorts       = "${APP_PORTS}"
+)
+
+// FindAppTemplate returns the app template with the given name.
+func FindAppTemplate(name string, staticStore *store.StaticStore, cfg config.Configuration) (AppTemplate, error) {
+	switch {
+	case strings.HasPrefix(name, examplesTemplatePrefix):
+		appPath := cfg.ExamplesDir().Join(strings.TrimPrefix(name, examplesTemplatePrefix))
+		if !isTemplateAppDir(appPath, cfg.ExamplesDir()) {
+			return AppTemplate{}, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+		}
+		return AppTemplate{Name: name, Source: AppTemplateSourceExample, Path: appPath}, nil
+
+	case strings.Contains(name, ":"):
+		brickID, example, _ := strings.Cut(name, "/")
+		examples, err := brickTemplates(brickID, staticStore)
+		if err != nil {
+			return AppTemplate{}, err
+		}
+		idx := slices.IndexFunc(examples, func(t AppTemplate) bool { return example == "" || t.Name == name })
+		if idx == -1 {
+			return AppTemplate{}, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+		}
+		return examples[idx], nil
+
+	default:
+		appPath := cfg.TemplatesDir().Join(name)
+		if !isTemplateAppDir(appPath, cfg.TemplatesDir()) {
+			return AppTemplate{}, fmt.Errorf("%w: %s", ErrTemplateNotFound, name)
+		}
+		return AppTemplate{Name: name, Source: AppTemplateSourceUser, Path: appPath}, nil
+	}
+}
+
+// ListAppTemplates returns the available app templates.
+func ListAppTemplates(brickIDs []string, staticStore *store.StaticStore, cfg config.Configuration) ([]AppTemplate, error) {
+	var res []AppTemplate
+	examples, err := findAppPaths(cfg.ExamplesDir())
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range examples {
+		rel, err := cfg.ExamplesDir().RelTo(p)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, AppTemplate{Name: examplesTemplatePrefix + rel.String(), Source: AppTemplateSourceExample, Path: p})
+	}
+	for _, id := range brickIDs {
+		templates, err := brickTemplates(id, staticStore)
+		if err != nil && !errors.Is(err, ErrTemplateNotFound) {
+			return nil, err
+		}
+		res = append(res, templates...)
+	}
+	if cfg.TemplatesDir().IsDir() {
+		dirs, err := cfg.TemplatesDir().ReadDir(paths.FilterDirectories())
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range dirs {
+			if isTemplateAppDir(d, cfg.TemplatesDir()) {
+				res = append(res, AppTemplate{Name: d.Base(), Source: AppTemplateSourceUser, Path: d})
+			}
+		}
+	}
+	return res, nil
+}
+
+func brickTemplates(brickID string, staticStore *store.StaticStore) ([]AppTemplate, error) {
+	examples, err := staticStore.GetBrickCodeExamplesPathFromID(brickID)
+	if err != nil {
+		return nil, err
+	}
+	examples.FilterSuffix(".py")
+	if len(examples) == 0 {
+		return nil, fmt.Errorf("%w: brick %s has no code examples", ErrTemplateNotFound, brickID)
+	}
+	examples.Sort()
+	res := make([]AppTemplate, len(examples))
+	for i, e := range examples {
+		res[i] = AppTemplate{
+			Name:   brickID + "/" + strings.TrimSuffix(e.Base(), e.Ext()),
+			Source: AppTemplateSourceBrick,
+			Path:   e,
+			Bricks: []string{brickID},
+		}
+	}
+	return res, nil
+}
+
+// isTemplateAppDir checks that the given folder is an app inside the templates root.
+func isTemplateAppDir(appPath, root *paths.Path) bool {
+	if inside, err := appPath.IsInsideDir(root); err != nil || !inside {
+		return false
+	}
+	return appPath.Join("app.yaml").Exist() || appPath.Join("app.yml").Exist()
+}
+
+// copyTemplateApp copies the template app folder to the given destination, replacing
+// the placeholders in the text files.
+func copyTemplateApp(template, dst *paths.Path, replacer *strings.Replacer) error {
+	files, err := template.ReadDirRecursiveFiltered(paths.FilterOutNames(".cache"), paths.FilterOutNames(".cache"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		rel, err := template.RelTo(file)
+		if err != nil {
+			return err
+		}
+		target := dst.Join(rel.String())
+		if file.IsDir() {
+			if err := target.MkdirAll(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := copyTemplateFile(file, target, replacer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyTemplateFile(src, dst *paths.Path, replacer *strings.Replacer) error {
+	content, err := src.ReadFile()
+	if err != nil {
+		return err
+	}
+	if utf8.Valid(content) && !slices.Contains(content, 0) {
+		content = []byte(replacer.Replace(string(content)))
+	}
+	if err := dst.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return dst.WriteFile(content)
+}
+
+func templateReplacer(name, description string, ports []int) *strings.Replacer {
+	portList := make([]string, len(ports))
+	for i, p := range ports {
+		portList[i] = strconv.Itoa(p)
+	}
+	return strings.NewReplacer(
+		TemplateAppName, name,
+		TemplateAppDescription, description,
+		TemplateAppPorts, strings.Join(portList, ", "),
+	)
+}
@@ -0,0 +1,117 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+func TestCreateAppFromTemplate(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	assetsDir := paths.New(t.TempDir())
+	require.NoError(t, assetsDir.Join("examples", "arduino", "web_ui").MkdirAll())
+	require.NoError(t, assetsDir.Join("examples", "arduino", "web_ui", "1_hello.py").WriteFile([]byte("print('hello')\n")))
+	staticStore := store.NewStaticStore(assetsDir.String())
+
+	writeTemplateApp := func(dir *paths.Path) {
+		require.NoError(t, dir.Join("python").MkdirAll())
+		require.NoError(t, dir.Join("python", "main.py").WriteFile([]byte("print('${APP_NAME} on ${APP_PORTS}')\n")))
+		require.NoError(t, dir.Join("app.yaml").WriteFile([]byte("name: Template\ndescription: ${APP_DESCRIPTION}\n")))
+		require.NoError(t, dir.Join(".cache").MkdirAll())
+		require.NoError(t, dir.Join(".cache", "app-compose.yaml").WriteFile([]byte("cache")))
+	}
+	writeTemplateApp(cfg.ExamplesDir().Join("blink"))
+	writeTemplateApp(cfg.TemplatesDir().Join("my-template"))
+
+	t.Run("example template", func(t *testing.T) {
+		template, err := FindAppTemplate("examples:blink", staticStore, cfg)
+		require.NoError(t, err)
+		require.Equal(t, AppTemplateSourceExample, template.Source)
+
+		resp, err := CreateApp(t.Context(), CreateAppRequest{
+			Name:        "From example",
+			Description: "A blinking app",
+			Ports:       []int{8080, 9090},
+			Template:    &template,
+		}, idProvider, &bricksindex.BricksIndex{}, cfg)
+		require.NoError(t, err)
+
+		appPath := resp.ID.ToPath()
+		require.Equal(t, "print('From example on 8080, 9090')\n", string(f.Must(appPath.Join("python", "main.py").ReadFile())))
+		require.NoFileExists(t, appPath.Join(".cache", "app-compose.yaml").String())
+		created := f.Must(app.Load(appPath.String(), cfg))
+		require.Equal(t, "From example", created.Name)
+		require.Equal(t, "A blinking app", created.Descriptor.Description)
+		require.Equal(t, []int{8080, 9090}, created.Descriptor.Ports)
+	})
+
+	t.Run("user template", func(t *testing.T) {
+		template, err := FindAppTemplate("my-template", staticStore, cfg)
+		require.NoError(t, err)
+		require.Equal(t, AppTemplateSourceUser, template.Source)
+
+		resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "From user", Template: &template, SkipSketch: true}, idProvider, &bricksindex.BricksIndex{}, cfg)
+		require.NoError(t, err)
+		require.NoDirExists(t, resp.ID.ToPath().Join("sketch").String())
+		require.FileExists(t, resp.ID.ToPath().Join("python", "main.py").String())
+	})
+
+	t.Run("brick template", func(t *testing.T) {
+		template, err := FindAppTemplate("arduino:web_ui", staticStore, cfg)
+		require.NoError(t, err)
+		require.Equal(t, "arduino:web_ui/1_hello", template.Name)
+
+		resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "From brick", Template: &template}, idProvider, &bricksindex.BricksIndex{}, cfg)
+		require.NoError(t, err)
+		appPath := resp.ID.ToPath()
+		require.Equal(t, "print('hello')\n", string(f.Must(appPath.Join("python", "main.py").ReadFile())))
+		created := f.Must(app.Load(appPath.String(), cfg))
+		require.Equal(t, []app.Brick{{ID: "arduino:web_ui"}}, created.Descriptor.Bricks)
+	})
+
+	t.Run("unknown bricks", func(t *testing.T) {
+		bricksIndex := &bricksindex.BricksIndex{Bricks: []bricksindex.Brick{{ID: "arduino:web_ui"}}}
+		_, err := CreateApp(t.Context(), CreateAppRequest{Name: "Unknown bricks", Bricks: []string{"arduino:web_ui", "arduino:missing"}}, idProvider, bricksIndex, cfg)
+		require.ErrorIs(t, err, app.ErrInvalidApp)
+		require.ErrorContains(t, err, `"arduino:missing"`)
+		require.NoDirExists(t, cfg.AppsDir().Join("unknown-bricks").String())
+	})
+
+	t.Run("list templates", func(t *testing.T) {
+		templates, err := ListAppTemplates([]string{"arduino:web_ui", "arduino:dbstorage_sqlstore"}, staticStore, cfg)
+		require.NoError(t, err)
+		require.Equal(t, []string{"examples:blink", "arduino:web_ui/1_hello", "my-template"}, f.Map(templates, func(t AppTemplate) string { return t.Name }))
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		_, err := FindAppTemplate("examples:missing", staticStore, cfg)
+		require.ErrorIs(t, err, ErrTemplateNotFound)
+		_, err = FindAppTemplate("arduino:missing", staticStore, cfg)
+		require.ErrorIs(t, err, ErrTemplateNotFound)
+		_, err = FindAppTemplate("../apps", staticStore, cfg)
+		require.ErrorIs(t, err, ErrTemplateNotFound)
+	})
+}
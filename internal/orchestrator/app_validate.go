@@ -0,0 +1,388 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/goccy/go-yaml/token"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/devices"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+type ValidationSeverity string
+
+const (
+	ValidationError   ValidationSeverity = "error"
+	ValidationWarning ValidationSeverity = "warning"
+)
+
+type ValidationFinding struct {
+	Severity ValidationSeverity `json:"severity"`
+	Message  string             `json:"message"`
+	// File is relative to the app folder.
+	File   string `json:"file"`
+	Line   int    `json:"line,omitempty"`
+	Column int    `json:"column,omitempty"`
+}
+
+type AppValidationResult struct {
+	Valid    bool                `json:"valid"`
+	Findings []ValidationFinding `json:"findings"`
+}
+
+var appDescriptorKeys = []string{"format_version", "name", "description", "ports", "bricks", "icon", "required_devices", "dev", "flash_mode"}
+
+// ValidateApp lints the app folder: the app.yaml descriptor is checked against the bricks
+// and models indexes, and each finding reports the position of the offending node.
+func ValidateApp(appPath *paths.Path, bricksIndex *bricksindex.BricksIndex, modelsIndex *modelsindex.ModelsIndex) (AppValidationResult, error) {
+	if !appPath.IsDir() {
+		return AppValidationResult{}, fmt.Errorf("app folder %s not found", appPath)
+	}
+	v := &appValidator{bricksIndex: bricksIndex, modelsIndex: modelsIndex}
+	v.validateMainFiles(appPath)
+	if err := v.validateDescriptor(appPath); err != nil {
+		return AppValidationResult{}, err
+	}
+
+	result := AppValidationResult{Valid: true, Findings: v.findings}
+	if result.Findings == nil {
+		result.Findings = []ValidationFinding{}
+	}
+	for _, finding := range result.Findings {
+		if finding.Severity == ValidationError {
+			result.Valid = false
+		}
+	}
+	return result, nil
+}
+
+type appValidator struct {
+	bricksIndex    *bricksindex.BricksIndex
+	modelsIndex    *modelsindex.ModelsIndex
+	descriptorFile string
+	findings       []ValidationFinding
+}
+
+func (v *appValidator) addf(severity ValidationSeverity, file string, tk *token.Token, format string, args ...any) {
+	finding := ValidationFinding{Severity: severity, Message: fmt.Sprintf(format, args...), File: file}
+	if tk != nil && tk.Position != nil {
+		finding.Line = tk.Position.Line
+		finding.Column = tk.Position.Column
+	}
+	v.findings = append(v.findings, finding)
+}
+
+func (v *appValidator) errorf(node ast.Node, format string, args ...any) {
+	v.addf(ValidationError, v.descriptorFile, nodeToken(node), format, args...)
+}
+
+func (v *appValidator) warnf(node ast.Node, format string, args ...any) {
+	v.addf(ValidationWarning, v.descriptorFile, nodeToken(node), format, args...)
+}
+
+func nodeToken(node ast.Node) *token.Token {
+	if node == nil {
+		return nil
+	}
+	return node.GetToken()
+}
+
+func (v *appValidator) validateMainFiles(appPath *paths.Path) {
+	hasPython := appPath.Join("python").IsDir()
+	hasSketch := appPath.Join("sketch").IsDir()
+	if hasPython && !appPath.Join("python", "main.py").Exist() {
+		v.addf(ValidationError, "python/main.py", nil, "python/main.py is missing")
+	}
+	if hasSketch && !appPath.Join("sketch", "sketch.ino").Exist() {
+		v.addf(ValidationError, "sketch/sketch.ino", nil, "sketch/sketch.ino is missing")
+	}
+	if !hasPython && !hasSketch {
+		v.addf(ValidationError, "", nil, "the app must contain python/main.py or sketch/sketch.ino")
+	}
+}
+
+func (v *appValidator) validateDescriptor(appPath *paths.Path) error {
+	descriptor := (&app.ArduinoApp{FullPath: appPath}).GetDescriptorPath()
+	v.descriptorFile = descriptor.Base()
+	if descriptor.NotExist() {
+		v.errorf(nil, "%s is missing", v.descriptorFile)
+		return nil
+	}
+	content, err := descriptor.ReadFile()
+	if err != nil {
+		return err
+	}
+
+	file, err := parser.ParseBytes(content, 0)
+	if err != nil {
+		var yamlErr yaml.Error
+		if errors.As(err, &yamlErr) {
+			v.addf(ValidationError, v.descriptorFile, yamlErr.GetToken(), "%s", yamlErr.GetMessage())
+			return nil
+		}
+		v.errorf(nil, "%s", err.Error())
+		return nil
+	}
+	if len(file.Docs) == 0 || file.Docs[0].Body == nil {
+		v.errorf(nil, "%s is empty", v.descriptorFile)
+		return nil
+	}
+	root := file.Docs[0].Body
+	values, ok := mappingValues(root)
+	if !ok {
+		v.errorf(root, "%s must be a mapping", v.descriptorFile)
+		return nil
+	}
+
+	var appPorts []*ast.MappingValueNode
+	var bricksNode ast.Node
+	hasName := false
+	for _, kv := range values {
+		key := kv.Key.GetToken().Value
+		switch key {
+		case "name":
+			hasName = true
+			if scalarValue(kv.Value) == "" {
+				v.errorf(kv.Key, "application name is empty")
+			}
+		case "icon":
+			if icon := scalarValue(kv.Value); icon != "" {
+				if err := (&app.AppDescriptor{Icon: icon}).IsValid(); err != nil {
+					v.errorf(kv.Value, "%s", err.Error())
+				}
+			}
+		case "ports":
+			appPorts = append(appPorts, kv)
+		case "bricks":
+			bricksNode = kv.Value
+		case "required_devices":
+			v.validateRequiredDevices(kv.Value)
+		case "format_version":
+			version, err := strconv.Atoi(scalarValue(kv.Value))
+			if err != nil || version < 0 {
+				v.errorf(kv.Value, "invalid format version %q", scalarValue(kv.Value))
+			} else if version > app.CurrentFormatVersion {
+				v.errorf(kv.Value, "%s", (&app.FormatTooNewError{Version: version}).Error())
+			}
+		case "flash_mode":
+			if err := (&app.AppDescriptor{FlashMode: scalarValue(kv.Value)}).IsValid(); err != nil {
+				v.errorf(kv.Value, "%s", err.Error())
+			}
+		default:
+			if !slices.Contains(appDescriptorKeys, key) {
+				v.warnf(kv.Key, "unknown key %q", key)
+			}
+		}
+	}
+	if !hasName {
+		v.errorf(root, "application name is missing")
+	}
+
+	ports := v.validateAppPorts(appPorts)
+	v.validateBricks(bricksNode, ports)
+	return nil
+}
+
+func (v *appValidator) validateRequiredDevices(node ast.Node) {
+	items, ok := sequenceValues(node)
+	if !ok {
+		v.errorf(node, "required_devices must be a list")
+		return
+	}
+	for _, item := range items {
+		class := scalarValue(item)
+		if !devices.IsKnownClass(class) {
+			v.errorf(item, "unknown device class %q, expected one of %s, %s, %s", class, devices.Camera, devices.Microphone, devices.Speaker)
+		}
+	}
+}
+
+// validateAppPorts returns the owner of each port exposed by the app.
+func (v *appValidator) validateAppPorts(portsNodes []*ast.MappingValueNode) map[string]string {
+	ports := map[string]string{}
+	for _, kv := range portsNodes {
+		items, ok := sequenceValues(kv.Value)
+		if !ok {
+			v.errorf(kv.Value, "ports must be a list")
+			continue
+		}
+		for _, item := range items {
+			port := scalarValue(item)
+			if n, err := strconv.Atoi(port); err != nil || n <= 0 || n > 65535 {
+				v.errorf(item, "invalid port %q", port)
+				continue
+			}
+			if _, exists := ports[port]; exists {
+				v.errorf(item, "port %s is listed more than once", port)
+				continue
+			}
+			ports[port] = "app"
+		}
+	}
+	return ports
+}
+
+func (v *appValidator) validateBricks(node ast.Node, ports map[string]string) {
+	if node == nil || node.Type() == ast.NullType {
+		return
+	}
+	items, ok := sequenceValues(node)
+	if !ok {
+		v.errorf(node, "bricks must be a list")
+		return
+	}
+	seen := map[string]bool{}
+	for _, item := range items {
+		var idNode ast.Node = item
+		var details ast.Node
+		switch n := item.(type) {
+		case *ast.StringNode:
+		case *ast.MappingNode, *ast.MappingValueNode:
+			values, _ := mappingValues(n)
+			if len(values) != 1 {
+				v.errorf(item, "expected a single brick id per item")
+				continue
+			}
+			idNode, details = values[0].Key, values[0].Value
+		default:
+			v.errorf(item, "expected a brick id or a brick id with its configuration")
+			continue
+		}
+
+		id := scalarValue(idNode)
+		if seen[id] {
+			v.errorf(idNode, "brick %q is listed more than once", id)
+			continue
+		}
+		seen[id] = true
+
+		brick, found := v.bricksIndex.FindBrickByID(id)
+		if !found {
+			v.errorf(idNode, "brick %q not found", id)
+			continue
+		}
+		v.validateBrickDetails(*brick, idNode, details)
+
+		for _, port := range brick.Ports {
+			if owner, exists := ports[port]; exists {
+				if owner == "app" {
+					v.errorf(idNode, "port %s exposed by brick %q is also listed in the app ports", port, id)
+				} else {
+					v.errorf(idNode, "port %s exposed by brick %q is also exposed by brick %q", port, id, owner)
+				}
+				continue
+			}
+			ports[port] = id
+		}
+	}
+}
+
+func (v *appValidator) validateBrickDetails(brick bricksindex.Brick, idNode ast.Node, details ast.Node) {
+	variables := map[string]ast.Node{}
+	if details != nil && details.Type() != ast.NullType {
+		values, ok := mappingValues(details)
+		if !ok {
+			v.errorf(details, "configuration of brick %q must be a mapping", brick.ID)
+			return
+		}
+		for _, kv := range values {
+			switch key := kv.Key.GetToken().Value; key {
+			case "model":
+				v.validateBrickModel(brick, kv.Value)
+			case "variables":
+				vars, ok := mappingValues(kv.Value)
+				if !ok && kv.Value.Type() != ast.NullType {
+					v.errorf(kv.Value, "variables of brick %q must be a mapping", brick.ID)
+				}
+				for _, variable := range vars {
+					name := variable.Key.GetToken().Value
+					if _, exist := brick.GetVariable(name); !exist {
+						v.warnf(variable.Key, "variable %q does not exist on brick %q", name, brick.ID)
+					}
+					variables[name] = variable.Value
+				}
+			default:
+				v.warnf(kv.Key, "unknown key %q in brick %q", key, brick.ID)
+			}
+		}
+	}
+
+	for _, variable := range brick.Variables {
+		if !variable.IsRequired() {
+			continue
+		}
+		value, set := variables[variable.Name]
+		if !set {
+			v.errorf(idNode, "required variable %q of brick %q has no value", variable.Name, brick.ID)
+		} else if scalarValue(value) == "" {
+			v.errorf(value, "required variable %q of brick %q has no value", variable.Name, brick.ID)
+		}
+	}
+}
+
+func (v *appValidator) validateBrickModel(brick bricksindex.Brick, node ast.Node) {
+	modelID := scalarValue(node)
+	if modelID == "" {
+		return
+	}
+	model, found := v.modelsIndex.GetModelByID(modelID)
+	if !found {
+		v.errorf(node, "model %q not found", modelID)
+		return
+	}
+	if err := modelsindex.CheckCompatibility(*model, brick); err != nil {
+		v.errorf(node, "%s", err.Error())
+	}
+}
+
+func mappingValues(node ast.Node) ([]*ast.MappingValueNode, bool) {
+	switch n := node.(type) {
+	case *ast.MappingNode:
+		return n.Values, true
+	case *ast.MappingValueNode:
+		return []*ast.MappingValueNode{n}, true
+	}
+	return nil, false
+}
+
+func sequenceValues(node ast.Node) ([]ast.Node, bool) {
+	if n, ok := node.(*ast.SequenceNode); ok {
+		return n.Values, true
+	}
+	return nil, false
+}
+
+func scalarValue(node ast.Node) string {
+	if node == nil || node.Type() == ast.NullType {
+		return ""
+	}
+	if _, ok := node.(ast.ScalarNode); !ok {
+		return ""
+	}
+	return strings.TrimSpace(node.GetToken().Value)
+}
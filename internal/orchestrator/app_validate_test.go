@@ -0,0 +1,190 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+func TestValidateApp(t *testing.T) {
+	bricksIndex := &bricksindex.BricksIndex{
+		Bricks: []bricksindex.Brick{
+			{ID: "arduino:object_detection", Ports: []string{"8080"}},
+			{ID: "arduino:web_ui", Ports: []string{"7000"}},
+			{ID: "arduino:dashboard", Ports: []string{"7000"}},
+			{ID: "arduino:cloud", Variables: []bricksindex.BrickVariable{
+				{Name: "DEVICE_ID"},
+				{Name: "SECRET"},
+				{Name: "REGION", DefaultValue: "eu"},
+			}},
+		},
+	}
+	modelsDir := paths.New(t.TempDir())
+	require.NoError(t, modelsDir.Join("models-list.yaml").WriteFile([]byte(`
+models:
+- face-detection:
+    runner: brick
+    name: Face detection
+    bricks:
+    - arduino:object_detection
+- keyword-spotting:
+    runner: brick
+    name: Keyword spotting
+    bricks:
+    - arduino:keyword_spotter
+`)))
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(modelsDir)
+	require.NoError(t, err)
+
+	newApp := func(t *testing.T, descriptor string) *paths.Path {
+		appDir := paths.New(t.TempDir())
+		require.NoError(t, appDir.Join("python").MkdirAll())
+		require.NoError(t, appDir.Join("python", "main.py").WriteFile([]byte("print('hello')")))
+		require.NoError(t, appDir.Join("app.yaml").WriteFile([]byte(descriptor)))
+		return appDir
+	}
+
+	t.Run("valid app", func(t *testing.T) {
+		appDir := newApp(t, `name: My app
+ports:
+  - 7860
+bricks:
+  - arduino:object_detection:
+      model: face-detection
+  - arduino:web_ui
+required_devices:
+  - camera
+`)
+		res, err := ValidateApp(appDir, bricksIndex, modelsIndex)
+		require.NoError(t, err)
+		require.True(t, res.Valid)
+		require.Empty(t, res.Findings)
+	})
+
+	t.Run("invalid app", func(t *testing.T) {
+		appDir := newApp(t, `name: My app
+author: me
+ports:
+  - 7000
+  - 7860
+  - 7860
+bricks:
+  - arduino:object_detection:
+      model: keyword-spotting
+      other: 1
+  - arduino:web_ui
+  - arduino:dashboard
+  - arduino:web_ui
+  - arduino:not_found
+  - arduino:cloud:
+      variables:
+        DEVICE_ID: ""
+        UNKNOWN: x
+required_devices:
+  - camera
+  - keyboard
+flash_mode: rom
+`)
+		require.NoError(t, appDir.Join("sketch").MkdirAll())
+
+		res, err := ValidateApp(appDir, bricksIndex, modelsIndex)
+		require.NoError(t, err)
+		require.False(t, res.Valid)
+		require.Equal(t, []ValidationFinding{
+			{Severity: ValidationError, Message: "sketch/sketch.ino is missing", File: "sketch/sketch.ino"},
+			{Severity: ValidationWarning, Message: `unknown key "author"`, File: "app.yaml", Line: 2, Column: 1},
+			{Severity: ValidationError, Message: "unknown device class \"keyboard\", expected one of camera, microphone, speaker", File: "app.yaml", Line: 21, Column: 5},
+			{Severity: ValidationError, Message: "flash_mode \"rom\" is not valid, expected ram or flash", File: "app.yaml", Line: 22, Column: 13},
+			{Severity: ValidationError, Message: "port 7860 is listed more than once", File: "app.yaml", Line: 6, Column: 5},
+			{Severity: ValidationError, Message: `model is not compatible with the brick: model "keyword-spotting" is not available for brick "arduino:object_detection"`, File: "app.yaml", Line: 9, Column: 14},
+			{Severity: ValidationWarning, Message: `unknown key "other" in brick "arduino:object_detection"`, File: "app.yaml", Line: 10, Column: 7},
+			{Severity: ValidationError, Message: `port 7000 exposed by brick "arduino:web_ui" is also listed in the app ports`, File: "app.yaml", Line: 11, Column: 5},
+			{Severity: ValidationError, Message: `port 7000 exposed by brick "arduino:dashboard" is also listed in the app ports`, File: "app.yaml", Line: 12, Column: 5},
+			{Severity: ValidationError, Message: `brick "arduino:web_ui" is listed more than once`, File: "app.yaml", Line: 13, Column: 5},
+			{Severity: ValidationError, Message: `brick "arduino:not_found" not found`, File: "app.yaml", Line: 14, Column: 5},
+			{Severity: ValidationWarning, Message: `variable "UNKNOWN" does not exist on brick "arduino:cloud"`, File: "app.yaml", Line: 18, Column: 9},
+			{Severity: ValidationError, Message: `required variable "DEVICE_ID" of brick "arduino:cloud" has no value`, File: "app.yaml", Line: 17, Column: 20},
+			{Severity: ValidationError, Message: `required variable "SECRET" of brick "arduino:cloud" has no value`, File: "app.yaml", Line: 15, Column: 5},
+		}, res.Findings)
+	})
+
+	t.Run("empty descriptor", func(t *testing.T) {
+		appDir := newApp(t, "")
+		res, err := ValidateApp(appDir, bricksIndex, modelsIndex)
+		require.NoError(t, err)
+		require.False(t, res.Valid)
+		require.Equal(t, []ValidationFinding{{Severity: ValidationError, Message: "app.yaml is empty", File: "app.yaml"}}, res.Findings)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		appDir := newApp(t, "name: My app\nbricks: [\n")
+		res, err := ValidateApp(appDir, bricksIndex, modelsIndex)
+		require.NoError(t, err)
+		require.False(t, res.Valid)
+		require.Len(t, res.Findings, 1)
+		require.Equal(t, 2, res.Findings[0].Line)
+	})
+
+	t.Run("missing main files", func(t *testing.T) {
+		appDir := paths.New(t.TempDir())
+		require.NoError(t, appDir.Join("app.yaml").WriteFile([]byte("name: My app\n")))
+		res, err := ValidateApp(appDir, bricksIndex, modelsIndex)
+		require.NoError(t, err)
+		require.False(t, res.Valid)
+		require.Equal(t, []ValidationFinding{{Severity: ValidationError, Message: "the app must contain python/main.py or sketch/sketch.ino"}}, res.Findings)
+	})
+	t.Run("format version", func(t *testing.T) {
+		appDir := newApp(t, "format_version: 99\nname: My app\n")
+		res, err := ValidateApp(appDir, bricksIndex, modelsIndex)
+		require.NoError(t, err)
+		require.False(t, res.Valid)
+		require.Len(t, res.Findings, 1)
+		require.Equal(t, 1, res.Findings[0].Line)
+	})
+}
+
+func TestValidateCreatedAndMigratedApps(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+	bricksIndex := &bricksindex.BricksIndex{}
+	modelsIndex := &modelsindex.ModelsIndex{}
+
+	resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "Created app"}, idProvider, &bricksindex.BricksIndex{}, cfg)
+	require.NoError(t, err)
+	res, err := ValidateApp(resp.ID.ToPath(), bricksIndex, modelsIndex)
+	require.NoError(t, err)
+	require.True(t, res.Valid)
+	require.Empty(t, res.Findings)
+
+	// A descriptor written before the format_version key existed.
+	appDir := paths.New(t.TempDir())
+	require.NoError(t, appDir.Join("python").MkdirAll())
+	require.NoError(t, appDir.Join("python", "main.py").WriteFile([]byte("print('hello')")))
+	require.NoError(t, appDir.Join("app.yaml").WriteFile([]byte("name: Old app\n")))
+	_, err = app.Migrate(appDir, cfg)
+	require.NoError(t, err)
+	res, err = ValidateApp(appDir, bricksIndex, modelsIndex)
+	require.NoError(t, err)
+	require.True(t, res.Valid)
+	require.Empty(t, res.Findings)
+}
@@ -0,0 +1,218 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"iter"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+// DefaultWatchDebounce is how long WatchApp waits for a burst of changes to settle
+// before restarting the main service.
+const DefaultWatchDebounce = 500 * time.Millisecond
+
+// WatchApp watches the python folder of a started app and restarts only its main
+// compose service when a file changes. Bursts of changes (e.g. an editor saving
+// several files) trigger a single restart. Brick containers and the sketch running
+// on the MCU are left untouched. The stream ends when ctx is cancelled.
+func WatchApp(
+	ctx context.Context,
+	modelsIndex *modelsindex.ModelsIndex,
+	bricksIndex *bricksindex.BricksIndex,
+	userApp app.ArduinoApp,
+	cfg config.Configuration,
+	debounce time.Duration,
+) iter.Seq[StreamMessage] {
+	return func(yield func(StreamMessage) bool) {
+		if userApp.MainPythonFile == nil {
+			yield(StreamMessage{error: fmt.Errorf("app %q has no python main to watch", userApp.Name)})
+			return
+		}
+		if !userApp.AppComposeFilePath().Exist() {
+			yield(StreamMessage{error: fmt.Errorf("app %q has not been started", userApp.Name)})
+			return
+		}
+
+		// The included brick compose files may interpolate the app variables, so the
+		// restart runs with the same environment used by StartApp.
+		modelPaths, err := fetchAppModels(ctx, userApp, modelsIndex, sharedModelCache(cfg))
+		if err != nil {
+			yield(StreamMessage{error: err})
+			return
+		}
+		envs := getAppEnvironmentVariables(userApp, bricksIndex, modelsIndex)
+		resolveModelPaths(envs, userApp, modelsIndex, modelPaths)
+
+		restart := func(ctx context.Context, out io.Writer) error {
+			commands := []string{"docker", "compose", "-f", userApp.AppComposeFilePath().String()}
+			if overrideComposeFile := userApp.AppComposeOverrideFilePath(); overrideComposeFile.Exist() {
+				commands = append(commands, "-f", overrideComposeFile.String())
+			}
+			commands = append(commands, "restart", "--no-deps", fmt.Sprintf("--timeout=%d", DefaultDockerStopTimeoutSeconds), "main")
+
+			slog.Debug("restarting main service", slog.String("command", strings.Join(commands, " ")))
+			process, err := paths.NewProcess(envs.AsList(), commands...)
+			if err != nil {
+				return err
+			}
+			process.RedirectStderrTo(out)
+			process.RedirectStdoutTo(out)
+			return process.RunWithinContext(ctx)
+		}
+
+		watchPythonDir(ctx, userApp.FullPath.Join("python"), debounce, restart)(yield)
+	}
+}
+
+// watchPythonDir yields a message for every debounced burst of changes below dir and
+// calls restart once per burst.
+func watchPythonDir(
+	ctx context.Context,
+	dir *paths.Path,
+	debounce time.Duration,
+	restart func(ctx context.Context, out io.Writer) error,
+) iter.Seq[StreamMessage] {
+	return func(yield func(StreamMessage) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			yield(StreamMessage{error: err})
+			return
+		}
+		defer watcher.Close()
+
+		if err := addWatchTree(watcher, dir.String()); err != nil {
+			yield(StreamMessage{error: fmt.Errorf("cannot watch %s: %w", dir, err)})
+			return
+		}
+		if !yield(StreamMessage{data: "Watching python/ for changes"}) {
+			return
+		}
+
+		timer := time.NewTimer(debounce)
+		timer.Stop()
+		defer timer.Stop()
+
+		var changed []string
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				yield(StreamMessage{error: err})
+				return
+			case ev, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if ev.Op == fsnotify.Chmod || !isWatchedPythonPath(ev.Name) {
+					continue
+				}
+				if ev.Has(fsnotify.Create) {
+					if paths.New(ev.Name).IsDir() {
+						if err := addWatchTree(watcher, ev.Name); err != nil {
+							slog.Debug("cannot watch new folder", slog.String("path", ev.Name), slog.String("error", err.Error()))
+						}
+					}
+				}
+				if rel, err := filepath.Rel(dir.String(), ev.Name); err == nil && !slices.Contains(changed, filepath.ToSlash(rel)) {
+					changed = append(changed, filepath.ToSlash(rel))
+				}
+				timer.Reset(debounce)
+			case <-timer.C:
+				if len(changed) == 0 {
+					continue
+				}
+				msg := fmt.Sprintf("Detected changes in %s, restarting the main service", strings.Join(changed, ", "))
+				changed = nil
+				if !yield(StreamMessage{data: msg}) {
+					return
+				}
+				out := NewCallbackWriter(func(line string) {
+					if !yield(StreamMessage{data: line}) {
+						cancel()
+					}
+				})
+				if err := restart(ctx, out); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					yield(StreamMessage{error: fmt.Errorf("cannot restart the main service: %w", err)})
+					return
+				}
+				if !yield(StreamMessage{data: "Main service restarted"}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// addWatchTree adds root and all its watched sub folders to the watcher.
+func addWatchTree(watcher *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root && !isWatchedPythonPath(path) {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
+
+// isWatchedPythonPath reports whether a change to path should trigger a restart.
+// Bytecode caches, hidden files and editor temporary files are ignored.
+func isWatchedPythonPath(path string) bool {
+	base := filepath.Base(path)
+	switch {
+	case base == "__pycache__",
+		strings.HasPrefix(base, "."),
+		strings.HasSuffix(base, "~"),
+		strings.HasSuffix(base, ".pyc"),
+		strings.HasSuffix(base, ".swp"):
+		return false
+	}
+	return !strings.Contains(filepath.ToSlash(path), "/__pycache__/")
+}
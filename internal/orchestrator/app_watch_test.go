@@ -0,0 +1,97 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchPythonDir(t *testing.T) {
+	dir := paths.New(t.TempDir())
+	require.NoError(t, dir.Join("main.py").WriteFile([]byte("print('hello')\n")))
+
+	const debounce = 100 * time.Millisecond
+	var restarts atomic.Int32
+	restart := func(ctx context.Context, out io.Writer) error {
+		restarts.Add(1)
+		_, err := fmt.Fprintln(out, "main restarted")
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	messages := make(chan StreamMessage)
+	go func() {
+		defer close(messages)
+		for msg := range watchPythonDir(ctx, dir, debounce, restart) {
+			messages <- msg
+		}
+	}()
+	next := func() string {
+		select {
+		case msg := <-messages:
+			require.NotEqual(t, ErrorType, msg.GetType(), "unexpected error: %v", msg.GetError())
+			return msg.GetData()
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout waiting for a message")
+			return ""
+		}
+	}
+	require.Equal(t, "Watching python/ for changes", next())
+
+	// A burst of changes triggers a single restart.
+	require.NoError(t, dir.Join("main.py").WriteFile([]byte("print('hello world')\n")))
+	require.NoError(t, dir.Join("utils.py").WriteFile([]byte("X = 1\n")))
+	require.NoError(t, dir.Join("main.py").WriteFile([]byte("print('hello again')\n")))
+	require.NoError(t, dir.Join("__pycache__").Mkdir())
+	require.NoError(t, dir.Join("__pycache__", "main.cpython-313.pyc").WriteFile([]byte{0}))
+
+	require.Equal(t, "Detected changes in main.py, utils.py, restarting the main service", next())
+	require.Equal(t, "main restarted", next())
+	require.Equal(t, "Main service restarted", next())
+	require.Equal(t, int32(1), restarts.Load())
+
+	// Ignored files do not trigger a restart.
+	require.NoError(t, dir.Join(".main.py.swp").WriteFile([]byte{0}))
+	require.NoError(t, dir.Join("main.py~").WriteFile([]byte{0}))
+	select {
+	case msg := <-messages:
+		require.FailNow(t, "unexpected message", "%v", msg.GetData())
+	case <-time.After(3 * debounce):
+	}
+	require.Equal(t, int32(1), restarts.Load())
+
+	cancel()
+	for range messages {
+	}
+}
+
+func TestIsWatchedPythonPath(t *testing.T) {
+	require.True(t, isWatchedPythonPath("/app/python/main.py"))
+	require.True(t, isWatchedPythonPath("/app/python/pkg/module.py"))
+	require.True(t, isWatchedPythonPath("/app/python/requirements.txt"))
+	require.False(t, isWatchedPythonPath("/app/python/__pycache__"))
+	require.False(t, isWatchedPythonPath("/app/python/__pycache__/main.cpython-313.pyc"))
+	require.False(t, isWatchedPythonPath("/app/python/.main.py.swp"))
+	require.False(t, isWatchedPythonPath("/app/python/main.py~"))
+}
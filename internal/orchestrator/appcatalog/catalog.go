@@ -0,0 +1,520 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package appcatalog keeps an in-memory catalogue of the apps in the app folders,
+// kept in sync with the filesystem through fsnotify.
+package appcatalog
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+type EventType string
+
+const (
+	EventAppCreated        EventType = "created"
+	EventAppDeleted        EventType = "deleted"
+	EventDescriptorChanged EventType = "descriptor-changed"
+	EventSketchChanged     EventType = "sketch-changed"
+)
+
+// Event notifies a change of an app in the catalogue.
+type Event struct {
+	Type EventType   `json:"type" required:"true"`
+	ID   app.ID      `json:"id" required:"true"`
+	Path *paths.Path `json:"-"`
+}
+
+// DefaultDebounce is the delay used to coalesce the changed events of an app, editors
+// usually produce a burst of filesystem events on save.
+const DefaultDebounce = 200 * time.Millisecond
+
+// subscriberBuffer is the number of events queued for a subscriber before dropping them.
+const subscriberBuffer = 64
+
+type entry struct {
+	id app.ID
+	// loaded is set when app or err hold the result of loading the app.
+	loaded bool
+	app    app.ArduinoApp
+	err    error
+}
+
+type Catalog struct {
+	idProvider *app.IDProvider
+	cfg        config.Configuration
+	roots      paths.PathList
+	watcher    *fsnotify.Watcher
+	debounce   time.Duration
+
+	mu      sync.Mutex
+	apps    map[string]*entry
+	dirs    map[string]bool
+	pending map[Event]*time.Timer
+
+	subsMu sync.Mutex
+	subs   map[chan Event]struct{}
+	closed bool
+
+	done chan struct{}
+}
+
+// New scans the apps folder, the examples folder and the app roots with the
+// watch option, and starts watching them for changes.
+func New(idProvider *app.IDProvider, cfg config.Configuration) (*Catalog, error) {
+	roots := paths.NewPathList(cfg.AppsDir().String(), cfg.ExamplesDir().String())
+	for _, root := range cfg.AppRoots() {
+		if root.Watch && root.Path.IsDir() {
+			roots.Add(root.Path)
+		}
+	}
+	return NewWithRoots(idProvider, cfg, roots, DefaultDebounce)
+}
+
+// NewWithRoots creates a catalogue of the apps in the given folders.
+func NewWithRoots(idProvider *app.IDProvider, cfg config.Configuration, roots paths.PathList, debounce time.Duration) (*Catalog, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	c := &Catalog{
+		idProvider: idProvider,
+		cfg:        cfg,
+		watcher:    watcher,
+		debounce:   debounce,
+		apps:       map[string]*entry{},
+		dirs:       map[string]bool{},
+		pending:    map[Event]*time.Timer{},
+		subs:       map[chan Event]struct{}{},
+		done:       make(chan struct{}),
+	}
+	for _, root := range roots {
+		root, err := root.Abs()
+		if err != nil {
+			_ = watcher.Close()
+			return nil, err
+		}
+		c.roots.Add(root)
+		c.mu.Lock()
+		err = c.scanDir(root, false)
+		c.mu.Unlock()
+		if err != nil {
+			_ = watcher.Close()
+			return nil, err
+		}
+	}
+	go c.run()
+	return c, nil
+}
+
+// Close stops watching the app folders and ends all the event subscriptions.
+func (c *Catalog) Close() error {
+	err := c.watcher.Close()
+	<-c.done
+
+	c.mu.Lock()
+	for _, t := range c.pending {
+		t.Stop()
+	}
+	c.mu.Unlock()
+
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+	c.closed = true
+	for ch := range c.subs {
+		close(ch)
+		delete(c.subs, ch)
+	}
+	return err
+}
+
+// Watches reports whether the apps of the given folder are tracked by the catalogue.
+func (c *Catalog) Watches(root *paths.Path) bool {
+	return slices.ContainsFunc(c.roots, root.EqualsTo)
+}
+
+// AppPaths returns the folders of the apps under the given root, sorted by path.
+func (c *Catalog) AppPaths(root *paths.Path) paths.PathList {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var res paths.PathList
+	for p := range c.apps {
+		if isInside(p, root.String()) {
+			res.Add(paths.New(p))
+		}
+	}
+	res.Sort()
+	return res
+}
+
+// Load returns the app in the given folder. The apps of the catalogue are
+// loaded once and reloaded only after they change.
+func (c *Catalog) Load(appPath *paths.Path) (app.ArduinoApp, error) {
+	c.mu.Lock()
+	e, ok := c.apps[appPath.String()]
+	if ok && e.loaded {
+		defer c.mu.Unlock()
+		return e.app, e.err
+	}
+	c.mu.Unlock()
+
+	loaded, err := app.Load(appPath.String(), c.cfg)
+	if ok {
+		c.mu.Lock()
+		// Cache the result only if the app has not been invalidated meanwhile.
+		if current, stillThere := c.apps[appPath.String()]; stillThere && current == e {
+			e.loaded, e.app, e.err = true, loaded, err
+		}
+		c.mu.Unlock()
+	}
+	return loaded, err
+}
+
+// Sync brings the catalogue up to date with the app folders without waiting for the
+// filesystem events, which are processed asynchronously: it must be called after changing
+// the apps, so that the next reads see the changes.
+func (c *Catalog) Sync() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for appPath := range c.apps {
+		if hasDescriptor(appPath) {
+			c.invalidate(appPath)
+		} else {
+			c.removeApp(appPath)
+		}
+	}
+	for _, root := range c.roots {
+		if err := c.scanDir(root, true); err != nil {
+			slog.Warn("unable to scan apps folder", slog.String("path", root.String()), slog.String("error", err.Error()))
+		}
+	}
+}
+
+// Events returns the events of the catalogue until the context is done.
+func (c *Catalog) Events(ctx context.Context) iter.Seq[Event] {
+	return func(yield func(Event) bool) {
+		ch := make(chan Event, subscriberBuffer)
+		c.subsMu.Lock()
+		if c.closed {
+			c.subsMu.Unlock()
+			return
+		}
+		c.subs[ch] = struct{}{}
+		c.subsMu.Unlock()
+		defer func() {
+			c.subsMu.Lock()
+			if _, ok := c.subs[ch]; ok {
+				delete(c.subs, ch)
+				close(ch)
+			}
+			c.subsMu.Unlock()
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-ch:
+				if !ok || !yield(ev) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *Catalog) run() {
+	defer close(c.done)
+	for {
+		select {
+		case ev, ok := <-c.watcher.Events:
+			if !ok {
+				return
+			}
+			c.mu.Lock()
+			c.handle(ev)
+			c.mu.Unlock()
+		case err, ok := <-c.watcher.Errors:
+			if !ok {
+				return
+			}
+			slog.Warn("app catalogue watcher error", slog.String("error", err.Error()))
+		}
+	}
+}
+
+// handle updates the catalogue after a filesystem event, c.mu must be held.
+func (c *Catalog) handle(ev fsnotify.Event) {
+	if ev.Op == fsnotify.Chmod {
+		return
+	}
+	name := filepath.Clean(ev.Name)
+	removed := ev.Has(fsnotify.Remove) || ev.Has(fsnotify.Rename)
+
+	appPath, rel := c.findApp(name)
+	if appPath == "" {
+		if isHidden(name) {
+			return
+		}
+		switch {
+		case removed:
+			c.removeTree(name)
+		case ev.Has(fsnotify.Create):
+			if isDir(name) && hasDescriptor(name) {
+				// An app moved or copied into a watched folder.
+				c.addApp(name, true)
+			} else if isDir(name) {
+				c.scanTree(name)
+			} else if isDescriptor(filepath.Base(name)) {
+				c.addApp(filepath.Dir(name), true)
+			}
+		}
+		return
+	}
+
+	switch {
+	case rel == "":
+		if removed {
+			c.removeTree(name)
+		}
+	case isDescriptor(rel):
+		if hasDescriptor(appPath) {
+			c.invalidate(appPath)
+			c.emitDebounced(EventDescriptorChanged, appPath)
+		} else {
+			// The app folder is not an app anymore, but it is still watched as a
+			// plain folder.
+			c.removeApp(appPath)
+		}
+	case rel == "sketch" || strings.HasPrefix(rel, "sketch"+string(filepath.Separator)):
+		if ev.Has(fsnotify.Create) && isDir(name) {
+			c.watchTree(name)
+		}
+		c.invalidate(appPath)
+		c.emitDebounced(EventSketchChanged, appPath)
+	case rel == "python" || strings.HasPrefix(rel, "python"+string(filepath.Separator)):
+		if rel == "python" && ev.Has(fsnotify.Create) && isDir(name) {
+			c.watch(name)
+		}
+		// The main python file may have been added or removed.
+		c.invalidate(appPath)
+	}
+}
+
+// scanTree scans a folder created under a watched folder, new apps are notified.
+func (c *Catalog) scanTree(dir string) {
+	if err := c.scanDir(paths.New(dir), true); err != nil {
+		slog.Warn("unable to scan apps folder", slog.String("path", dir), slog.String("error", err.Error()))
+	}
+}
+
+// scanDir watches the given folder and looks for apps in it and in its sub-folders.
+func (c *Catalog) scanDir(dir *paths.Path, notify bool) error {
+	if err := c.watch(dir.String()); err != nil {
+		return err
+	}
+	// Hidden folders, like .cache or the temporary folders of an import, never contain apps.
+	entries, err := dir.ReadDir(paths.FilterDirectories(), paths.FilterOutNames("python", "sketch"), paths.FilterOutPrefixes("."))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, sub := range entries {
+		if hasDescriptor(sub.String()) {
+			c.addApp(sub.String(), notify)
+			continue
+		}
+		if err := c.scanDir(sub, notify); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Catalog) addApp(appPath string, notify bool) {
+	if _, ok := c.apps[appPath]; ok {
+		return
+	}
+	id, err := c.idProvider.IDFromPath(paths.New(appPath))
+	if err != nil {
+		slog.Warn("unable to get app id", slog.String("path", appPath), slog.String("error", err.Error()))
+		return
+	}
+	c.apps[appPath] = &entry{id: id}
+	_ = c.watch(appPath)
+	c.watchTree(filepath.Join(appPath, "sketch"))
+	if isDir(filepath.Join(appPath, "python")) {
+		_ = c.watch(filepath.Join(appPath, "python"))
+	}
+	if notify {
+		c.broadcast(Event{Type: EventAppCreated, ID: id, Path: paths.New(appPath)})
+	}
+}
+
+func (c *Catalog) removeApp(appPath string) {
+	e, ok := c.apps[appPath]
+	if !ok {
+		return
+	}
+	delete(c.apps, appPath)
+	for key, t := range c.pending {
+		if key.Path.String() == appPath {
+			t.Stop()
+			delete(c.pending, key)
+		}
+	}
+	c.broadcast(Event{Type: EventAppDeleted, ID: e.id, Path: paths.New(appPath)})
+}
+
+// removeTree forgets the removed folder with all its apps and watched sub-folders.
+func (c *Catalog) removeTree(dir string) {
+	for appPath := range c.apps {
+		if isInside(appPath, dir) {
+			c.removeApp(appPath)
+		}
+	}
+	for d := range c.dirs {
+		if isInside(d, dir) {
+			delete(c.dirs, d)
+			// The watch of a removed folder is dropped automatically.
+			_ = c.watcher.Remove(d)
+		}
+	}
+}
+
+func (c *Catalog) invalidate(appPath string) {
+	if e, ok := c.apps[appPath]; ok {
+		c.apps[appPath] = &entry{id: e.id}
+	}
+}
+
+// findApp returns the folder of the app containing the given path and the
+// path relative to it.
+func (c *Catalog) findApp(name string) (string, string) {
+	var found string
+	for appPath := range c.apps {
+		if isInside(name, appPath) && len(appPath) > len(found) {
+			found = appPath
+		}
+	}
+	if found == "" {
+		return "", ""
+	}
+	rel, err := filepath.Rel(found, name)
+	if err != nil || rel == "." {
+		return found, ""
+	}
+	return found, rel
+}
+
+func (c *Catalog) watch(dir string) error {
+	if c.dirs[dir] {
+		return nil
+	}
+	if err := c.watcher.Add(dir); err != nil {
+		return err
+	}
+	c.dirs[dir] = true
+	return nil
+}
+
+// watchTree watches the given folder and all its sub-folders.
+func (c *Catalog) watchTree(dir string) {
+	_ = filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			_ = c.watch(p)
+		}
+		return nil
+	})
+}
+
+func (c *Catalog) emitDebounced(eventType EventType, appPath string) {
+	e, ok := c.apps[appPath]
+	if !ok {
+		return
+	}
+	ev := Event{Type: eventType, ID: e.id, Path: paths.New(appPath)}
+	for key, t := range c.pending {
+		if key.Type == eventType && key.Path.String() == appPath {
+			t.Reset(c.debounce)
+			return
+		}
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(c.debounce, func() {
+		c.mu.Lock()
+		if c.pending[ev] != timer {
+			c.mu.Unlock()
+			return
+		}
+		delete(c.pending, ev)
+		c.mu.Unlock()
+		c.broadcast(ev)
+	})
+	c.pending[ev] = timer
+}
+
+func (c *Catalog) broadcast(ev Event) {
+	c.subsMu.Lock()
+	defer c.subsMu.Unlock()
+	for ch := range c.subs {
+		select {
+		case ch <- ev:
+		default:
+			slog.Warn("app catalogue subscriber too slow, event dropped", slog.String("type", string(ev.Type)), slog.String("path", ev.Path.String()))
+		}
+	}
+}
+
+func isHidden(p string) bool {
+	return strings.HasPrefix(filepath.Base(p), ".")
+}
+
+func isInside(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, dir+string(filepath.Separator))
+}
+
+func isDescriptor(name string) bool {
+	return name == "app.yaml" || name == "app.yml"
+}
+
+func hasDescriptor(dir string) bool {
+	return paths.New(dir, "app.yaml").Exist() || paths.New(dir, "app.yml").Exist()
+}
+
+func isDir(p string) bool {
+	info, err := os.Stat(p)
+	return err == nil && info.IsDir()
+}
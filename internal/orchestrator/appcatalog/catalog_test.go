@@ -0,0 +1,166 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package appcatalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+func writeApp(t *testing.T, dir *paths.Path, name string) {
+	t.Helper()
+	require.NoError(t, dir.Join("python").MkdirAll())
+	require.NoError(t, dir.Join("python", "main.py").WriteFile(nil))
+	require.NoError(t, dir.Join("app.yaml").WriteFile([]byte("name: "+name+"\n")))
+}
+
+func TestCatalog(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	t.Setenv("ARDUINO_APP_CLI__APPS_DIR", tmp.Join("apps").String())
+	t.Setenv("ARDUINO_APP_CLI__DATA_DIR", tmp.Join("data").String())
+	cfg, err := config.NewFromEnv()
+	require.NoError(t, err)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	writeApp(t, cfg.AppsDir().Join("app1"), "app1")
+	writeApp(t, cfg.ExamplesDir().Join("group", "example1"), "example1")
+	require.NoError(t, cfg.AppsDir().Join("app1", "sketch").MkdirAll())
+
+	catalog, err := NewWithRoots(idProvider, cfg, paths.NewPathList(cfg.AppsDir().String(), cfg.ExamplesDir().String()), 10*time.Millisecond)
+	require.NoError(t, err)
+	defer catalog.Close()
+
+	require.True(t, catalog.Watches(cfg.AppsDir()))
+	require.False(t, catalog.Watches(tmp))
+	require.Equal(t, paths.PathList{cfg.AppsDir().Join("app1")}, catalog.AppPaths(cfg.AppsDir()))
+	require.Equal(t, paths.PathList{cfg.ExamplesDir().Join("group", "example1")}, catalog.AppPaths(cfg.ExamplesDir()))
+
+	events := make(chan Event, 10)
+	go func() {
+		for ev := range catalog.Events(t.Context()) {
+			events <- ev
+		}
+	}()
+	// Give the subscriber the time to register.
+	time.Sleep(50 * time.Millisecond)
+	next := func() Event {
+		t.Helper()
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "event not received")
+			return Event{}
+		}
+	}
+
+	loaded, err := catalog.Load(cfg.AppsDir().Join("app1"))
+	require.NoError(t, err)
+	require.Equal(t, "app1", loaded.Name)
+
+	t.Run("descriptor changed", func(t *testing.T) {
+		require.NoError(t, cfg.AppsDir().Join("app1", "app.yaml").WriteFile([]byte("name: renamed\n")))
+		ev := next()
+		require.Equal(t, EventDescriptorChanged, ev.Type)
+		require.Equal(t, f.Must(idProvider.ParseID("user:app1")), ev.ID)
+
+		loaded, err := catalog.Load(cfg.AppsDir().Join("app1"))
+		require.NoError(t, err)
+		require.Equal(t, "renamed", loaded.Name)
+	})
+
+	t.Run("sketch changed", func(t *testing.T) {
+		require.NoError(t, cfg.AppsDir().Join("app1", "sketch", "sketch.ino").WriteFile([]byte("void setup() {}\n")))
+		require.NoError(t, cfg.AppsDir().Join("app1", "sketch", "sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+		ev := next()
+		require.Equal(t, EventSketchChanged, ev.Type)
+		require.Equal(t, cfg.AppsDir().Join("app1"), ev.Path)
+	})
+
+	t.Run("app created", func(t *testing.T) {
+		// Apps are created in a temporary folder and moved in place.
+		staging := tmp.Join("staging")
+		writeApp(t, staging, "app2")
+		require.NoError(t, staging.Rename(cfg.AppsDir().Join("app2")))
+		ev := next()
+		require.Equal(t, EventAppCreated, ev.Type)
+		require.Equal(t, f.Must(idProvider.ParseID("user:app2")), ev.ID)
+		require.Equal(t, paths.PathList{cfg.AppsDir().Join("app1"), cfg.AppsDir().Join("app2")}, catalog.AppPaths(cfg.AppsDir()))
+	})
+
+	t.Run("app created in a new folder", func(t *testing.T) {
+		require.NoError(t, cfg.AppsDir().Join("nested").MkdirAll())
+		time.Sleep(50 * time.Millisecond)
+		writeApp(t, cfg.AppsDir().Join("nested", "app3"), "app3")
+		ev := next()
+		require.Equal(t, EventAppCreated, ev.Type)
+		require.Equal(t, cfg.AppsDir().Join("nested", "app3"), ev.Path)
+	})
+
+	t.Run("app deleted", func(t *testing.T) {
+		id := f.Must(idProvider.ParseID("user:app2"))
+		require.NoError(t, cfg.AppsDir().Join("app2").RemoveAll())
+		ev := next()
+		require.Equal(t, EventAppDeleted, ev.Type)
+		require.Equal(t, id, ev.ID)
+
+		require.NoError(t, cfg.AppsDir().Join("nested").RemoveAll())
+		ev = next()
+		require.Equal(t, EventAppDeleted, ev.Type)
+		require.Equal(t, paths.PathList{cfg.AppsDir().Join("app1")}, catalog.AppPaths(cfg.AppsDir()))
+	})
+}
+
+func TestCatalogSync(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	t.Setenv("ARDUINO_APP_CLI__APPS_DIR", tmp.Join("apps").String())
+	t.Setenv("ARDUINO_APP_CLI__DATA_DIR", tmp.Join("data").String())
+	cfg, err := config.NewFromEnv()
+	require.NoError(t, err)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	writeApp(t, cfg.AppsDir().Join("app1"), "app1")
+	// The temporary folders, e.g. of an app being imported, are not apps.
+	writeApp(t, cfg.AppsDir().Join(".import-1", "app"), "importing")
+
+	catalog, err := NewWithRoots(idProvider, cfg, paths.NewPathList(cfg.AppsDir().String()), 10*time.Millisecond)
+	require.NoError(t, err)
+	defer catalog.Close()
+	require.Equal(t, paths.PathList{cfg.AppsDir().Join("app1")}, catalog.AppPaths(cfg.AppsDir()))
+	_, err = catalog.Load(cfg.AppsDir().Join("app1"))
+	require.NoError(t, err)
+
+	// The changes are visible right after Sync, without waiting for the filesystem events.
+	writeApp(t, cfg.AppsDir().Join(".import-2", "app"), "importing")
+	writeApp(t, cfg.AppsDir().Join("app2"), "app2")
+	require.NoError(t, cfg.AppsDir().Join("app1", "app.yaml").WriteFile([]byte("name: renamed\n")))
+	catalog.Sync()
+	require.Equal(t, paths.PathList{cfg.AppsDir().Join("app1"), cfg.AppsDir().Join("app2")}, catalog.AppPaths(cfg.AppsDir()))
+	loaded, err := catalog.Load(cfg.AppsDir().Join("app1"))
+	require.NoError(t, err)
+	require.Equal(t, "renamed", loaded.Name)
+
+	require.NoError(t, cfg.AppsDir().Join("app2").RemoveAll())
+	catalog.Sync()
+	require.Equal(t, paths.PathList{cfg.AppsDir().Join("app1")}, catalog.AppPaths(cfg.AppsDir()))
+}
@@ -20,13 +20,16 @@ import (
 	"fmt"
 	"log/slog"
 	"slices"
+	"strings"
 
 	"github.com/arduino/go-paths-helper"
 	"go.bug.st/f"
 
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/devices"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 	"github.com/arduino/arduino-app-cli/internal/store"
 )
@@ -72,6 +75,20 @@ func (s *Service) List() (BrickListResult, error) {
 	return res, nil
 }
 
+// ListWithCompatibility returns the same result of List, reporting for each brick
+// whether the available devices satisfy its required devices.
+func (s *Service) ListWithCompatibility(available devices.Available) (BrickListResult, error) {
+	res, err := s.List()
+	if err != nil {
+		return BrickListResult{}, err
+	}
+	for i, brick := range s.bricksIndex.Bricks {
+		compatibility := available.Check(brick.RequiredDevices)
+		res.Bricks[i].Compatibility = &compatibility
+	}
+	return res, nil
+}
+
 func (s *Service) AppBrickInstancesList(a *app.ArduinoApp) (AppBrickInstancesResult, error) {
 	res := AppBrickInstancesResult{BrickInstances: make([]BrickInstance, len(a.Descriptor.Bricks))}
 	for i, brickInstance := range a.Descriptor.Bricks {
@@ -153,7 +170,9 @@ func getBrickConfigDetails(
 	return variablesMap, variableDetails
 }
 
-func (s *Service) BricksDetails(id string, idProvider *app.IDProvider,
+// BricksDetails returns the details of the brick. The apps using it are looked up
+// in appCatalog, if not nil.
+func (s *Service) BricksDetails(id string, idProvider *app.IDProvider, appCatalog *appcatalog.Catalog,
 	cfg config.Configuration) (BrickDetailsResult, error) {
 	brick, found := s.bricksIndex.FindBrickByID(id)
 	if !found {
@@ -189,7 +208,7 @@ func (s *Service) BricksDetails(id string, idProvider *app.IDProvider,
 		}
 	})
 
-	usedByApps, err := getUsedByApps(cfg, brick.ID, idProvider)
+	usedByApps, err := getUsedByApps(cfg, brick.ID, idProvider, appCatalog)
 	if err != nil {
 		return BrickDetailsResult{}, fmt.Errorf("unable to get used by apps: %w", err)
 	}
@@ -210,7 +229,7 @@ func (s *Service) BricksDetails(id string, idProvider *app.IDProvider,
 }
 
 func getUsedByApps(
-	cfg config.Configuration, brickId string, idProvider *app.IDProvider) ([]AppReference, error) {
+	cfg config.Configuration, brickId string, idProvider *app.IDProvider, appCatalog *appcatalog.Catalog) ([]AppReference, error) {
 	var (
 		pathsToExplore paths.PathList
 		appPaths       paths.PathList
@@ -220,15 +239,19 @@ func getUsedByApps(
 	usedByApps := []AppReference{}
 
 	for _, p := range pathsToExplore {
+		if appCatalog != nil && appCatalog.Watches(p) {
+			appPaths.AddAllMissing(appCatalog.AppPaths(p))
+			continue
+		}
 		res, err := p.ReadDirRecursiveFiltered(func(file *paths.Path) bool {
-			if file.Base() == ".cache" {
+			if strings.HasPrefix(file.Base(), ".") {
 				return false
 			}
 			if file.Join("app.yaml").NotExist() && file.Join("app.yml").NotExist() {
 				return true
 			}
 			return false
-		}, paths.FilterDirectories(), paths.FilterOutNames("python", "sketch", ".cache"))
+		}, paths.FilterDirectories(), paths.FilterOutNames("python", "sketch"), paths.FilterOutPrefixes("."))
 		if err != nil {
 			slog.Error("unable to list apps", slog.String("error", err.Error()))
 			return usedByApps, err
@@ -236,8 +259,12 @@ func getUsedByApps(
 		appPaths.AddAllMissing(res)
 	}
 
+	load := func(p *paths.Path) (app.ArduinoApp, error) { return app.Load(p.String(), cfg) }
+	if appCatalog != nil {
+		load = appCatalog.Load
+	}
 	for _, file := range appPaths {
-		app, err := app.Load(file.String())
+		app, err := load(file)
 		if err != nil {
 			// we are not considering the broken apps
 			slog.Warn("unable to parse app.yaml, skipping", "path", file.String(), "error", err.Error())
@@ -309,12 +336,10 @@ func (s *Service) BrickCreate(
 	brickInstance.ID = req.ID
 
 	if req.Model != nil {
-		models := s.modelsIndex.GetModelsByBrick(brickInstance.ID)
-		idx := slices.IndexFunc(models, func(m modelsindex.AIModel) bool { return m.ID == *req.Model })
-		if idx == -1 {
-			return fmt.Errorf("model %s does not exsist", *req.Model)
+		if err := s.checkModel(*req.Model, *brick); err != nil {
+			return err
 		}
-		brickInstance.Model = models[idx].ID
+		brickInstance.Model = *req.Model
 	}
 	brickInstance.Variables = req.Variables
 
@@ -346,18 +371,16 @@ func (s *Service) BrickUpdate(
 	}
 	brickModel := appCurrent.Descriptor.Bricks[index].Model
 
-	if req.Model != nil && *req.Model != brickModel {
-		models := s.modelsIndex.GetModelsByBrick(req.ID)
-		idx := slices.IndexFunc(models, func(m modelsindex.AIModel) bool { return m.ID == *req.Model })
-		if idx == -1 {
-			return fmt.Errorf("model %s does not exsist", *req.Model)
-		}
-		brickModel = *req.Model
-	}
 	brick, present := s.bricksIndex.FindBrickByID(brickID)
 	if !present {
 		return fmt.Errorf("brick not found with id %s", brickID)
 	}
+	if req.Model != nil && *req.Model != brickModel {
+		if err := s.checkModel(*req.Model, *brick); err != nil {
+			return err
+		}
+		brickModel = *req.Model
+	}
 	for name, updateValue := range req.Variables {
 		value, exist := brick.GetVariable(name)
 		if !exist {
@@ -408,3 +431,12 @@ func (s *Service) BrickDelete(
 	}
 	return nil
 }
+
+// checkModel ensures the model exists and that model and brick accept each other.
+func (s *Service) checkModel(modelID string, brick bricksindex.Brick) error {
+	model, found := s.modelsIndex.GetModelByID(modelID)
+	if !found {
+		return fmt.Errorf("model %s does not exsist", modelID)
+	}
+	return modelsindex.CheckCompatibility(*model, brick)
+}
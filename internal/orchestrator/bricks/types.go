@@ -15,6 +15,8 @@
 
 package bricks
 
+import "github.com/arduino/arduino-app-cli/internal/orchestrator/devices"
+
 type BrickListResult struct {
 	Bricks []BrickListItem `json:"bricks"`
 }
@@ -27,6 +29,8 @@ type BrickListItem struct {
 	Category    string   `json:"category"`
 	Status      string   `json:"status"`
 	Models      []string `json:"models"`
+
+	Compatibility *devices.Compatibility `json:"compatibility,omitempty"`
 }
 
 type AppBrickInstancesResult struct {
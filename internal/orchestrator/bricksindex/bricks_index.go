@@ -49,18 +49,28 @@ func (v BrickVariable) IsRequired() bool {
 }
 
 type Brick struct {
-	ID                        string          `yaml:"id"`
-	Name                      string          `yaml:"name"`
-	Description               string          `yaml:"description"`
-	Category                  string          `yaml:"category,omitempty"`
-	RequiresDisplay           string          `yaml:"requires_display,omitempty"`
-	RequireContainer          bool            `yaml:"require_container"`
-	RequireModel              bool            `yaml:"require_model"`
-	Variables                 []BrickVariable `yaml:"variables,omitempty"`
-	Ports                     []string        `yaml:"ports,omitempty"`
-	ModelName                 string          `yaml:"model_name,omitempty"`
-	MountDevicesIntoContainer bool            `yaml:"mount_devices_into_container,omitempty"`
-	RequiredDevices           []string        `yaml:"required_devices,omitempty"`
+	ID                        string            `yaml:"id" required:"true"`
+	Name                      string            `yaml:"name"`
+	Description               string            `yaml:"description"`
+	Category                  string            `yaml:"category,omitempty"`
+	RequiresDisplay           string            `yaml:"requires_display,omitempty"`
+	RequireContainer          bool              `yaml:"require_container"`
+	RequireModel              bool              `yaml:"require_model"`
+	Variables                 []BrickVariable   `yaml:"variables,omitempty"`
+	Ports                     []string          `yaml:"ports,omitempty"`
+	ModelName                 string            `yaml:"model_name,omitempty"`
+	MountDevicesIntoContainer bool              `yaml:"mount_devices_into_container,omitempty"`
+	RequiredDevices           []string          `yaml:"required_devices,omitempty"`
+	ModelRequirements         ModelRequirements `yaml:"model_requirements,omitempty"`
+}
+
+// ModelRequirements describes which models a brick accepts. Empty fields accept any value.
+type ModelRequirements struct {
+	Runners         []string `yaml:"runners,omitempty"`
+	Images          []string `yaml:"images,omitempty"`
+	InputModalities []string `yaml:"input_modalities,omitempty"`
+	// Labels that the model must be able to predict.
+	Labels []string `yaml:"labels,omitempty"`
 }
 
 func (b Brick) GetVariable(name string) (BrickVariable, bool) {
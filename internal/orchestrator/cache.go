@@ -7,6 +7,7 @@ import (
 	"github.com/docker/cli/cli/command"
 
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 )
 
 type CleanAppCacheRequest struct {
@@ -22,8 +23,9 @@ func CleanAppCache(
 	docker command.Cli,
 	app app.ArduinoApp,
 	req CleanAppCacheRequest,
+	cfg config.Configuration,
 ) error {
-	runningApp, err := getRunningApp(ctx, docker.Client())
+	runningApp, err := getRunningApp(ctx, docker.Client(), cfg)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,71 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"slices"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/devices"
+)
+
+type AppCompatibilityResult struct {
+	devices.Compatibility
+	Bricks []BrickCompatibility `json:"bricks"`
+}
+
+type BrickCompatibility struct {
+	ID string `json:"id"`
+	devices.Compatibility
+}
+
+// GetAvailableDevices returns the device classes currently attached to the board.
+func GetAvailableDevices() (devices.Available, error) {
+	res, err := getDevices()
+	if err != nil {
+		return devices.Available{}, err
+	}
+	return res.available(), nil
+}
+
+// AppCompatibility checks whether the currently attached devices satisfy the
+// required devices of the app and of each of its bricks.
+func AppCompatibility(userApp app.ArduinoApp, bricksIndex *bricksindex.BricksIndex) (AppCompatibilityResult, error) {
+	available, err := GetAvailableDevices()
+	if err != nil {
+		return AppCompatibilityResult{}, err
+	}
+	return appCompatibility(userApp, bricksIndex, available), nil
+}
+
+func appCompatibility(userApp app.ArduinoApp, bricksIndex *bricksindex.BricksIndex, available devices.Available) AppCompatibilityResult {
+	required := slices.Clone(userApp.Descriptor.RequiredDevices)
+	result := AppCompatibilityResult{Bricks: []BrickCompatibility{}}
+	for _, b := range userApp.Descriptor.Bricks {
+		brick, found := bricksIndex.FindBrickByID(b.ID)
+		if !found {
+			continue
+		}
+		required = append(required, brick.RequiredDevices...)
+		result.Bricks = append(result.Bricks, BrickCompatibility{
+			ID:            b.ID,
+			Compatibility: available.Check(brick.RequiredDevices),
+		})
+	}
+	result.Compatibility = available.Check(required)
+	return result
+}
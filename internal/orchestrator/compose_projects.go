@@ -0,0 +1,64 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+const composeProjectsFileName = "compose-projects.json"
+
+var composeProjectsMutex sync.Mutex
+
+// recordAppComposeProject remembers the app folder of a compose project, so that the
+// resources left by the project, like its volumes, can be traced back to the app.
+func recordAppComposeProject(cfg config.Configuration, project string, appPath *paths.Path) error {
+	composeProjectsMutex.Lock()
+	defer composeProjectsMutex.Unlock()
+
+	projects := readAppComposeProjects(cfg)
+	if projects[project] == appPath.String() {
+		return nil
+	}
+	projects[project] = appPath.String()
+	content, err := json.Marshal(projects)
+	if err != nil {
+		return err
+	}
+	return fatomic.WriteFile(cfg.DataDir().Join(composeProjectsFileName).String(), content, os.FileMode(0644))
+}
+
+// readAppComposeProjects returns the app folders of the recorded compose projects, by project name.
+func readAppComposeProjects(cfg config.Configuration) map[string]string {
+	projects := map[string]string{}
+	content, err := cfg.DataDir().Join(composeProjectsFileName).ReadFile()
+	if err != nil {
+		return projects
+	}
+	if err := json.Unmarshal(content, &projects); err != nil {
+		slog.Warn("invalid compose projects record", slog.String("error", err.Error()))
+		return map[string]string{}
+	}
+	return projects
+}
@@ -21,6 +21,8 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -35,11 +37,15 @@ type Configuration struct {
 	dataDir            *paths.Path
 	routerSocketPath   *paths.Path
 	customEIModelsDir  *paths.Path
+	appRoots           []AppRoot
+	registryMirrors    []RegistryMirror
 	PythonImage        string
 	UsedPythonImageTag string
 	RunnerVersion      string
 	AllowRoot          bool
 	LibrariesAPIURL    *url.URL
+	// ModelsDownloadURL is the base URL used to resolve relative model download URLs.
+	ModelsDownloadURL *url.URL
 }
 
 func NewFromEnv() (Configuration, error) {
@@ -89,7 +95,13 @@ func NewFromEnv() (Configuration, error) {
 		}
 	}
 
+	registryMirrors, err := parseRegistryMirrors(os.Getenv("ARDUINO_APP_CLI__REGISTRY_MIRRORS"))
+	if err != nil {
+		return Configuration{}, fmt.Errorf("invalid ARDUINO_APP_CLI__REGISTRY_MIRRORS: %w", err)
+	}
+
 	pythonImage, usedPythonImageTag := getPythonImageAndTag()
+	pythonImage = rewriteImage(registryMirrors, pythonImage)
 	slog.Debug("Using pythonImage", slog.String("image", pythonImage))
 
 	allowRoot, err := strconv.ParseBool(os.Getenv("ARDUINO_APP_CLI__ALLOW_ROOT"))
@@ -106,16 +118,32 @@ func NewFromEnv() (Configuration, error) {
 		return Configuration{}, fmt.Errorf("invalid LIBRARIES_API_URL: %w", err)
 	}
 
+	appRoots, err := parseAppRoots(os.Getenv("ARDUINO_APP_CLI__APP_ROOTS"), appsDir, dataDir.Join("examples"))
+	if err != nil {
+		return Configuration{}, fmt.Errorf("invalid ARDUINO_APP_CLI__APP_ROOTS: %w", err)
+	}
+
+	var modelsDownloadURL *url.URL
+	if u := os.Getenv("MODELS_DOWNLOAD_URL"); u != "" {
+		modelsDownloadURL, err = url.Parse(u)
+		if err != nil {
+			return Configuration{}, fmt.Errorf("invalid MODELS_DOWNLOAD_URL: %w", err)
+		}
+	}
+
 	c := Configuration{
 		appsDir:            appsDir,
 		dataDir:            dataDir,
 		routerSocketPath:   routerSocket,
 		customEIModelsDir:  customEIModelsDir,
+		appRoots:           appRoots,
+		registryMirrors:    registryMirrors,
 		PythonImage:        pythonImage,
 		UsedPythonImageTag: usedPythonImageTag,
 		RunnerVersion:      runnerVersion,
 		AllowRoot:          allowRoot,
 		LibrariesAPIURL:    parsedLibrariesURL,
+		ModelsDownloadURL:  modelsDownloadURL,
 	}
 	if err := c.init(); err != nil {
 		return Configuration{}, err
@@ -140,6 +168,37 @@ func (c *Configuration) AppsDir() *paths.Path {
 	return c.appsDir
 }
 
+// AppRoots returns the additional folders containing apps, besides AppsDir and ExamplesDir.
+func (c *Configuration) AppRoots() []AppRoot {
+	return c.appRoots
+}
+
+// RegistryMirrors returns the rules redirecting the images to local registry mirrors.
+func (c *Configuration) RegistryMirrors() []RegistryMirror {
+	return c.registryMirrors
+}
+
+// RewriteImage returns the image to use in place of the given one, according to the
+// registry mirrors. The PythonImage is already rewritten.
+func (c *Configuration) RewriteImage(image string) string {
+	return rewriteImage(c.registryMirrors, image)
+}
+
+// OriginalImages returns the images that RewriteImage redirects to the given one.
+func (c *Configuration) OriginalImages(image string) []string {
+	var originals []string
+	for _, m := range c.registryMirrors {
+		rest, found := strings.CutPrefix(image, m.Mirror)
+		if !found {
+			continue
+		}
+		if original := m.Prefix + rest; rewriteImage(c.registryMirrors, original) == image {
+			originals = append(originals, original)
+		}
+	}
+	return originals
+}
+
 func (c *Configuration) DataDir() *paths.Path {
 	return c.dataDir
 }
@@ -148,6 +207,19 @@ func (c *Configuration) ExamplesDir() *paths.Path {
 	return c.dataDir.Join("examples")
 }
 
+// TemplatesDir is the folder holding the user app templates, one per sub-folder.
+func (c *Configuration) TemplatesDir() *paths.Path {
+	return c.dataDir.Join("templates")
+}
+
+func (c *Configuration) ModelsCacheDir() *paths.Path {
+	return c.dataDir.Join("models")
+}
+
+func (c *Configuration) CustomEIModelsDir() *paths.Path {
+	return c.customEIModelsDir
+}
+
 func (c *Configuration) RouterSocketPath() *paths.Path {
 	return c.routerSocketPath
 }
@@ -156,6 +228,128 @@ func (c *Configuration) AssetsDir() *paths.Path {
 	return c.dataDir.Join("assets")
 }
 
+// AppRoot is an additional folder containing apps, for example a mounted USB
+// stick or a shared team folder.
+type AppRoot struct {
+	// Prefix identifies the root in the app IDs, as `<prefix>:<app>`.
+	Prefix string
+	Path   *paths.Path
+	// ReadOnly roots are never modified: their apps can be run, cloned and exported, and keep
+	// their provisioning state in the data folder.
+	ReadOnly bool
+	// Watch enables watching the root for added, removed and changed apps.
+	Watch bool
+}
+
+var appRootPrefixRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// reservedAppRootPrefixes are the ID prefixes of the standard locations.
+var reservedAppRootPrefixes = []string{"user", "examples"}
+
+// parseAppRoots parses a list of app roots separated by `;`, each one in the
+// form `<prefix>=<path>[,ro][,watch]`. A root cannot overlap another root or one
+// of the standard locations, otherwise the apps inside both would have two ids.
+func parseAppRoots(value string, appsDir, examplesDir *paths.Path) ([]AppRoot, error) {
+	var roots []AppRoot
+	for entry := range strings.SplitSeq(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		prefix, spec, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("app root %q must be in the form <prefix>=<path>", entry)
+		}
+		if !appRootPrefixRegexp.MatchString(prefix) {
+			return nil, fmt.Errorf("invalid app root prefix %q", prefix)
+		}
+		if slices.Contains(reservedAppRootPrefixes, prefix) || slices.ContainsFunc(roots, func(r AppRoot) bool { return r.Prefix == prefix }) {
+			return nil, fmt.Errorf("duplicated app root prefix %q", prefix)
+		}
+
+		fields := strings.Split(spec, ",")
+		root := AppRoot{Prefix: prefix, Path: paths.New(fields[0])}
+		if root.Path == nil || !root.Path.IsAbs() {
+			return nil, fmt.Errorf("path of app root %q must be absolute", prefix)
+		}
+		for _, dir := range []*paths.Path{appsDir, examplesDir} {
+			if overlaps(root.Path, dir) {
+				return nil, fmt.Errorf("app root %q overlaps %s", prefix, dir)
+			}
+		}
+		for _, other := range roots {
+			if overlaps(root.Path, other.Path) {
+				return nil, fmt.Errorf("app root %q overlaps app root %q", prefix, other.Prefix)
+			}
+		}
+		for _, option := range fields[1:] {
+			switch strings.TrimSpace(option) {
+			case "ro":
+				root.ReadOnly = true
+			case "watch":
+				root.Watch = true
+			default:
+				return nil, fmt.Errorf("unknown option %q for app root %q", option, prefix)
+			}
+		}
+		roots = append(roots, root)
+	}
+	return roots, nil
+}
+
+// overlaps reports whether a and b are the same folder or one is inside the other.
+func overlaps(a, b *paths.Path) bool {
+	if a.Clean().EqualsTo(b.Clean()) {
+		return true
+	}
+	aInB, _ := a.IsInsideDir(b)
+	bInA, _ := b.IsInsideDir(a)
+	return aInB || bInA
+}
+
+// RegistryMirror redirects the images starting with Prefix to a local registry mirror,
+// replacing the prefix with Mirror.
+type RegistryMirror struct {
+	Prefix string
+	Mirror string
+}
+
+// parseRegistryMirrors parses a list of rewrite rules separated by `;`, each one in the
+// form `<prefix>=<mirror>`.
+func parseRegistryMirrors(value string) ([]RegistryMirror, error) {
+	var mirrors []RegistryMirror
+	for entry := range strings.SplitSeq(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		prefix, mirror, found := strings.Cut(entry, "=")
+		prefix, mirror = strings.TrimSpace(prefix), strings.TrimSpace(mirror)
+		if !found || prefix == "" || mirror == "" {
+			return nil, fmt.Errorf("registry mirror %q must be in the form <prefix>=<mirror>", entry)
+		}
+		if slices.ContainsFunc(mirrors, func(m RegistryMirror) bool { return m.Prefix == prefix }) {
+			return nil, fmt.Errorf("duplicated registry mirror prefix %q", prefix)
+		}
+		mirrors = append(mirrors, RegistryMirror{Prefix: prefix, Mirror: mirror})
+	}
+	return mirrors, nil
+}
+
+// rewriteImage applies the rule with the longest prefix matching the image.
+func rewriteImage(mirrors []RegistryMirror, image string) string {
+	var match *RegistryMirror
+	for i, m := range mirrors {
+		if strings.HasPrefix(image, m.Prefix) && (match == nil || len(m.Prefix) > len(match.Prefix)) {
+			match = &mirrors[i]
+		}
+	}
+	if match == nil {
+		return image
+	}
+	return match.Mirror + strings.TrimPrefix(image, match.Prefix)
+}
+
 func getPythonImageAndTag() (string, string) {
 	registryBase := os.Getenv("DOCKER_REGISTRY_BASE")
 	if registryBase == "" {
@@ -0,0 +1,83 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package devices
+
+import (
+	"slices"
+)
+
+// Device classes that bricks and apps can list in their `required_devices`.
+const (
+	Camera     = "camera"
+	Microphone = "microphone"
+	Speaker    = "speaker"
+)
+
+// Available describes which device classes are currently attached to the board.
+type Available struct {
+	Camera     bool
+	Microphone bool
+	Speaker    bool
+}
+
+type MissingDevice struct {
+	Class string `json:"class"`
+	Hint  string `json:"hint"`
+}
+
+type Compatibility struct {
+	Compatible     bool            `json:"compatible"`
+	MissingDevices []MissingDevice `json:"missing_devices"`
+}
+
+// IsKnownClass reports whether the given device class is handled by the orchestrator.
+func IsKnownClass(class string) bool {
+	return slices.Contains([]string{Camera, Microphone, Speaker}, class)
+}
+
+// Check returns the compatibility of the required device classes against the available devices.
+// Unknown device classes are ignored, as they are at app start.
+func (a Available) Check(required []string) Compatibility {
+	res := Compatibility{Compatible: true, MissingDevices: []MissingDevice{}}
+	for _, class := range required {
+		if slices.ContainsFunc(res.MissingDevices, func(m MissingDevice) bool { return m.Class == class }) {
+			continue
+		}
+		if missing, ok := a.missing(class); ok {
+			res.Compatible = false
+			res.MissingDevices = append(res.MissingDevices, missing)
+		}
+	}
+	return res
+}
+
+func (a Available) missing(class string) (MissingDevice, bool) {
+	switch class {
+	case Camera:
+		if !a.Camera {
+			return MissingDevice{Class: class, Hint: "no camera found, connect a USB camera to the board"}, true
+		}
+	case Microphone:
+		if !a.Microphone {
+			return MissingDevice{Class: class, Hint: "no microphone device found, connect a USB microphone or a USB audio adapter to the board"}, true
+		}
+	case Speaker:
+		if !a.Speaker {
+			return MissingDevice{Class: class, Hint: "no speaker device found, connect USB speakers or a USB audio adapter to the board"}, true
+		}
+	}
+	return MissingDevice{}, false
+}
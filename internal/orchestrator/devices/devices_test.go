@@ -0,0 +1,52 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package devices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	t.Run("no required devices", func(t *testing.T) {
+		res := Available{}.Check(nil)
+		require.True(t, res.Compatible)
+		require.Empty(t, res.MissingDevices)
+	})
+
+	t.Run("all devices available", func(t *testing.T) {
+		res := Available{Camera: true, Microphone: true, Speaker: true}.Check([]string{Camera, Microphone, Speaker})
+		require.True(t, res.Compatible)
+		require.Empty(t, res.MissingDevices)
+	})
+
+	t.Run("missing devices are reported once with a hint", func(t *testing.T) {
+		res := Available{Camera: true}.Check([]string{Camera, Microphone, Speaker, Microphone})
+		require.False(t, res.Compatible)
+		require.Len(t, res.MissingDevices, 2)
+		require.Equal(t, Microphone, res.MissingDevices[0].Class)
+		require.NotEmpty(t, res.MissingDevices[0].Hint)
+		require.Equal(t, Speaker, res.MissingDevices[1].Class)
+		require.NotEmpty(t, res.MissingDevices[1].Hint)
+	})
+
+	t.Run("unknown device classes are ignored", func(t *testing.T) {
+		res := Available{}.Check([]string{"gpu"})
+		require.True(t, res.Compatible)
+		require.False(t, IsKnownClass("gpu"))
+	})
+}
@@ -182,6 +182,7 @@ func getAppStatus(
 func getRunningApp(
 	ctx context.Context,
 	docker dockerClient.APIClient,
+	cfg config.Configuration,
 ) (*app.ArduinoApp, error) {
 	apps, err := getAppsStatus(ctx, docker)
 	if err != nil {
@@ -193,7 +194,7 @@ func getRunningApp(
 	if idx == -1 {
 		return nil, nil
 	}
-	app, err := app.Load(apps[idx].AppPath.String())
+	app, err := app.Load(apps[idx].AppPath.String(), cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load running app: %w", err)
 	}
@@ -0,0 +1,131 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/helpers"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelcache"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+// modelCaches holds a single Cache per folder, so that its lock serializes the downloads
+// and the prunes of the whole process.
+var modelCaches sync.Map
+
+func sharedModelCache(cfg config.Configuration) *modelcache.Cache {
+	cache, _ := modelCaches.LoadOrStore(cfg.ModelsCacheDir().String(), modelcache.New(cfg.ModelsCacheDir(), cfg.ModelsDownloadURL))
+	return cache.(*modelcache.Cache)
+}
+
+// fetchAppModels downloads the models used by the app that are not shipped inside the
+// runner images, records their usage, and returns the cached path of each model by id.
+func fetchAppModels(ctx context.Context, userApp app.ArduinoApp, modelsIndex *modelsindex.ModelsIndex, cache *modelcache.Cache) (map[string]*paths.Path, error) {
+	modelPaths := map[string]*paths.Path{}
+	usage := map[string]string{}
+	for _, brick := range userApp.Descriptor.Bricks {
+		model, found := modelsIndex.GetModelByID(brick.Model)
+		if !found || model.Download == nil {
+			continue
+		}
+		modelPath, err := cache.Fetch(ctx, *model)
+		if err != nil {
+			return nil, err
+		}
+		modelPaths[model.ID] = modelPath
+		usage[model.ID] = modelPath.Base()
+	}
+	if err := cache.SetAppUsage(userApp.FullPath, usage); err != nil {
+		slog.Warn("unable to record models usage", slog.String("app", userApp.Name), slog.String("error", err.Error()))
+	}
+	return modelPaths, nil
+}
+
+// cachedAppModels returns the cached path of each model used by the app, by id, together
+// with the ids of the models that still have to be downloaded.
+func cachedAppModels(userApp app.ArduinoApp, modelsIndex *modelsindex.ModelsIndex, cache *modelcache.Cache) (map[string]*paths.Path, []string) {
+	modelPaths := map[string]*paths.Path{}
+	var missing []string
+	for _, brick := range userApp.Descriptor.Bricks {
+		model, found := modelsIndex.GetModelByID(brick.Model)
+		if !found || model.Download == nil {
+			continue
+		}
+		if modelPath, err := cache.Path(model.Download.SHA256); err == nil && modelPath.Exist() {
+			modelPaths[model.ID] = modelPath
+		} else if !slices.Contains(missing, model.ID) {
+			missing = append(missing, model.ID)
+		}
+	}
+	return modelPaths, missing
+}
+
+// resolveModelPaths replaces the model path placeholder, left in the environment by the
+// model configuration, with the path of the cached model.
+func resolveModelPaths(envs helpers.EnvVars, userApp app.ArduinoApp, modelsIndex *modelsindex.ModelsIndex, modelPaths map[string]*paths.Path) {
+	for _, brick := range userApp.Descriptor.Bricks {
+		modelPath, ok := modelPaths[brick.Model]
+		if !ok {
+			continue
+		}
+		model, _ := modelsIndex.GetModelByID(brick.Model)
+		for key := range model.ModelConfiguration {
+			envs[key] = strings.ReplaceAll(envs[key], modelcache.ModelPathPlaceholder, modelPath.String())
+		}
+	}
+}
+
+// usesModelCache reports whether some environment variable points into the models cache.
+func usesModelCache(envs helpers.EnvVars, cfg config.Configuration) bool {
+	cacheDir := cfg.ModelsCacheDir().String()
+	for _, v := range envs {
+		if strings.HasPrefix(v, cacheDir) {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneModelCache removes the cached models that are no longer used by any app.
+func pruneModelCache(cfg config.Configuration) (modelcache.PruneResult, error) {
+	return sharedModelCache(cfg).Prune(isModelUsedByApp(cfg))
+}
+
+// unusedModelCacheFiles returns the files that pruneModelCache would remove.
+func unusedModelCacheFiles(cfg config.Configuration) (paths.PathList, error) {
+	return sharedModelCache(cfg).Unused(isModelUsedByApp(cfg))
+}
+
+// isModelUsedByApp returns a function reporting whether the app in the given folder uses the model.
+func isModelUsedByApp(cfg config.Configuration) func(appPath *paths.Path, modelID string) bool {
+	return func(appPath *paths.Path, modelID string) bool {
+		userApp, err := app.Load(appPath.String(), cfg)
+		if err != nil {
+			// Keep the models of apps that still exist but cannot be loaded right now.
+			return appPath.Join("app.yaml").Exist() || appPath.Join("app.yml").Exist()
+		}
+		return slices.ContainsFunc(userApp.Descriptor.Bricks, func(b app.Brick) bool { return b.Model == modelID })
+	}
+}
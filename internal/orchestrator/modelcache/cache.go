@@ -0,0 +1,396 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package modelcache stores the downloadable AI models in a content-addressed cache
+// and keeps track of the apps using them, so that unused models can be pruned.
+package modelcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+// ModelPathPlaceholder is replaced, in the model configuration, with the path of the cached model file.
+const ModelPathPlaceholder = "${MODEL_PATH}"
+
+// partialDownloadMaxAge is how long a partial download is kept, after its last write, to be
+// resumed. Younger partial downloads may belong to a download in progress in another process.
+const partialDownloadMaxAge = 24 * time.Hour
+
+var (
+	ErrNotDownloadable  = errors.New("model is not downloadable")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	ErrInvalidDigest    = errors.New("invalid sha256 digest")
+)
+
+var digestRegexp = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+// Cache is a content-addressed store of downloaded models: each model is saved
+// under `sha256/<digest>` and partial downloads are kept under `tmp` to be resumed.
+type Cache struct {
+	dir     *paths.Path
+	baseURL *url.URL
+	client  *http.Client
+	mu      sync.Mutex
+	// downloads are the downloads in progress, by digest. They are guarded by mu.
+	downloads map[string]*download
+}
+
+// download serializes the fetches of the same model, while the other models are
+// downloaded in parallel.
+type download struct {
+	mu      sync.Mutex
+	waiters int
+}
+
+type usageFile struct {
+	// Apps maps the path of an app to the digests of the models it uses, keyed by model id.
+	Apps map[string]map[string]string `json:"apps"`
+}
+
+type PruneResult struct {
+	ModelsRemoved int
+	SpaceFreed    int64 // in bytes
+}
+
+func New(dir *paths.Path, baseURL *url.URL) *Cache {
+	return &Cache{dir: dir, baseURL: baseURL, client: http.DefaultClient, downloads: map[string]*download{}}
+}
+
+func (c *Cache) Dir() *paths.Path {
+	return c.dir
+}
+
+// Path returns the path where a model with the given digest is stored.
+func (c *Cache) Path(digest string) (*paths.Path, error) {
+	if !digestRegexp.MatchString(digest) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDigest, digest)
+	}
+	return c.dir.Join("sha256", strings.ToLower(digest)), nil
+}
+
+// Fetch returns the path of the cached model, downloading it if it is not in the cache yet.
+// Interrupted downloads are resumed from where they stopped.
+func (c *Cache) Fetch(ctx context.Context, model modelsindex.AIModel) (*paths.Path, error) {
+	if model.Download == nil || model.Download.SHA256 == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNotDownloadable, model.ID)
+	}
+	dst, err := c.Path(model.Download.SHA256)
+	if err != nil {
+		return nil, fmt.Errorf("model %s: %w", model.ID, err)
+	}
+	digest := strings.ToLower(model.Download.SHA256)
+	release := c.lockDownload(digest)
+	defer release()
+
+	if dst.Exist() {
+		return dst, nil
+	}
+	u, err := c.resolveURL(model.Download.URL)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.download(ctx, u, digest, dst); err != nil {
+		return nil, fmt.Errorf("downloading model %s: %w", model.ID, err)
+	}
+	return dst, nil
+}
+
+// lockDownload waits for the other fetches of the same digest and marks it as in progress,
+// so that Prune leaves its files alone. The returned function releases it.
+func (c *Cache) lockDownload(digest string) func() {
+	c.mu.Lock()
+	d := c.downloads[digest]
+	if d == nil {
+		d = &download{}
+		c.downloads[digest] = d
+	}
+	d.waiters++
+	c.mu.Unlock()
+
+	d.mu.Lock()
+	return func() {
+		d.mu.Unlock()
+		c.mu.Lock()
+		if d.waiters--; d.waiters == 0 {
+			delete(c.downloads, digest)
+		}
+		c.mu.Unlock()
+	}
+}
+
+func (c *Cache) resolveURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	if u.IsAbs() {
+		return u.String(), nil
+	}
+	if c.baseURL == nil {
+		return "", fmt.Errorf("cannot resolve relative model URL %q: no models download URL configured", raw)
+	}
+	base := *c.baseURL
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+	return base.ResolveReference(u).String(), nil
+}
+
+func (c *Cache) download(ctx context.Context, u string, digest string, dst *paths.Path) error {
+	tmpDir := c.dir.Join("tmp")
+	if err := tmpDir.MkdirAll(); err != nil {
+		return err
+	}
+	partial := tmpDir.Join(digest + ".partial")
+
+	var offset int64
+	if info, err := partial.Stat(); err == nil {
+		offset = info.Size()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusOK:
+		flags |= os.O_TRUNC
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The partial file is already complete.
+		flags |= os.O_APPEND
+		resp.Body = http.NoBody
+	default:
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	out, err := os.OpenFile(partial.String(), flags, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	sum, err := fileSHA256(partial)
+	if err != nil {
+		return err
+	}
+	if sum != digest {
+		_ = partial.Remove()
+		return fmt.Errorf("%w: expected %s, got %s", ErrChecksumMismatch, digest, sum)
+	}
+	if err := dst.Parent().MkdirAll(); err != nil {
+		return err
+	}
+	return partial.Rename(dst)
+}
+
+// SetAppUsage records the models, keyed by id with their digest, used by the app.
+func (c *Cache) SetAppUsage(appPath *paths.Path, models map[string]string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	usage, err := c.readUsage()
+	if err != nil {
+		return err
+	}
+	if len(models) == 0 {
+		delete(usage.Apps, appPath.String())
+	} else {
+		usage.Apps[appPath.String()] = models
+	}
+	return c.writeUsage(usage)
+}
+
+// AppsUsage returns the recorded models used by each app.
+func (c *Cache) AppsUsage() (map[string]map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	usage, err := c.readUsage()
+	if err != nil {
+		return nil, err
+	}
+	return usage.Apps, nil
+}
+
+// Prune removes the cached models not used by any app, and the partial downloads not
+// written for partialDownloadMaxAge. Apps that no longer exist are forgotten, and only the
+// models still referenced by the app (according to isUsed) are kept. The files of the
+// downloads in progress in the same Cache are never pruned.
+func (c *Cache) Prune(isUsed func(appPath *paths.Path, modelID string) bool) (PruneResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result PruneResult
+	usage, err := c.prunedUsage(isUsed)
+	if err != nil {
+		return result, err
+	}
+	if err := c.writeUsage(usage); err != nil {
+		return result, err
+	}
+
+	for _, file := range c.unusedFiles(usage) {
+		info, err := file.Stat()
+		if err != nil {
+			continue
+		}
+		if err := file.RemoveAll(); err != nil {
+			return result, err
+		}
+		result.SpaceFreed += info.Size()
+		if file.Parent().Base() == "sha256" {
+			result.ModelsRemoved++
+		}
+	}
+	return result, nil
+}
+
+// Unused returns the files that Prune would remove, without removing them.
+func (c *Cache) Unused(isUsed func(appPath *paths.Path, modelID string) bool) (paths.PathList, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	usage, err := c.prunedUsage(isUsed)
+	if err != nil {
+		return nil, err
+	}
+	return c.unusedFiles(usage), nil
+}
+
+// prunedUsage returns the usage without the models no longer used by the apps.
+func (c *Cache) prunedUsage(isUsed func(appPath *paths.Path, modelID string) bool) (usageFile, error) {
+	usage, err := c.readUsage()
+	if err != nil {
+		return usage, err
+	}
+	for appPath, models := range usage.Apps {
+		maps.DeleteFunc(models, func(modelID, _ string) bool {
+			return !isUsed(paths.New(appPath), modelID)
+		})
+		if len(models) == 0 {
+			delete(usage.Apps, appPath)
+		}
+	}
+	return usage, nil
+}
+
+// unusedFiles returns the cached models not in the usage, and the stale partial downloads.
+func (c *Cache) unusedFiles(usage usageFile) paths.PathList {
+	var used []string
+	for _, models := range usage.Apps {
+		used = slices.AppendSeq(used, maps.Values(models))
+	}
+	used = f.Uniq(used)
+
+	var candidates paths.PathList
+	if blobs, err := c.dir.Join("sha256").ReadDir(); err == nil {
+		candidates = slices.DeleteFunc(blobs, func(p *paths.Path) bool {
+			_, downloading := c.downloads[p.Base()]
+			return downloading || slices.Contains(used, p.Base())
+		})
+	}
+	if partials, err := c.dir.Join("tmp").ReadDir(); err == nil {
+		for _, partial := range partials {
+			if _, downloading := c.downloads[strings.TrimSuffix(partial.Base(), ".partial")]; downloading {
+				continue
+			}
+			if info, err := partial.Stat(); err == nil && time.Since(info.ModTime()) > partialDownloadMaxAge {
+				candidates = append(candidates, partial)
+			}
+		}
+	}
+	return candidates
+}
+
+func (c *Cache) readUsage() (usageFile, error) {
+	usage := usageFile{Apps: map[string]map[string]string{}}
+	content, err := c.dir.Join("usage.json").ReadFile()
+	if errors.Is(err, os.ErrNotExist) {
+		return usage, nil
+	}
+	if err != nil {
+		return usage, err
+	}
+	if err := json.Unmarshal(content, &usage); err != nil {
+		return usage, fmt.Errorf("invalid models usage file: %w", err)
+	}
+	if usage.Apps == nil {
+		usage.Apps = map[string]map[string]string{}
+	}
+	return usage, nil
+}
+
+func (c *Cache) writeUsage(usage usageFile) error {
+	if err := c.dir.MkdirAll(); err != nil {
+		return err
+	}
+	content, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+	return fatomic.WriteFile(c.dir.Join("usage.json").String(), content, 0644)
+}
+
+func fileSHA256(file *paths.Path) (string, error) {
+	in, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, in); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,220 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package modelcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+)
+
+var zeros = strings.Repeat("0", 64)
+
+func mustPath(t *testing.T, cache *Cache, digest string) *paths.Path {
+	t.Helper()
+	p, err := cache.Path(digest)
+	require.NoError(t, err)
+	return p
+}
+
+func TestFetch(t *testing.T) {
+	content := []byte("this is a fake edge impulse model")
+	sum := sha256.Sum256(content)
+	digest := hex.EncodeToString(sum[:])
+
+	serverDir := paths.New(t.TempDir())
+	require.NoError(t, serverDir.Join("models").MkdirAll())
+	require.NoError(t, serverDir.Join("models", "model.eim").WriteFile(content))
+
+	// A local file server recording the Range header of each request.
+	var mu sync.Mutex
+	var ranges []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		ranges = append(ranges, r.Header.Get("Range"))
+		mu.Unlock()
+		http.FileServer(http.Dir(serverDir.String())).ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	baseURL, err := url.Parse(server.URL + "/models")
+	require.NoError(t, err)
+
+	model := modelsindex.AIModel{
+		ID:       "downloadable",
+		Download: &modelsindex.ModelDownload{URL: "model.eim", SHA256: digest},
+	}
+
+	t.Run("it downloads a model into the cache", func(t *testing.T) {
+		cache := New(paths.New(t.TempDir()), nil)
+		_, err := cache.Fetch(t.Context(), model)
+		require.ErrorContains(t, err, "no models download URL configured")
+
+		absolute := model
+		absolute.Download = &modelsindex.ModelDownload{URL: server.URL + "/models/model.eim", SHA256: digest}
+		modelPath, err := cache.Fetch(t.Context(), absolute)
+		require.NoError(t, err)
+		assert.Equal(t, mustPath(t, cache, digest), modelPath)
+		got, err := modelPath.ReadFile()
+		require.NoError(t, err)
+		assert.Equal(t, content, got)
+	})
+
+	t.Run("it resumes a partial download", func(t *testing.T) {
+		cache := New(paths.New(t.TempDir()), baseURL)
+		require.NoError(t, cache.Dir().Join("tmp").MkdirAll())
+		require.NoError(t, cache.Dir().Join("tmp", digest+".partial").WriteFile(content[:10]))
+
+		ranges = nil
+		modelPath, err := cache.Fetch(t.Context(), model)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"bytes=10-"}, ranges)
+		got, err := modelPath.ReadFile()
+		require.NoError(t, err)
+		assert.Equal(t, content, got)
+		assert.False(t, cache.Dir().Join("tmp", digest+".partial").Exist())
+
+		// The cached model is not downloaded again
+		ranges = nil
+		_, err = cache.Fetch(t.Context(), model)
+		require.NoError(t, err)
+		assert.Empty(t, ranges)
+	})
+
+	t.Run("it rejects a model with a wrong checksum", func(t *testing.T) {
+		cache := New(paths.New(t.TempDir()), baseURL)
+		wrong := model
+		wrong.Download = &modelsindex.ModelDownload{URL: "model.eim", SHA256: zeros}
+		_, err := cache.Fetch(t.Context(), wrong)
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+		assert.False(t, mustPath(t, cache, zeros).Exist())
+		assert.False(t, cache.Dir().Join("tmp", zeros+".partial").Exist())
+	})
+
+	t.Run("it rejects an invalid digest", func(t *testing.T) {
+		cache := New(paths.New(t.TempDir()), baseURL)
+		for _, digest := range []string{"0000", "../../etc/passwd", strings.Repeat("g", 64)} {
+			invalid := model
+			invalid.Download = &modelsindex.ModelDownload{URL: "model.eim", SHA256: digest}
+			_, err := cache.Fetch(t.Context(), invalid)
+			require.ErrorIs(t, err, ErrInvalidDigest, digest)
+			_, err = cache.Path(digest)
+			require.ErrorIs(t, err, ErrInvalidDigest, digest)
+		}
+	})
+
+	t.Run("it fails on models without download information", func(t *testing.T) {
+		cache := New(paths.New(t.TempDir()), baseURL)
+		_, err := cache.Fetch(t.Context(), modelsindex.AIModel{ID: "builtin"})
+		require.ErrorIs(t, err, ErrNotDownloadable)
+	})
+}
+
+func TestFetchInParallel(t *testing.T) {
+	slow, fast := []byte("slow model"), []byte("fast model")
+	slowSum, fastSum := sha256.Sum256(slow), sha256.Sum256(fast)
+	slowDigest, fastDigest := hex.EncodeToString(slowSum[:]), hex.EncodeToString(fastSum[:])
+
+	slowStarted, releaseSlow := make(chan struct{}), make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow.eim":
+			close(slowStarted)
+			<-releaseSlow
+			_, _ = w.Write(slow)
+		case "/fast.eim":
+			_, _ = w.Write(fast)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cache := New(paths.New(t.TempDir()), nil)
+	// A stale partial download of the slow model, resumed by the fetch.
+	require.NoError(t, cache.Dir().Join("tmp").MkdirAll())
+	partial := cache.Dir().Join("tmp", slowDigest+".partial")
+	require.NoError(t, partial.WriteFile(nil))
+	stale := time.Now().Add(-partialDownloadMaxAge - time.Minute)
+	require.NoError(t, os.Chtimes(partial.String(), stale, stale))
+
+	slowDone := make(chan error, 1)
+	go func() {
+		_, err := cache.Fetch(t.Context(), modelsindex.AIModel{ID: "slow", Download: &modelsindex.ModelDownload{URL: server.URL + "/slow.eim", SHA256: slowDigest}})
+		slowDone <- err
+	}()
+	<-slowStarted
+
+	// Another model is downloaded while the first download is in progress.
+	_, err := cache.Fetch(t.Context(), modelsindex.AIModel{ID: "fast", Download: &modelsindex.ModelDownload{URL: server.URL + "/fast.eim", SHA256: fastDigest}})
+	require.NoError(t, err)
+
+	// The partial file of the download in progress is not pruned.
+	unused, err := cache.Unused(func(*paths.Path, string) bool { return false })
+	require.NoError(t, err)
+	require.NotContains(t, unused, partial)
+
+	close(releaseSlow)
+	require.NoError(t, <-slowDone)
+}
+
+func TestPrune(t *testing.T) {
+	aDigest, bDigest, cDigest, dDigest := strings.Repeat("a", 64), strings.Repeat("b", 64), strings.Repeat("c", 64), strings.Repeat("d", 64)
+	eDigest, fDigest := strings.Repeat("e", 64), strings.Repeat("f", 64)
+	cache := New(paths.New(t.TempDir()), nil)
+	require.NoError(t, cache.Dir().Join("sha256").MkdirAll())
+	require.NoError(t, cache.Dir().Join("tmp").MkdirAll())
+	require.NoError(t, mustPath(t, cache, aDigest).WriteFile([]byte("used")))
+	require.NoError(t, mustPath(t, cache, bDigest).WriteFile([]byte("no longer used")))
+	require.NoError(t, mustPath(t, cache, cDigest).WriteFile([]byte("app deleted")))
+	require.NoError(t, mustPath(t, cache, dDigest).WriteFile([]byte("never used")))
+	require.NoError(t, cache.Dir().Join("tmp", eDigest+".partial").WriteFile([]byte("partial")))
+	stale := time.Now().Add(-partialDownloadMaxAge - time.Minute)
+	require.NoError(t, os.Chtimes(cache.Dir().Join("tmp", eDigest+".partial").String(), stale, stale))
+	// A recent partial download may be in progress in another process.
+	require.NoError(t, cache.Dir().Join("tmp", fDigest+".partial").WriteFile([]byte("downloading")))
+
+	require.NoError(t, cache.SetAppUsage(paths.New("/apps/one"), map[string]string{"model-a": aDigest, "model-b": bDigest}))
+	require.NoError(t, cache.SetAppUsage(paths.New("/apps/deleted"), map[string]string{"model-c": cDigest}))
+
+	result, err := cache.Prune(func(appPath *paths.Path, modelID string) bool {
+		return appPath.String() == "/apps/one" && modelID == "model-a"
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.ModelsRemoved)
+	assert.Equal(t, int64(len("no longer used")+len("app deleted")+len("never used")+len("partial")), result.SpaceFreed)
+
+	assert.True(t, mustPath(t, cache, aDigest).Exist())
+	assert.False(t, mustPath(t, cache, bDigest).Exist())
+	assert.False(t, mustPath(t, cache, cDigest).Exist())
+	assert.False(t, mustPath(t, cache, dDigest).Exist())
+	assert.False(t, cache.Dir().Join("tmp", eDigest+".partial").Exist())
+	assert.True(t, cache.Dir().Join("tmp", fDigest+".partial").Exist())
+
+	usage, err := cache.AppsUsage()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{"/apps/one": {"model-a": aDigest}}, usage)
+}
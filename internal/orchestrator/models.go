@@ -16,6 +16,13 @@
 package orchestrator
 
 import (
+	"slices"
+
+	"github.com/arduino/go-paths-helper"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 )
 
@@ -28,33 +35,43 @@ type AIModelItem struct {
 	Name               string            `json:"name"`
 	ModuleDescription  string            `json:"description"`
 	Runner             string            `json:"runner"`
+	RunnerImage        string            `json:"runner_image,omitempty"`
+	InputModality      string            `json:"input_modality,omitempty"`
+	Source             string            `json:"source"`
 	Bricks             []string          `json:"brick_ids"`
+	Labels             []string          `json:"labels,omitempty"`
+	InputShape         []int             `json:"input_shape,omitempty"`
 	Metadata           map[string]string `json:"metadata,omitempty"`
 	ModelConfiguration map[string]string `json:"model_configuration,omitempty"`
 }
 
 type AIModelsListRequest struct {
-	FilterByBrickID []string
+	FilterByBrickID  []string
+	FilterByRunner   string
+	FilterByModality string
 }
 
-func AIModelsList(req AIModelsListRequest, modelsIndex *modelsindex.ModelsIndex) AIModelsListResult {
+func AIModelsList(req AIModelsListRequest, modelsIndex *modelsindex.ModelsIndex, bricksIndex *bricksindex.BricksIndex) AIModelsListResult {
 	var collection []modelsindex.AIModel
 	if len(req.FilterByBrickID) == 0 {
 		collection = modelsIndex.GetModels()
 	} else {
-		collection = modelsIndex.GetModelsByBricks(req.FilterByBrickID)
+		collection = f.Filter(modelsIndex.GetModelsByBricks(req.FilterByBrickID), func(model modelsindex.AIModel) bool {
+			return slices.ContainsFunc(req.FilterByBrickID, func(brickID string) bool {
+				brick, found := bricksIndex.FindBrickByID(brickID)
+				return found && modelsindex.CheckCompatibility(model, *brick) == nil
+			})
+		})
+	}
+	if req.FilterByRunner != "" {
+		collection = f.Filter(collection, func(model modelsindex.AIModel) bool { return model.Runner == req.FilterByRunner })
+	}
+	if req.FilterByModality != "" {
+		collection = f.Filter(collection, func(model modelsindex.AIModel) bool { return model.InputModality == req.FilterByModality })
 	}
 	res := AIModelsListResult{Models: make([]AIModelItem, len(collection))}
 	for i, model := range collection {
-		res.Models[i] = AIModelItem{
-			ID:                 model.ID,
-			Name:               model.Name,
-			ModuleDescription:  model.ModuleDescription,
-			Runner:             model.Runner,
-			Bricks:             model.Bricks,
-			Metadata:           model.Metadata,
-			ModelConfiguration: model.ModelConfiguration,
-		}
+		res.Models[i] = toAIModelItem(model)
 	}
 	return res
 }
@@ -64,13 +81,60 @@ func AIModelDetails(modelsIndex *modelsindex.ModelsIndex, id string) (AIModelIte
 	if !found {
 		return AIModelItem{}, false
 	}
+	return toAIModelItem(*model), true
+}
+
+type AIModelImportRequest struct {
+	File        *paths.Path
+	ID          string
+	Name        string
+	Description string
+	Runner      string
+	RunnerImage string
+	Modality    string
+	Bricks      []string
+	Labels      []string
+	InputShape  []int
+}
+
+// AIModelImport registers a user-trained Edge Impulse model in the custom models directory,
+// making it selectable by the bricks it declares.
+func AIModelImport(req AIModelImportRequest, modelsIndex *modelsindex.ModelsIndex, cfg config.Configuration) (AIModelItem, error) {
+	model, err := modelsIndex.ImportCustomModel(cfg.CustomEIModelsDir(), req.File, modelsindex.AIModel{
+		ID:                req.ID,
+		Name:              req.Name,
+		ModuleDescription: req.Description,
+		Runner:            req.Runner,
+		RunnerImage:       req.RunnerImage,
+		InputModality:     req.Modality,
+		Bricks:            req.Bricks,
+		ModelLabels:       req.Labels,
+		InputShape:        req.InputShape,
+	})
+	if err != nil {
+		return AIModelItem{}, err
+	}
+	return toAIModelItem(model), nil
+}
+
+// AIModelRemove deletes a custom model from the custom models directory.
+func AIModelRemove(id string, modelsIndex *modelsindex.ModelsIndex, cfg config.Configuration) error {
+	return modelsIndex.RemoveCustomModel(cfg.CustomEIModelsDir(), id)
+}
+
+func toAIModelItem(model modelsindex.AIModel) AIModelItem {
 	return AIModelItem{
 		ID:                 model.ID,
 		Name:               model.Name,
 		ModuleDescription:  model.ModuleDescription,
 		Runner:             model.Runner,
+		RunnerImage:        model.RunnerImage,
+		InputModality:      model.InputModality,
+		Source:             model.Source,
 		Bricks:             model.Bricks,
+		Labels:             model.ModelLabels,
+		InputShape:         model.InputShape,
 		Metadata:           model.Metadata,
 		ModelConfiguration: model.ModelConfiguration,
-	}, true
+	}
 }
@@ -0,0 +1,91 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package modelsindex
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+var ErrIncompatibleModel = errors.New("model is not compatible with the brick")
+
+// CheckCompatibility verifies that the model can be used by the brick: the model
+// must list the brick, and the brick must accept the runner, image, input modality
+// and labels declared by the model. A runner, image or input modality the model does
+// not declare is not checked.
+func CheckCompatibility(model AIModel, brick bricksindex.Brick) error {
+	var reasons []string
+	if !slices.Contains(model.Bricks, brick.ID) {
+		reasons = append(reasons, fmt.Sprintf("model %q is not available for brick %q", model.ID, brick.ID))
+	}
+
+	req := brick.ModelRequirements
+	if !accepts(req.Runners, model.Runner, sameValue) {
+		reasons = append(reasons, fmt.Sprintf("runner %q is not one of %s", model.Runner, strings.Join(req.Runners, ", ")))
+	}
+	if !accepts(req.Images, model.RunnerImage, sameImage) {
+		reasons = append(reasons, fmt.Sprintf("image %q is not one of %s", model.RunnerImage, strings.Join(req.Images, ", ")))
+	}
+	if !accepts(req.InputModalities, model.InputModality, sameValue) {
+		reasons = append(reasons, fmt.Sprintf("input modality %q is not one of %s", model.InputModality, strings.Join(req.InputModalities, ", ")))
+	}
+	var missingLabels []string
+	for _, label := range req.Labels {
+		if !slices.Contains(model.ModelLabels, label) {
+			missingLabels = append(missingLabels, label)
+		}
+	}
+	if len(missingLabels) > 0 {
+		reasons = append(reasons, fmt.Sprintf("missing labels %s", strings.Join(missingLabels, ", ")))
+	}
+
+	if len(reasons) > 0 {
+		return fmt.Errorf("%w: %s", ErrIncompatibleModel, strings.Join(reasons, "; "))
+	}
+	return nil
+}
+
+// accepts reports whether the value declared by a model is one of the accepted ones. Values
+// not declared, or without any accepted value, are accepted.
+func accepts(accepted []string, value string, equal func(a, b string) bool) bool {
+	if len(accepted) == 0 || value == "" {
+		return true
+	}
+	return slices.ContainsFunc(accepted, func(a string) bool { return equal(a, value) })
+}
+
+func sameValue(a, b string) bool {
+	return a == b
+}
+
+// sameImage compares two image references ignoring the tag when one of them does not specify it.
+func sameImage(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return imageName(a) == imageName(b) && (imageName(a) == a || imageName(b) == b)
+}
+
+func imageName(image string) string {
+	if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+		return image[:idx]
+	}
+	return image
+}
@@ -0,0 +1,221 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package modelsindex
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml"
+)
+
+// CustomModelExtension is the extension of the Edge Impulse models stored in the custom models directory.
+// Each `<id>.eim` model may be described by a `<id>.yaml` metadata file placed next to it.
+const CustomModelExtension = ".eim"
+
+const customModelMetadataExtension = ".yaml"
+
+var (
+	ErrModelNotFound    = errors.New("model not found")
+	ErrModelNotCustom   = errors.New("only custom models can be removed")
+	ErrModelIDInUse     = errors.New("a model with the same id already exists")
+	ErrInvalidModelID   = errors.New("invalid model id")
+	ErrInvalidModelFile = errors.New("model file must have the " + CustomModelExtension + " extension")
+)
+
+var customModelIDRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ValidateCustomModelID returns ErrInvalidModelID if id cannot be used as a custom model id.
+func ValidateCustomModelID(id string) error {
+	if !customModelIDRegexp.MatchString(id) {
+		return fmt.Errorf("%w: %q", ErrInvalidModelID, id)
+	}
+	return nil
+}
+
+// CustomModelID returns the id under which a model file is registered.
+func CustomModelID(modelFile *paths.Path) string {
+	id := strings.ToLower(strings.TrimSuffix(modelFile.Base(), modelFile.Ext()))
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, id)
+}
+
+// LoadCustomModels scans the custom models directory and replaces the custom
+// models of the index with the ones found there.
+func (m *ModelsIndex) LoadCustomModels(dir *paths.Path) error {
+	custom, err := readCustomModels(dir)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	models := slices.DeleteFunc(slices.Clone(m.models), func(v AIModel) bool { return v.Source == SourceCustom })
+	for _, model := range custom {
+		if slices.ContainsFunc(models, func(v AIModel) bool { return v.ID == model.ID }) {
+			slog.Warn("skipping custom model, id already used by another model", slog.String("id", model.ID))
+			continue
+		}
+		if len(model.ModelConfiguration) == 0 {
+			model.ModelConfiguration = defaultModelConfiguration(models, model.Bricks, dir.Join(model.ID+CustomModelExtension))
+		}
+		models = append(models, model)
+	}
+	m.models = models
+	return nil
+}
+
+// ImportCustomModel copies the model file into the custom models directory, writes
+// its metadata next to it and reloads the custom models of the index.
+func (m *ModelsIndex) ImportCustomModel(dir *paths.Path, modelFile *paths.Path, metadata AIModel) (AIModel, error) {
+	if modelFile.Ext() != CustomModelExtension {
+		return AIModel{}, ErrInvalidModelFile
+	}
+	if metadata.ID == "" {
+		metadata.ID = CustomModelID(modelFile)
+	}
+	if err := ValidateCustomModelID(metadata.ID); err != nil {
+		return AIModel{}, err
+	}
+	if _, found := m.GetModelByID(metadata.ID); found {
+		return AIModel{}, fmt.Errorf("%w: %q", ErrModelIDInUse, metadata.ID)
+	}
+	if metadata.Name == "" {
+		metadata.Name = metadata.ID
+	}
+
+	if err := dir.MkdirAll(); err != nil {
+		return AIModel{}, err
+	}
+	dst := dir.Join(metadata.ID + CustomModelExtension)
+	if err := modelFile.CopyTo(dst); err != nil {
+		return AIModel{}, fmt.Errorf("failed to copy model file: %w", err)
+	}
+	if err := dst.Chmod(0755); err != nil {
+		return AIModel{}, err
+	}
+	content, err := yaml.Marshal(metadata)
+	if err != nil {
+		return AIModel{}, err
+	}
+	if err := dir.Join(metadata.ID + customModelMetadataExtension).WriteFile(content); err != nil {
+		return AIModel{}, err
+	}
+
+	if err := m.LoadCustomModels(dir); err != nil {
+		return AIModel{}, err
+	}
+	model, found := m.GetModelByID(metadata.ID)
+	if !found {
+		return AIModel{}, ErrModelNotFound
+	}
+	return *model, nil
+}
+
+// RemoveCustomModel deletes a custom model and its metadata from the custom models directory.
+func (m *ModelsIndex) RemoveCustomModel(dir *paths.Path, id string) error {
+	model, found := m.GetModelByID(id)
+	if !found {
+		return ErrModelNotFound
+	}
+	if model.Source != SourceCustom {
+		return ErrModelNotCustom
+	}
+	if err := dir.Join(id + CustomModelExtension).RemoveAll(); err != nil {
+		return err
+	}
+	if err := dir.Join(id + customModelMetadataExtension).RemoveAll(); err != nil {
+		return err
+	}
+	return m.LoadCustomModels(dir)
+}
+
+func readCustomModels(dir *paths.Path) ([]AIModel, error) {
+	if dir == nil || dir.NotExist() {
+		return nil, nil
+	}
+	files, err := dir.ReadDir()
+	if err != nil {
+		return nil, err
+	}
+	files.FilterOutDirs()
+	files.FilterSuffix(CustomModelExtension)
+	files.Sort()
+
+	models := make([]AIModel, 0, len(files))
+	for _, file := range files {
+		model, err := readCustomModel(file)
+		if err != nil {
+			slog.Warn("skipping custom model", slog.String("file", file.String()), slog.String("error", err.Error()))
+			continue
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+func readCustomModel(modelFile *paths.Path) (AIModel, error) {
+	id := strings.TrimSuffix(modelFile.Base(), CustomModelExtension)
+	var model AIModel
+	metadataFile := modelFile.Parent().Join(id + customModelMetadataExtension)
+	if metadataFile.Exist() {
+		content, err := metadataFile.ReadFile()
+		if err != nil {
+			return AIModel{}, err
+		}
+		if err := yaml.Unmarshal(content, &model); err != nil {
+			return AIModel{}, fmt.Errorf("invalid metadata file %s: %w", metadataFile, err)
+		}
+	}
+	model.ID = id
+	model.Source = SourceCustom
+	if model.Name == "" {
+		model.Name = id
+	}
+	if model.Runner == "" {
+		model.Runner = "brick"
+	}
+	return model, nil
+}
+
+// defaultModelConfiguration points the model variables used by the builtin models
+// of the same bricks to the custom model file.
+func defaultModelConfiguration(models []AIModel, bricks []string, modelFile *paths.Path) map[string]string {
+	cfg := map[string]string{}
+	for _, model := range models {
+		if !slices.ContainsFunc(model.Bricks, func(b string) bool { return slices.Contains(bricks, b) }) {
+			continue
+		}
+		for key, value := range model.ModelConfiguration {
+			if strings.HasSuffix(value, CustomModelExtension) {
+				cfg[key] = modelFile.String()
+			}
+		}
+	}
+	if len(cfg) == 0 {
+		return nil
+	}
+	return cfg
+}
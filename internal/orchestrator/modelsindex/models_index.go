@@ -17,6 +17,7 @@ package modelsindex
 
 import (
 	"slices"
+	"sync"
 
 	"github.com/arduino/go-paths-helper"
 	"github.com/goccy/go-yaml"
@@ -42,26 +43,51 @@ func (b *assetsModelList) UnmarshalYAML(unmarshal func(any) error) error {
 	return nil
 }
 
+// Sources of the models in the index.
+const (
+	SourceBuiltin = "builtin"
+	SourceCustom  = "custom"
+)
+
 type AIModel struct {
 	ID                 string            `yaml:"-"`
+	Source             string            `yaml:"-"`
 	Name               string            `yaml:"name"`
 	ModuleDescription  string            `yaml:"description"`
 	Runner             string            `yaml:"runner"`
+	RunnerImage        string            `yaml:"runner_image,omitempty"`
+	InputModality      string            `yaml:"input_modality,omitempty"`
 	Bricks             []string          `yaml:"bricks,omitempty"`
 	ModelLabels        []string          `yaml:"model_labels,omitempty"`
+	InputShape         []int             `yaml:"input_shape,omitempty"`
 	Metadata           map[string]string `yaml:"metadata,omitempty"`
 	ModelConfiguration map[string]string `yaml:"model_configuration,omitempty"`
+	Download           *ModelDownload    `yaml:"download,omitempty"`
+}
+
+// ModelDownload describes where a model that is not shipped inside a runner image can be downloaded.
+// The `${MODEL_PATH}` placeholder in the model configuration is replaced with the path of the downloaded file.
+type ModelDownload struct {
+	// URL of the model file, relative URLs are resolved against the configured models download URL.
+	URL    string `yaml:"url"`
+	SHA256 string `yaml:"sha256"`
+	Size   int64  `yaml:"size,omitempty"`
 }
 
 type ModelsIndex struct {
+	mu     sync.RWMutex
 	models []AIModel
 }
 
 func (m *ModelsIndex) GetModels() []AIModel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return m.models
 }
 
 func (m *ModelsIndex) GetModelByID(id string) (*AIModel, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	idx := slices.IndexFunc(m.models, func(v AIModel) bool { return v.ID == id })
 	if idx == -1 {
 		return nil, false
@@ -70,6 +96,8 @@ func (m *ModelsIndex) GetModelByID(id string) (*AIModel, bool) {
 }
 
 func (m *ModelsIndex) GetModelsByBrick(brick string) []AIModel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	var matches []AIModel
 	for i := range m.models {
 		if len(m.models[i].Bricks) > 0 && slices.Contains(m.models[i].Bricks, brick) {
@@ -83,6 +111,8 @@ func (m *ModelsIndex) GetModelsByBrick(brick string) []AIModel {
 }
 
 func (m *ModelsIndex) GetModelsByBricks(bricks []string) []AIModel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	var matchingModels []AIModel
 	for _, model := range m.models {
 		for _, modelBrick := range model.Bricks {
@@ -110,6 +140,7 @@ func GenerateModelsIndexFromFile(dir *paths.Path) (*ModelsIndex, error) {
 	for i, modelMap := range list.Models {
 		for id, model := range modelMap {
 			model.ID = id
+			model.Source = SourceBuiltin
 			models[i] = model
 		}
 	}
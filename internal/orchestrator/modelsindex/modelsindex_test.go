@@ -6,6 +6,8 @@ import (
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 )
 
 func TestModelsIndex(t *testing.T) {
@@ -70,3 +72,148 @@ func TestModelsIndex(t *testing.T) {
 		assert.Equal(t, "yolox-object-detection", models[1].ID)
 	})
 }
+
+func TestCustomModels(t *testing.T) {
+	modelsIndex, err := GenerateModelsIndexFromFile(paths.New("testdata"))
+	require.NoError(t, err)
+
+	customDir := paths.New(t.TempDir())
+	require.NoError(t, customDir.Join("plain.eim").WriteFile([]byte("model")))
+	require.NoError(t, customDir.Join("my-faces.eim").WriteFile([]byte("model")))
+	require.NoError(t, customDir.Join("my-faces.yaml").WriteFile([]byte(`
+name: My faces
+runner: brick
+bricks:
+  - arduino:object_detection
+model_labels:
+  - alice
+  - bob
+input_shape: [96, 96, 3]
+`)))
+	require.NoError(t, customDir.Join("notes.txt").WriteFile([]byte("not a model")))
+
+	require.NoError(t, modelsIndex.LoadCustomModels(customDir))
+
+	t.Run("it merges custom models into the index", func(t *testing.T) {
+		assert.Len(t, modelsIndex.GetModels(), 4)
+
+		model, found := modelsIndex.GetModelByID("face-detection")
+		require.True(t, found)
+		assert.Equal(t, SourceBuiltin, model.Source)
+
+		model, found = modelsIndex.GetModelByID("my-faces")
+		require.True(t, found)
+		assert.Equal(t, SourceCustom, model.Source)
+		assert.Equal(t, "My faces", model.Name)
+		assert.Equal(t, []string{"alice", "bob"}, model.ModelLabels)
+		assert.Equal(t, []int{96, 96, 3}, model.InputShape)
+		assert.Equal(t, map[string]string{"EI_OBJ_DETECTION_MODEL": customDir.Join("my-faces.eim").String()}, model.ModelConfiguration)
+
+		model, found = modelsIndex.GetModelByID("plain")
+		require.True(t, found)
+		assert.Equal(t, "plain", model.Name)
+		assert.Equal(t, "brick", model.Runner)
+		assert.Empty(t, model.ModelConfiguration)
+
+		models := modelsIndex.GetModelsByBrick("arduino:object_detection")
+		assert.Equal(t, []string{"face-detection", "my-faces"}, []string{models[0].ID, models[1].ID})
+	})
+
+	t.Run("it imports a custom model", func(t *testing.T) {
+		src := paths.New(t.TempDir(), "Hand Gestures.eim")
+		require.NoError(t, src.WriteFile([]byte("model")))
+
+		model, err := modelsIndex.ImportCustomModel(customDir, src, AIModel{
+			Bricks:      []string{"arduino:video_object_detection"},
+			ModelLabels: []string{"up", "down"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "hand-gestures", model.ID)
+		assert.Equal(t, SourceCustom, model.Source)
+		assert.Equal(t, map[string]string{"EI_OBJ_DETECTION_MODEL": customDir.Join("hand-gestures.eim").String()}, model.ModelConfiguration)
+		assert.True(t, customDir.Join("hand-gestures.eim").Exist())
+		assert.True(t, customDir.Join("hand-gestures.yaml").Exist())
+
+		_, err = modelsIndex.ImportCustomModel(customDir, src, AIModel{})
+		require.ErrorIs(t, err, ErrModelIDInUse)
+
+		_, err = modelsIndex.ImportCustomModel(customDir, src, AIModel{ID: "face-detection"})
+		require.ErrorIs(t, err, ErrModelIDInUse)
+
+		_, err = modelsIndex.ImportCustomModel(customDir, paths.New("testdata", "models-list.yaml"), AIModel{})
+		require.ErrorIs(t, err, ErrInvalidModelFile)
+	})
+
+	t.Run("it removes a custom model", func(t *testing.T) {
+		require.ErrorIs(t, modelsIndex.RemoveCustomModel(customDir, "face-detection"), ErrModelNotCustom)
+		require.ErrorIs(t, modelsIndex.RemoveCustomModel(customDir, "not-existing"), ErrModelNotFound)
+
+		require.NoError(t, modelsIndex.RemoveCustomModel(customDir, "hand-gestures"))
+		_, found := modelsIndex.GetModelByID("hand-gestures")
+		assert.False(t, found)
+		assert.False(t, customDir.Join("hand-gestures.eim").Exist())
+		assert.False(t, customDir.Join("hand-gestures.yaml").Exist())
+	})
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	model := AIModel{
+		ID:            "my-faces",
+		Runner:        "brick",
+		RunnerImage:   "ghcr.io/arduino/app-bricks/ei-models-runner:0.5.0",
+		InputModality: "image",
+		Bricks:        []string{"arduino:object_detection"},
+		ModelLabels:   []string{"face", "person"},
+	}
+
+	t.Run("it accepts a compatible model", func(t *testing.T) {
+		brick := bricksindex.Brick{
+			ID: "arduino:object_detection",
+			ModelRequirements: bricksindex.ModelRequirements{
+				Runners:         []string{"brick"},
+				Images:          []string{"ghcr.io/arduino/app-bricks/ei-models-runner"},
+				InputModalities: []string{"image"},
+				Labels:          []string{"face"},
+			},
+		}
+		require.NoError(t, CheckCompatibility(model, brick))
+		require.NoError(t, CheckCompatibility(model, bricksindex.Brick{ID: "arduino:object_detection"}))
+	})
+
+	t.Run("it rejects a model not listing the brick", func(t *testing.T) {
+		err := CheckCompatibility(model, bricksindex.Brick{ID: "arduino:audio_classifier"})
+		require.ErrorIs(t, err, ErrIncompatibleModel)
+		assert.Contains(t, err.Error(), `model "my-faces" is not available for brick "arduino:audio_classifier"`)
+	})
+
+	t.Run("it rejects a model the brick does not accept", func(t *testing.T) {
+		brick := bricksindex.Brick{
+			ID: "arduino:object_detection",
+			ModelRequirements: bricksindex.ModelRequirements{
+				Runners:         []string{"llm"},
+				Images:          []string{"ghcr.io/arduino/app-bricks/other-runner"},
+				InputModalities: []string{"audio"},
+				Labels:          []string{"face", "car"},
+			},
+		}
+		err := CheckCompatibility(model, brick)
+		require.ErrorIs(t, err, ErrIncompatibleModel)
+		assert.Contains(t, err.Error(), `runner "brick" is not one of llm`)
+		assert.Contains(t, err.Error(), `image "ghcr.io/arduino/app-bricks/ei-models-runner:0.5.0" is not one of ghcr.io/arduino/app-bricks/other-runner`)
+		assert.Contains(t, err.Error(), `input modality "image" is not one of audio`)
+		assert.Contains(t, err.Error(), "missing labels car")
+	})
+
+	t.Run("it does not check the fields the model does not declare", func(t *testing.T) {
+		brick := bricksindex.Brick{
+			ID: "arduino:object_detection",
+			ModelRequirements: bricksindex.ModelRequirements{
+				Runners:         []string{"llm"},
+				Images:          []string{"ghcr.io/arduino/app-bricks/other-runner"},
+				InputModalities: []string{"audio"},
+			},
+		}
+		undeclared := AIModel{ID: "my-faces", Bricks: []string{"arduino:object_detection"}}
+		require.NoError(t, CheckCompatibility(undeclared, brick))
+	})
+}
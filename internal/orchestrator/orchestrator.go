@@ -18,6 +18,7 @@ package orchestrator
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"iter"
@@ -37,7 +38,6 @@ import (
 	"github.com/docker/cli/cli/command"
 	"github.com/goccy/go-yaml"
 	"github.com/gosimple/slug"
-	"github.com/sirupsen/logrus"
 	"go.bug.st/f"
 
 	"github.com/arduino/arduino-app-cli/internal/fatomic"
@@ -45,10 +45,13 @@ import (
 	"github.com/arduino/arduino-app-cli/internal/micro"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
 	appgenerator "github.com/arduino/arduino-app-cli/internal/orchestrator/app/generator"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/devices"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 	"github.com/arduino/arduino-app-cli/internal/store"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
 )
 
 var (
@@ -59,9 +62,9 @@ var (
 const (
 	DefaultDockerStopTimeoutSeconds = 5
 
-	CameraDevice     = "camera"
-	MicrophoneDevice = "microphone"
-	SpeakerDevice    = "speaker"
+	CameraDevice     = devices.Camera
+	MicrophoneDevice = devices.Microphone
+	SpeakerDevice    = devices.Speaker
 )
 
 type AppStreamMessage struct {
@@ -117,12 +120,13 @@ func StartApp(
 	app app.ArduinoApp,
 	cfg config.Configuration,
 	staticStore *store.StaticStore,
+	opts StartAppOptions,
 ) iter.Seq[StreamMessage] {
 	return func(yield func(StreamMessage) bool) {
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
 
-		running, err := getRunningApp(ctx, docker.Client())
+		running, err := getRunningApp(ctx, docker.Client(), cfg)
 		if err != nil {
 			yield(StreamMessage{error: err})
 			return
@@ -135,6 +139,19 @@ func StartApp(
 			return
 		}
 
+		// Offline, check everything upfront instead of failing on the first download.
+		if opts.Offline {
+			missing, err := missingAppDependencies(ctx, docker, provisioner, modelsIndex, bricksIndex, &app, cfg, staticStore)
+			if err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
+			if len(missing) > 0 {
+				yield(StreamMessage{error: &MissingDependenciesError{Missing: missing}})
+				return
+			}
+		}
+
 		if err := setStatusLeds(LedTriggerNone); err != nil {
 			slog.Debug("unable to set status leds", slog.String("error", err.Error()))
 		}
@@ -152,7 +169,7 @@ func StartApp(
 			if !yield(StreamMessage{progress: &Progress{Name: "sketch compiling and uploading", Progress: 0.0}}) {
 				return
 			}
-			if err := compileUploadSketch(ctx, &app, sketchCallbackWriter); err != nil {
+			if _, _, err := ensureSketchUploaded(ctx, &app, cfg, opts.ForceUpload, sketchCallbackWriter); err != nil {
 				yield(StreamMessage{error: err})
 				return
 			}
@@ -162,7 +179,13 @@ func StartApp(
 		}
 
 		if app.MainPythonFile != nil {
+			modelPaths, err := fetchAppModels(ctx, app, modelsIndex, sharedModelCache(cfg))
+			if err != nil {
+				yield(StreamMessage{error: err})
+				return
+			}
 			envs := getAppEnvironmentVariables(app, bricksIndex, modelsIndex)
+			resolveModelPaths(envs, app, modelsIndex, modelPaths)
 
 			if !yield(StreamMessage{data: "python provisioning"}) {
 				cancel()
@@ -188,14 +211,11 @@ func StartApp(
 			}
 
 			// Launch the docker compose command to start the app
-			overrideComposeFile := app.AppComposeOverrideFilePath()
-
-			commands := []string{}
-			commands = append(commands, "docker", "compose", "-f", app.AppComposeFilePath().String())
-			if ok, _ := overrideComposeFile.ExistCheck(); ok {
-				commands = append(commands, "-f", overrideComposeFile.String())
+			pull := "missing"
+			if opts.Offline {
+				pull = "never"
 			}
-			commands = append(commands, "up", "-d", "--remove-orphans", "--pull", "missing")
+			commands := appComposeCommand(&app, "up", "-d", "--remove-orphans", "--pull", pull)
 
 			dockerParser := NewDockerProgressParser(200)
 
@@ -442,11 +462,12 @@ func RestartApp(
 	appToStart app.ArduinoApp,
 	cfg config.Configuration,
 	staticStore *store.StaticStore,
+	opts StartAppOptions,
 ) iter.Seq[StreamMessage] {
 	return func(yield func(StreamMessage) bool) {
 		ctx, cancel := context.WithCancel(ctx)
 		defer cancel()
-		runningApp, err := getRunningApp(ctx, docker.Client())
+		runningApp, err := getRunningApp(ctx, docker.Client(), cfg)
 		if err != nil {
 			yield(StreamMessage{error: err})
 			return
@@ -468,7 +489,7 @@ func RestartApp(
 				}
 			}
 		}
-		startStream := StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, appToStart, cfg, staticStore)
+		startStream := StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, appToStart, cfg, staticStore, opts)
 		startStream(yield)
 	}
 }
@@ -501,7 +522,9 @@ func StartDefaultApp(
 	}
 
 	// TODO: we need to stop all other running app before starting the default app.
-	for msg := range StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, *app, cfg, staticStore) {
+	// A sketch deployed in flash survives the reboot: StartApp skips its upload when the
+	// flashed image still matches the sketch of the app.
+	for msg := range StartApp(ctx, docker, provisioner, modelsIndex, bricksIndex, *app, cfg, staticStore, StartAppOptions{}) {
 		if msg.IsError() {
 			return fmt.Errorf("failed to start app: %w", msg.GetError())
 		}
@@ -511,8 +534,9 @@ func StartDefaultApp(
 }
 
 type ListAppResult struct {
-	Apps       []AppInfo       `json:"apps"`
-	BrokenApps []BrokenAppInfo `json:"broken_apps"`
+	Apps            []AppInfo            `json:"apps"`
+	BrokenApps      []BrokenAppInfo      `json:"broken_apps"`
+	UnsupportedApps []UnsupportedAppInfo `json:"unsupported_apps"`
 }
 
 type AppInfo struct {
@@ -523,6 +547,7 @@ type AppInfo struct {
 	Status      Status `json:"status,omitempty"`
 	Example     bool   `json:"example"`
 	Default     bool   `json:"default"`
+	ReadOnly    bool   `json:"read_only,omitempty"`
 }
 
 type BrokenAppInfo struct {
@@ -530,6 +555,14 @@ type BrokenAppInfo struct {
 	Error string `json:"error"`
 }
 
+// UnsupportedAppInfo describes an app whose app.yaml format is newer than the one supported by the CLI.
+type UnsupportedAppInfo struct {
+	Name                   string `json:"name"`
+	Path                   string `json:"path"`
+	FormatVersion          int    `json:"format_version"`
+	SupportedFormatVersion int    `json:"supported_format_version"`
+}
+
 type ListAppRequest struct {
 	ShowExamples    bool
 	ShowOnlyDefault bool
@@ -540,6 +573,10 @@ type ListAppRequest struct {
 	// We will search by looking for docker container metadata, and add the app not present in the
 	// standard apps directory in the result list.
 	IncludeNonStandardLocationApps bool
+
+	// Catalog, when set, is used to look up and load the apps of the folders it
+	// watches, instead of walking them.
+	Catalog *appcatalog.Catalog
 }
 
 func ListApps(
@@ -564,6 +601,12 @@ func ListApps(
 	}
 	if req.ShowApps || req.ShowOnlyDefault {
 		pathsToExplore.Add(cfg.AppsDir())
+		for _, root := range cfg.AppRoots() {
+			// Removable roots may be not mounted.
+			if root.Path.IsDir() {
+				pathsToExplore.Add(root.Path)
+			}
+		}
 		// adds app that are on different paths
 		if req.IncludeNonStandardLocationApps {
 			for _, app := range apps {
@@ -572,18 +615,13 @@ func ListApps(
 		}
 	}
 
-	result := ListAppResult{Apps: []AppInfo{}, BrokenApps: []BrokenAppInfo{}}
+	result := ListAppResult{Apps: []AppInfo{}, BrokenApps: []BrokenAppInfo{}, UnsupportedApps: []UnsupportedAppInfo{}}
 	for _, p := range pathsToExplore {
-		res, err := p.ReadDirRecursiveFiltered(func(file *paths.Path) bool {
-			if file.Base() == ".cache" {
-				return false
-			}
-			if file.Join("app.yaml").NotExist() && file.Join("app.yml").NotExist() {
-				// Let's continue the scan, we might be in an parent folder
-				return true
-			}
-			return false
-		}, paths.FilterDirectories(), paths.FilterOutNames("python", "sketch", ".cache"))
+		if req.Catalog != nil && req.Catalog.Watches(p) {
+			appPaths.AddAllMissing(req.Catalog.AppPaths(p))
+			continue
+		}
+		res, err := findAppPaths(p)
 		if err != nil {
 			slog.Error("unable to list apps", slog.String("error", err.Error()))
 			return result, err
@@ -597,7 +635,22 @@ func ListApps(
 	}
 
 	for _, file := range appPaths {
-		app, err := app.Load(file.String())
+		var userApp app.ArduinoApp
+		if req.Catalog != nil {
+			userApp, err = req.Catalog.Load(file)
+		} else {
+			userApp, err = app.Load(file.String(), cfg)
+		}
+		var tooNew *app.FormatTooNewError
+		if errors.As(err, &tooNew) {
+			result.UnsupportedApps = append(result.UnsupportedApps, UnsupportedAppInfo{
+				Name:                   file.Base(),
+				Path:                   file.String(),
+				FormatVersion:          tooNew.Version,
+				SupportedFormatVersion: app.CurrentFormatVersion,
+			})
+			continue
+		}
 		if err != nil {
 			result.BrokenApps = append(result.BrokenApps, BrokenAppInfo{
 				Name:  file.Base(),
@@ -606,14 +659,14 @@ func ListApps(
 			continue
 		}
 
-		isDefault := defaultApp != nil && defaultApp.FullPath.String() == app.FullPath.String()
+		isDefault := defaultApp != nil && defaultApp.FullPath.String() == userApp.FullPath.String()
 		if req.ShowOnlyDefault && !isDefault {
 			continue
 		}
 
 		var status Status
 		if idx := slices.IndexFunc(apps, func(a AppStatusInfo) bool {
-			return a.AppPath.EqualsTo(app.FullPath)
+			return a.AppPath.EqualsTo(userApp.FullPath)
 		}); idx != -1 {
 			status = apps[idx].Status
 		}
@@ -622,7 +675,7 @@ func ListApps(
 			continue
 		}
 
-		id, err := idProvider.IDFromPath(app.FullPath)
+		id, err := idProvider.IDFromPath(userApp.FullPath)
 		if err != nil {
 			return ListAppResult{}, fmt.Errorf("failed to get app ID from path %s: %w", file.String(), err)
 		}
@@ -630,12 +683,13 @@ func ListApps(
 		result.Apps = append(result.Apps,
 			AppInfo{
 				ID:          id,
-				Name:        app.Name,
-				Description: app.Descriptor.Description,
-				Icon:        app.Descriptor.Icon,
+				Name:        userApp.Name,
+				Description: userApp.Descriptor.Description,
+				Icon:        userApp.Descriptor.Icon,
 				Status:      status,
 				Example:     id.IsExample(),
 				Default:     isDefault,
+				ReadOnly:    id.IsReadOnly(),
 			},
 		)
 	}
@@ -643,6 +697,21 @@ func ListApps(
 	return result, nil
 }
 
+// findAppPaths returns the folders containing an app descriptor under the given path.
+func findAppPaths(p *paths.Path) (paths.PathList, error) {
+	return p.ReadDirRecursiveFiltered(func(file *paths.Path) bool {
+		// Hidden folders, like .cache or the temporary folder of an app being imported, are never apps.
+		if strings.HasPrefix(file.Base(), ".") {
+			return false
+		}
+		if file.Join("app.yaml").NotExist() && file.Join("app.yml").NotExist() {
+			// Let's continue the scan, we might be in an parent folder
+			return true
+		}
+		return false
+	}, paths.FilterDirectories(), paths.FilterOutNames("python", "sketch"), paths.FilterOutPrefixes("."))
+}
+
 type AppDetailedInfo struct {
 	ID          app.ID             `json:"id" required:"true" `
 	Name        string             `json:"name" required:"true"`
@@ -652,7 +721,12 @@ type AppDetailedInfo struct {
 	Status      Status             `json:"status" required:"true"`
 	Example     bool               `json:"example"`
 	Default     bool               `json:"default"`
+	ReadOnly    bool               `json:"read_only,omitempty"`
+	Dev         bool               `json:"dev,omitempty"`
+	FlashMode   string             `json:"flash_mode,omitempty" enum:"ram,flash" description:"where the sketch is deployed, only set for apps with a sketch"`
+	Flashed     bool               `json:"flashed,omitempty" description:"the sketch stored in the flash of the MCU was uploaded by this app"`
 	Bricks      []AppDetailedBrick `json:"bricks,omitempty"`
+	Git         *AppGitInfo        `json:"git,omitempty"`
 }
 
 type AppDetailedBrick struct {
@@ -670,9 +744,10 @@ func AppDetails(
 	cfg config.Configuration,
 ) (AppDetailedInfo, error) {
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(3)
 	var defaultAppPath string
 	var status Status
+	var gitInfo *AppGitInfo
 	go func() {
 		defer wg.Done()
 		app, err := getAppStatus(ctx, docker, userApp)
@@ -696,6 +771,15 @@ func AppDetails(
 		defaultAppPath = defaultApp.FullPath.String()
 
 	}()
+	go func() {
+		defer wg.Done()
+		info, err := AppGitStatus(userApp)
+		if err != nil {
+			slog.Warn("unable to get app git status", slog.String("error", err.Error()), slog.String("path", userApp.FullPath.String()))
+			return
+		}
+		gitInfo = info
+	}()
 	wg.Wait()
 
 	id, err := idProvider.IDFromPath(userApp.FullPath)
@@ -703,6 +787,15 @@ func AppDetails(
 		return AppDetailedInfo{}, err
 	}
 
+	var flashMode string
+	var flashed bool
+	if userApp.MainSketchPath != nil {
+		flashMode = userApp.Descriptor.SketchFlashMode()
+		if record := readSketchUploadRecord(cfg); record != nil {
+			flashed = record.FlashMode == app.FlashModeFlash && record.AppPath == userApp.FullPath.String()
+		}
+	}
+
 	return AppDetailedInfo{
 		ID:          id,
 		Name:        userApp.Name,
@@ -712,6 +805,10 @@ func AppDetails(
 		Status:      status,
 		Example:     id.IsExample(),
 		Default:     defaultAppPath == userApp.FullPath.String(),
+		ReadOnly:    id.IsReadOnly(),
+		Dev:         userApp.Descriptor.Dev,
+		FlashMode:   flashMode,
+		Flashed:     flashed,
 		Bricks: f.Map(userApp.Descriptor.Bricks, func(b app.Brick) AppDetailedBrick {
 			res := AppDetailedBrick{ID: b.ID}
 			bi, found := bricksIndex.FindBrickByID(b.ID)
@@ -723,6 +820,7 @@ func AppDetails(
 			res.Category = bi.Category
 			return res
 		}),
+		Git: gitInfo,
 	}, nil
 }
 
@@ -730,8 +828,14 @@ type CreateAppRequest struct {
 	Name        string
 	Icon        string
 	Description string
+	Ports       []int
+	Bricks      []string
 	SkipPython  bool
 	SkipSketch  bool
+	// Template, when set, replaces the default app boilerplate.
+	Template *AppTemplate
+	// GitInit initializes a git repository in the new app.
+	GitInit bool
 }
 
 type CreateAppResponse struct {
@@ -742,24 +846,37 @@ func CreateApp(
 	ctx context.Context,
 	req CreateAppRequest,
 	idProvider *app.IDProvider,
+	bricksIndex *bricksindex.BricksIndex,
 	cfg config.Configuration,
-) (CreateAppResponse, error) {
+) (_ CreateAppResponse, createErr error) {
 	if req.SkipPython && req.SkipSketch {
 		return CreateAppResponse{}, fmt.Errorf("cannot skip both python and sketch")
 	}
+	if req.Template != nil && req.Template.Source == AppTemplateSourceBrick && req.SkipPython {
+		return CreateAppResponse{}, fmt.Errorf("cannot skip python with a brick template")
+	}
 	if req.Name == "" {
 		return CreateAppResponse{}, fmt.Errorf("app name cannot be empty")
 	}
+	for _, id := range req.Bricks {
+		if _, found := bricksIndex.FindBrickByID(id); !found {
+			return CreateAppResponse{}, fmt.Errorf("%w: brick %q not found", app.ErrInvalidApp, id)
+		}
+	}
 
 	basePath, appExists := findAppPathByName(req.Name, cfg)
 	if appExists {
 		return CreateAppResponse{}, ErrAppAlreadyExists
 	}
 	appName := req.Name
+	ports := req.Ports
+	if ports == nil {
+		ports = []int{}
+	}
 	newApp := app.AppDescriptor{
 		Name:        appName,
 		Description: req.Description,
-		Ports:       []int{},
+		Ports:       ports,
 		Icon:        req.Icon, // TODO: not sure if icon will exists for bricks
 	}
 	if err := newApp.IsValid(); err != nil {
@@ -774,9 +891,45 @@ func CreateApp(
 		options |= appgenerator.SkipPython
 	}
 
-	if err := appgenerator.GenerateApp(basePath, newApp, options); err != nil {
-		return CreateAppResponse{}, fmt.Errorf("failed to create app: %w", err)
+	// In case something fails we remove the partially created app
+	defer func() {
+		if createErr != nil {
+			_ = basePath.RemoveAll()
+		}
+	}()
+
+	replacer := templateReplacer(req.Name, req.Description, ports)
+	if req.Template != nil && req.Template.Source != AppTemplateSourceBrick {
+		if err := copyTemplateApp(req.Template.Path, basePath, replacer); err != nil {
+			return CreateAppResponse{}, fmt.Errorf("failed to copy app template: %w", err)
+		}
+		if req.SkipPython {
+			_ = basePath.Join("python").RemoveAll()
+		}
+		if req.SkipSketch {
+			_ = basePath.Join("sketch").RemoveAll()
+		}
+	} else {
+		if err := appgenerator.GenerateApp(basePath, newApp, options); err != nil {
+			return CreateAppResponse{}, fmt.Errorf("failed to create app: %w", err)
+		}
+		if req.Template != nil {
+			if err := copyTemplateFile(req.Template.Path, basePath.Join("python", "main.py"), replacer); err != nil {
+				return CreateAppResponse{}, fmt.Errorf("failed to copy brick example: %w", err)
+			}
+		}
+	}
+	if req.Template != nil || len(req.Bricks) > 0 {
+		if err := applyCreateRequest(basePath, req, cfg); err != nil {
+			return CreateAppResponse{}, err
+		}
+	}
+	if req.GitInit {
+		if err := InitAppGitRepository(basePath); err != nil {
+			return CreateAppResponse{}, err
+		}
 	}
+
 	id, err := idProvider.IDFromPath(basePath)
 	if err != nil {
 		return CreateAppResponse{}, fmt.Errorf("failed to get app id: %w", err)
@@ -784,8 +937,44 @@ func CreateApp(
 	return CreateAppResponse{ID: id}, nil
 }
 
+// applyCreateRequest updates the descriptor of an app created from a template with the
+// values of the request and the bricks to pre-populate.
+func applyCreateRequest(basePath *paths.Path, req CreateAppRequest, cfg config.Configuration) error {
+	newApp, err := app.Load(basePath.String(), cfg)
+	if err != nil {
+		return fmt.Errorf("%w: %w", app.ErrInvalidApp, err)
+	}
+	newApp.Descriptor.Name = req.Name
+	if req.Description != "" {
+		newApp.Descriptor.Description = req.Description
+	}
+	if req.Icon != "" {
+		newApp.Descriptor.Icon = req.Icon
+	}
+	if len(req.Ports) > 0 {
+		newApp.Descriptor.Ports = req.Ports
+	}
+	var bricks []string
+	if req.Template != nil {
+		bricks = append(bricks, req.Template.Bricks...)
+	}
+	bricks = append(bricks, req.Bricks...)
+	for _, id := range bricks {
+		if !slices.ContainsFunc(newApp.Descriptor.Bricks, func(b app.Brick) bool { return b.ID == id }) {
+			newApp.Descriptor.Bricks = append(newApp.Descriptor.Bricks, app.Brick{ID: id})
+		}
+	}
+	if err := newApp.Save(); err != nil {
+		return fmt.Errorf("failed to save app descriptor: %w", err)
+	}
+	return nil
+}
+
 type CloneAppRequest struct {
 	FromID app.ID
+	// FromRepository, when set, is a local bare git repository the app is cloned from
+	// instead of FromID.
+	FromRepository *paths.Path
 
 	Name *string
 	Icon *string
@@ -801,12 +990,21 @@ func CloneApp(
 	idProvider *app.IDProvider,
 	cfg config.Configuration,
 ) (response CloneAppResponse, cloneErr error) {
-	originPath := req.FromID.ToPath()
-	if !originPath.Exist() {
-		return CloneAppResponse{}, ErrAppDoesntExists
-	}
-	if !originPath.Join("app.yaml").Exist() && !originPath.Join("app.yml").Exist() {
-		return CloneAppResponse{}, app.ErrInvalidApp
+	var originName string
+	if req.FromRepository != nil {
+		if !req.FromRepository.IsDir() {
+			return CloneAppResponse{}, ErrAppDoesntExists
+		}
+		originName = strings.TrimSuffix(req.FromRepository.Base(), ".git")
+	} else {
+		originPath := req.FromID.ToPath()
+		if !originPath.Exist() {
+			return CloneAppResponse{}, ErrAppDoesntExists
+		}
+		if !originPath.Join("app.yaml").Exist() && !originPath.Join("app.yml").Exist() {
+			return CloneAppResponse{}, app.ErrInvalidApp
+		}
+		originName = originPath.Base()
 	}
 
 	var dstPath *paths.Path
@@ -817,7 +1015,7 @@ func CloneApp(
 		}
 	} else {
 		for i := range 100 { // In case of name collision, we try up to 100 times.
-			dstName := fmt.Sprintf("%s-copy%d", originPath.Base(), i)
+			dstName := fmt.Sprintf("%s-copy%d", originName, i)
 			dstPath = cfg.AppsDir().Join(dstName)
 			if !dstPath.Exist() {
 				break
@@ -835,20 +1033,15 @@ func CloneApp(
 		}
 	}()
 
-	list, err := originPath.ReadDir(paths.FilterOutNames(".cache", "data"))
-	if err != nil {
-		return CloneAppResponse{}, fmt.Errorf("failed to read app directory: %w", err)
-	}
-	for _, file := range list {
-		if file.IsDir() {
-			if err := file.CopyDirTo(dstPath.Join(file.Base())); err != nil {
-				return CloneAppResponse{}, fmt.Errorf("failed to copy directory: %w", err)
-			}
-		} else {
-			if err := file.CopyTo(dstPath.Join(file.Base())); err != nil {
-				return CloneAppResponse{}, fmt.Errorf("failed to copy file: %w", err)
-			}
+	if req.FromRepository != nil {
+		if err := cloneAppRepository(req.FromRepository, dstPath); err != nil {
+			return CloneAppResponse{}, err
+		}
+		if !dstPath.Join("app.yaml").Exist() && !dstPath.Join("app.yml").Exist() {
+			return CloneAppResponse{}, app.ErrInvalidApp
 		}
+	} else if err := copyAppFiles(req.FromID.ToPath(), dstPath); err != nil {
+		return CloneAppResponse{}, err
 	}
 
 	if (req.Name != nil && *req.Name != "") || (req.Icon != nil && *req.Icon != "") {
@@ -888,7 +1081,29 @@ func CloneApp(
 	return CloneAppResponse{ID: id}, nil
 }
 
+func copyAppFiles(originPath, dstPath *paths.Path) error {
+	list, err := originPath.ReadDir(paths.FilterOutNames(".cache", "data"))
+	if err != nil {
+		return fmt.Errorf("failed to read app directory: %w", err)
+	}
+	for _, file := range list {
+		if file.IsDir() {
+			if err := file.CopyDirTo(dstPath.Join(file.Base())); err != nil {
+				return fmt.Errorf("failed to copy directory: %w", err)
+			}
+		} else {
+			if err := file.CopyTo(dstPath.Join(file.Base())); err != nil {
+				return fmt.Errorf("failed to copy file: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
 func DeleteApp(ctx context.Context, app app.ArduinoApp) error {
+	if err := app.CheckWritable(); err != nil {
+		return err
+	}
 	for msg := range StopApp(ctx, app) {
 		if msg.error != nil {
 			return fmt.Errorf("failed to stop app: %w", msg.error)
@@ -932,7 +1147,7 @@ func GetDefaultApp(cfg config.Configuration) (*app.ArduinoApp, error) {
 		return nil, nil
 	}
 
-	app, err := app.Load(string(defaultAppPath))
+	app, err := app.Load(string(defaultAppPath), cfg)
 	if err != nil {
 		// If the app is not valid, we remove the file
 		slog.Warn("default app is not valid", slog.String("path", string(defaultAppPath)), slog.String("error", err.Error()))
@@ -948,6 +1163,7 @@ type AppEditRequest struct {
 	Icon        *string
 	Description *string
 	Default     *bool
+	Dev         *bool
 }
 
 func EditApp(
@@ -955,11 +1171,20 @@ func EditApp(
 	editApp *app.ArduinoApp,
 	cfg config.Configuration,
 ) (editErr error) {
+	// The default app is not stored in the app, so it can be changed for read-only apps too.
+	if req.Name != nil || req.Icon != nil || req.Description != nil || req.Dev != nil {
+		if err := editApp.CheckWritable(); err != nil {
+			return err
+		}
+	}
 	if req.Default != nil {
 		if err := editAppDefaults(editApp, *req.Default, cfg); err != nil {
 			return fmt.Errorf("failed to edit app defaults: %w", err)
 		}
 	}
+	if req.Name == nil && req.Icon == nil && req.Description == nil && req.Dev == nil {
+		return nil
+	}
 
 	if req.Name != nil {
 		editApp.Descriptor.Name = *req.Name
@@ -981,6 +1206,9 @@ func EditApp(
 	if req.Description != nil {
 		editApp.Descriptor.Description = *req.Description
 	}
+	if req.Dev != nil {
+		editApp.Descriptor.Dev = *req.Dev
+	}
 
 	if err := editApp.Descriptor.IsValid(); err != nil {
 		return fmt.Errorf("%w: %w", app.ErrInvalidApp, err)
@@ -1069,32 +1297,28 @@ func getDevices() (*deviceResult, error) {
 	return &res, nil
 }
 
+func (r *deviceResult) available() devices.Available {
+	return devices.Available{
+		Camera:     r.hasVideoDevice,
+		Microphone: r.hasSoundDevice,
+		Speaker:    r.hasSoundDevice,
+	}
+}
+
 // Validate that the required devices are available. Blocks the app start if a required device is missing.
 func validateDevices(res *deviceResult, requiredDeviceClasses map[string]any) error {
-
-	// Check if all required device classes are available
-	if len(requiredDeviceClasses) > 0 {
-		for class := range requiredDeviceClasses {
-			switch class {
-			case CameraDevice:
-				if !res.hasVideoDevice {
-					return fmt.Errorf("no camera found")
-				}
-			case MicrophoneDevice:
-				if !res.hasSoundDevice {
-					return fmt.Errorf("no microphone device found")
-				}
-			case SpeakerDevice:
-				if !res.hasSoundDevice {
-					return fmt.Errorf("no speaker device found")
-				}
-			default:
-				slog.Debug("not handled device class - no action", slog.String("class", class))
-			}
+	for class := range requiredDeviceClasses {
+		if !devices.IsKnownClass(class) {
+			slog.Debug("not handled device class - no action", slog.String("class", class))
 		}
 	}
 
-	return nil
+	compatibility := res.available().Check(slices.Sorted(maps.Keys(requiredDeviceClasses)))
+	var allErrors error
+	for _, missing := range compatibility.MissingDevices {
+		allErrors = errors.Join(allErrors, errors.New(missing.Hint))
+	}
+	return allErrors
 }
 
 // addLedControl adds bindings for led control if the paths exist.
@@ -1119,44 +1343,47 @@ func addLedControl(volumes []volume) []volume {
 	return volumes
 }
 
-func compileUploadSketch(
+// buildSketch compiles the sketch of the app and, if upload is true, uploads it to the micro.
+// The returned result is nil if the compilation could not be attempted; when the compilation
+// fails it is returned, with its diagnostics, together with the error.
+func buildSketch(
 	ctx context.Context,
 	arduinoApp *app.ArduinoApp,
+	upload bool,
 	w io.Writer,
-) error {
-	logrus.SetLevel(logrus.ErrorLevel) // Reduce the log level of arduino-cli
-	srv := commands.NewArduinoCoreServer()
+) (*SketchBuildResult, error) {
+	req, err := sketchProfileRequest(ctx, arduinoApp, w)
+	if err != nil {
+		return nil, err
+	}
 
-	var inst *rpc.Instance
-	if resp, err := srv.Create(ctx, &rpc.CreateRequest{}); err != nil {
+	var result *SketchBuildResult
+	err = arduinocli.Shared().Run(ctx, req, func(s *arduinocli.Session) error {
+		var err error
+		result, err = compileUploadSketch(ctx, s.Server, s.Instance, arduinoApp, upload, w)
 		return err
-	} else {
-		inst = resp.GetInstance()
-	}
+	})
+	return result, err
+}
 
-	defer func() {
-		_, _ = srv.Destroy(ctx, &rpc.DestroyRequest{Instance: inst})
-	}()
+// sketchProfileRequest returns the request for the instance of the default profile of the
+// sketch of the app. The init progress of the instance is written to w.
+func sketchProfileRequest(ctx context.Context, arduinoApp *app.ArduinoApp, w io.Writer) (arduinocli.Request, error) {
 	sketchPath := arduinoApp.MainSketchPath.String()
-	buildPath := arduinoApp.SketchBuildPath().String()
-	sketchResp, err := srv.LoadSketch(ctx, &rpc.LoadSketchRequest{SketchPath: sketchPath})
+	sketchResp, err := arduinocli.Shared().Server().LoadSketch(ctx, &rpc.LoadSketchRequest{SketchPath: sketchPath})
 	if err != nil {
-		return err
+		return arduinocli.Request{}, err
 	}
 	sketch := sketchResp.GetSketch()
 	profile := sketch.GetDefaultProfile().GetName()
 	if profile == "" {
-		return fmt.Errorf("sketch %q has no default profile", sketchPath)
+		return arduinocli.Request{}, fmt.Errorf("sketch %q has no default profile", sketchPath)
 	}
-	initReq := &rpc.InitRequest{
-		Instance:   inst,
+
+	return arduinocli.Request{
 		SketchPath: sketchPath,
 		Profile:    profile,
-	}
-
-	if err := srv.Init(
-		initReq,
-		commands.InitStreamResponseToCallbackFunction(ctx, func(r *rpc.InitResponse) error {
+		InitCallback: func(r *rpc.InitResponse) error {
 			var response string
 			switch msg := r.GetMessage().(type) {
 			case *rpc.InitResponse_InitProgress:
@@ -1180,30 +1407,48 @@ func compileUploadSketch(
 			}
 
 			return nil
-		}),
-	); err != nil {
-		return err
+		},
+	}, nil
+}
+
+func compileUploadSketch(
+	ctx context.Context,
+	srv rpc.ArduinoCoreServiceServer,
+	inst *rpc.Instance,
+	arduinoApp *app.ArduinoApp,
+	upload bool,
+	w io.Writer,
+) (*SketchBuildResult, error) {
+	sketchPath := arduinoApp.MainSketchPath.String()
+	buildPath := arduinoApp.SketchBuildPath().String()
+
+	fqbn := "arduino:zephyr:unoq"
+	flashMode := arduinoApp.Descriptor.SketchFlashMode()
+	if flashMode == app.FlashModeFlash {
+		fqbn += ":flash_mode=flash"
 	}
 
 	// build the sketch
 	server, getCompileResult := commands.CompilerServerToStreams(ctx, w, w, nil)
 	compileReq := rpc.CompileRequest{
 		Instance:   inst,
-		Fqbn:       "arduino:zephyr:unoq",
+		Fqbn:       fqbn,
 		SketchPath: sketchPath,
 		BuildPath:  buildPath,
 		Jobs:       2,
 	}
 
-	err = srv.Compile(&compileReq, server)
-	if err != nil {
-		return err
+	compileErr := srv.Compile(&compileReq, server)
+	result := getCompileResult()
+	if compileErr != nil {
+		if result == nil {
+			return nil, compileErr
+		}
+		return newSketchBuildResult(arduinoApp, result, compileErr), compileErr
 	}
 
 	// Output compilations details
-	result := getCompileResult()
 	f.Assert(result != nil, "Failed to get compilation result")
-	// TODO: maybe handle result.GetDiagnostics()
 	boardPlatform := result.GetBoardPlatform()
 	if boardPlatform != nil {
 		slog.Info("Board platform: " + boardPlatform.GetId() + " (" + boardPlatform.GetVersion() + ") in " + boardPlatform.GetInstallDir())
@@ -1216,6 +1461,31 @@ func compileUploadSketch(
 		slog.Info("Used library " + lib.GetName() + " (" + lib.GetVersion() + ") in " + lib.GetInstallDir())
 	}
 
+	buildResult := newSketchBuildResult(arduinoApp, result, nil)
+	if !upload {
+		return buildResult, nil
+	}
+	if flashMode == app.FlashModeFlash {
+		if err := uploadSketchInFlash(ctx, w, srv, inst, fqbn, sketchPath, buildPath); err != nil {
+			return buildResult, err
+		}
+		return buildResult, nil
+	}
+	if err := uploadSketchInRamOrConfigure(ctx, w, srv, inst, sketchPath, buildPath); err != nil {
+		return buildResult, err
+	}
+	return buildResult, nil
+}
+
+// uploadSketchInRamOrConfigure uploads the sketch in RAM and, if that fails, configures the
+// micro in RAM mode and retries.
+func uploadSketchInRamOrConfigure(ctx context.Context,
+	w io.Writer,
+	srv rpc.ArduinoCoreServiceServer,
+	inst *rpc.Instance,
+	sketchPath string,
+	buildPath string,
+) error {
 	if err := uploadSketchInRam(ctx, w, srv, inst, sketchPath, buildPath); err != nil {
 		slog.Warn("failed to upload in ram mode, trying to configure the board in ram mode, and retry", slog.String("error", err.Error()))
 		if err := configureMicroInRamMode(ctx, w, srv, inst); err != nil {
@@ -1245,6 +1515,25 @@ func uploadSketchInRam(ctx context.Context,
 	return nil
 }
 
+// uploadSketchInFlash uploads the sketch in the flash of the micro, so that it keeps running
+// after a power loss.
+func uploadSketchInFlash(ctx context.Context,
+	w io.Writer,
+	srv rpc.ArduinoCoreServiceServer,
+	inst *rpc.Instance,
+	fqbn string,
+	sketchPath string,
+	buildPath string,
+) error {
+	stream, _ := commands.UploadToServerStreams(ctx, w, w)
+	return srv.Upload(&rpc.UploadRequest{
+		Instance:   inst,
+		Fqbn:       fqbn,
+		SketchPath: sketchPath,
+		ImportDir:  buildPath,
+	}, stream)
+}
+
 // configureMicroInRamMode uploads an empty binary overing any sketch previously uploaded in flash.
 // This is required to be able to upload sketches in ram mode after if there is already a sketch in flash.
 func configureMicroInRamMode(
@@ -29,8 +29,10 @@ import (
 	"go.bug.st/f"
 
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/appcatalog"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/devices"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
 )
 
@@ -40,7 +42,7 @@ func TestCloneApp(t *testing.T) {
 
 	originalAppID := f.Must(idProvider.ParseID("user:original-app"))
 	originalAppPath := originalAppID.ToPath()
-	r, err := CreateApp(t.Context(), CreateAppRequest{Name: "original-app"}, idProvider, cfg)
+	r, err := CreateApp(t.Context(), CreateAppRequest{Name: "original-app"}, idProvider, &bricksindex.BricksIndex{}, cfg)
 	require.NoError(t, err)
 	require.Equal(t, originalAppID, r.ID)
 	require.DirExists(t, originalAppPath.String())
@@ -90,7 +92,7 @@ func TestCloneApp(t *testing.T) {
 			})
 
 			// The app.yaml will have the name set to the new-name
-			clonedApp := f.Must(app.Load(appDir.String()))
+			clonedApp := f.Must(app.Load(appDir.String(), cfg))
 			require.Equal(t, "new-name", clonedApp.Name)
 		})
 		t.Run("with icon", func(t *testing.T) {
@@ -108,7 +110,7 @@ func TestCloneApp(t *testing.T) {
 			})
 
 			// The app.yaml will have the icon set to 🦄
-			clonedApp := f.Must(app.Load(appDir.String()))
+			clonedApp := f.Must(app.Load(appDir.String(), cfg))
 			require.Equal(t, "with-icon", clonedApp.Name)
 			require.Equal(t, "🦄", clonedApp.Descriptor.Icon)
 		})
@@ -159,12 +161,12 @@ func TestEditApp(t *testing.T) {
 	idProvider := app.NewAppIDProvider(cfg)
 
 	t.Run("with default", func(t *testing.T) {
-		_, err := CreateApp(t.Context(), CreateAppRequest{Name: "app-default"}, idProvider, cfg)
+		_, err := CreateApp(t.Context(), CreateAppRequest{Name: "app-default"}, idProvider, &bricksindex.BricksIndex{}, cfg)
 		require.NoError(t, err)
 		appDir := cfg.AppsDir().Join("app-default")
 
 		t.Run("previously not default", func(t *testing.T) {
-			app := f.Must(app.Load(appDir.String()))
+			app := f.Must(app.Load(appDir.String(), cfg))
 
 			previousDefaultApp, err := GetDefaultApp(cfg)
 			require.NoError(t, err)
@@ -178,7 +180,7 @@ func TestEditApp(t *testing.T) {
 			require.True(t, appDir.EquivalentTo(currentDefaultApp.FullPath))
 		})
 		t.Run("previously default", func(t *testing.T) {
-			app := f.Must(app.Load(appDir.String()))
+			app := f.Must(app.Load(appDir.String(), cfg))
 			err := SetDefaultApp(&app, cfg)
 			require.NoError(t, err)
 
@@ -197,25 +199,25 @@ func TestEditApp(t *testing.T) {
 
 	t.Run("with name", func(t *testing.T) {
 		originalAppName := "original-name"
-		_, err := CreateApp(t.Context(), CreateAppRequest{Name: originalAppName}, idProvider, cfg)
+		_, err := CreateApp(t.Context(), CreateAppRequest{Name: originalAppName}, idProvider, &bricksindex.BricksIndex{}, cfg)
 		require.NoError(t, err)
 		appDir := cfg.AppsDir().Join(originalAppName)
-		userApp := f.Must(app.Load(appDir.String()))
+		userApp := f.Must(app.Load(appDir.String(), cfg))
 		originalPath := userApp.FullPath
 
 		err = EditApp(AppEditRequest{Name: f.Ptr("new-name")}, &userApp, cfg)
 		require.NoError(t, err)
-		editedApp, err := app.Load(cfg.AppsDir().Join("new-name").String())
+		editedApp, err := app.Load(cfg.AppsDir().Join("new-name").String(), cfg)
 		require.NoError(t, err)
 		require.Equal(t, "new-name", editedApp.Name)
 		require.True(t, originalPath.NotExist()) // The original app directory should be removed after renaming
 
 		t.Run("already existing name", func(t *testing.T) {
 			existingAppName := "existing-name"
-			_, err := CreateApp(t.Context(), CreateAppRequest{Name: existingAppName}, idProvider, cfg)
+			_, err := CreateApp(t.Context(), CreateAppRequest{Name: existingAppName}, idProvider, &bricksindex.BricksIndex{}, cfg)
 			require.NoError(t, err)
 			appDir := cfg.AppsDir().Join(existingAppName)
-			existingApp := f.Must(app.Load(appDir.String()))
+			existingApp := f.Must(app.Load(appDir.String(), cfg))
 
 			err = EditApp(AppEditRequest{Name: f.Ptr(existingAppName)}, &existingApp, cfg)
 			require.ErrorIs(t, err, ErrAppAlreadyExists)
@@ -224,17 +226,17 @@ func TestEditApp(t *testing.T) {
 
 	t.Run("with icon and description", func(t *testing.T) {
 		commonAppName := "common-app"
-		_, err := CreateApp(t.Context(), CreateAppRequest{Name: commonAppName}, idProvider, cfg)
+		_, err := CreateApp(t.Context(), CreateAppRequest{Name: commonAppName}, idProvider, &bricksindex.BricksIndex{}, cfg)
 		require.NoError(t, err)
 		commonAppDir := cfg.AppsDir().Join(commonAppName)
-		commonApp := f.Must(app.Load(commonAppDir.String()))
+		commonApp := f.Must(app.Load(commonAppDir.String(), cfg))
 
 		err = EditApp(AppEditRequest{
 			Icon:        f.Ptr("💻"),
 			Description: f.Ptr("new desc"),
 		}, &commonApp, cfg)
 		require.NoError(t, err)
-		editedApp := f.Must(app.Load(commonAppDir.String()))
+		editedApp := f.Must(app.Load(commonAppDir.String(), cfg))
 		require.Equal(t, "new desc", editedApp.Descriptor.Description)
 		require.Equal(t, "💻", editedApp.Descriptor.Icon)
 	})
@@ -351,6 +353,78 @@ func TestListApp(t *testing.T) {
 			},
 		}, res.Apps))
 	})
+
+	t.Run("list from the catalogue", func(t *testing.T) {
+		catalog, err := appcatalog.New(idProvider, cfg)
+		require.NoError(t, err)
+		defer catalog.Close()
+
+		want, err := ListApps(t.Context(), dockerCli, ListAppRequest{ShowApps: true, ShowExamples: true}, idProvider, cfg)
+		require.NoError(t, err)
+		res, err := ListApps(t.Context(), dockerCli, ListAppRequest{ShowApps: true, ShowExamples: true, Catalog: catalog}, idProvider, cfg)
+		require.NoError(t, err)
+		assert.Empty(t, gCmp.Diff(want.Apps, res.Apps))
+	})
+
+	t.Run("apps with a newer format are reported as unsupported", func(t *testing.T) {
+		appDir := cfg.AppsDir().Join("future-app")
+		require.NoError(t, appDir.Join("python").MkdirAll())
+		require.NoError(t, appDir.Join("python", "main.py").WriteFile(nil))
+		require.NoError(t, appDir.Join("app.yaml").WriteFile([]byte("format_version: 99\nname: future-app\nsome_new_key: true\n")))
+		t.Cleanup(func() { _ = appDir.RemoveAll() })
+
+		res, err := ListApps(t.Context(), dockerCli, ListAppRequest{ShowApps: true}, idProvider, cfg)
+		require.NoError(t, err)
+		assert.Empty(t, res.BrokenApps)
+		assert.Len(t, res.Apps, 2)
+		assert.Equal(t, []UnsupportedAppInfo{{
+			Name:                   "future-app",
+			Path:                   appDir.String(),
+			FormatVersion:          99,
+			SupportedFormatVersion: app.CurrentFormatVersion,
+		}}, res.UnsupportedApps)
+	})
+}
+
+func TestListAppFromAppRoots(t *testing.T) {
+	rootsDir := paths.New(t.TempDir())
+	t.Setenv("ARDUINO_APP_CLI__APP_ROOTS", "usb="+rootsDir.Join("usb").String()+",ro;missing="+rootsDir.Join("missing").String())
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	docker, err := dockerClient.NewClientWithOpts(
+		dockerClient.FromEnv,
+		dockerClient.WithAPIVersionNegotiation(),
+	)
+	require.NoError(t, err)
+	dockerCli, err := command.NewDockerCli(
+		command.WithAPIClient(docker),
+		command.WithBaseContext(t.Context()),
+	)
+	require.NoError(t, err)
+	require.NoError(t, dockerCli.Initialize(&flags.ClientOptions{}))
+
+	createApp(t, "app1", false, idProvider, cfg)
+	usbApp := rootsDir.Join("usb", "usb-app")
+	require.NoError(t, usbApp.Join("python").MkdirAll())
+	require.NoError(t, usbApp.Join("python", "main.py").WriteFile(nil))
+	require.NoError(t, usbApp.Join("app.yaml").WriteFile([]byte("name: usb-app\n")))
+
+	res, err := ListApps(t.Context(), dockerCli, ListAppRequest{ShowApps: true}, idProvider, cfg)
+	require.NoError(t, err)
+	assert.Empty(t, res.BrokenApps)
+	assert.Empty(t, gCmp.Diff([]AppInfo{
+		{
+			ID:   f.Must(idProvider.ParseID("user:app1")),
+			Name: "app1",
+			Icon: "😃",
+		},
+		{
+			ID:       f.Must(idProvider.ParseID("usb:usb-app")),
+			Name:     "usb-app",
+			ReadOnly: true,
+		},
+	}, res.Apps))
 }
 
 func setTestOrchestratorConfig(t *testing.T) config.Configuration {
@@ -378,7 +452,7 @@ func createApp(
 	res, err := CreateApp(t.Context(), CreateAppRequest{
 		Name: name,
 		Icon: "😃",
-	}, idProvider, cfg)
+	}, idProvider, &bricksindex.BricksIndex{}, cfg)
 	require.NoError(t, err)
 	require.Empty(t, gCmp.Diff(f.Must(idProvider.ParseID("user:"+name)), res.ID))
 	if isExample {
@@ -427,7 +501,7 @@ func TestGetAppEnvironmentVariablesWithDefaults(t *testing.T) {
 	require.NoError(t, err)
 
 	appId := createApp(t, "app1", false, idProvider, cfg)
-	appDesc, err := app.Load(appId.ToPath().String())
+	appDesc, err := app.Load(appId.ToPath().String(), cfg)
 	require.NoError(t, err)
 	appDesc.Descriptor.Bricks = []app.Brick{
 		{
@@ -512,7 +586,7 @@ func TestGetAppEnvironmentVariablesWithCustomModelOverrides(t *testing.T) {
 	require.NoError(t, err)
 
 	appId := createApp(t, "app1", false, idProvider, cfg)
-	appDesc, err := app.Load(appId.ToPath().String())
+	appDesc, err := app.Load(appId.ToPath().String(), cfg)
 	require.NoError(t, err)
 	appDesc.Descriptor.Bricks = []app.Brick{
 		{
@@ -630,3 +704,34 @@ func TestValidateDevice(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAppCompatibility(t *testing.T) {
+	bricksIndex := &bricksindex.BricksIndex{
+		Bricks: []bricksindex.Brick{
+			{ID: "arduino:video_object_detection", RequiredDevices: []string{"camera"}},
+			{ID: "arduino:keyword_spotting", RequiredDevices: []string{"microphone"}},
+		},
+	}
+	userApp := app.ArduinoApp{
+		Descriptor: app.AppDescriptor{
+			Bricks:          []app.Brick{{ID: "arduino:video_object_detection"}, {ID: "arduino:keyword_spotting"}},
+			RequiredDevices: []string{"speaker"},
+		},
+	}
+
+	t.Run("compatible", func(t *testing.T) {
+		res := appCompatibility(userApp, bricksIndex, devices.Available{Camera: true, Microphone: true, Speaker: true})
+		require.True(t, res.Compatible)
+		require.Len(t, res.Bricks, 2)
+		require.True(t, res.Bricks[0].Compatible)
+		require.True(t, res.Bricks[1].Compatible)
+	})
+
+	t.Run("missing camera", func(t *testing.T) {
+		res := appCompatibility(userApp, bricksIndex, devices.Available{Microphone: true, Speaker: true})
+		require.False(t, res.Compatible)
+		require.Equal(t, []string{"camera"}, f.Map(res.MissingDevices, func(m devices.MissingDevice) string { return m.Class }))
+		require.False(t, res.Bricks[0].Compatible)
+		require.True(t, res.Bricks[1].Compatible)
+	})
+}
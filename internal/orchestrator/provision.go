@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	"github.com/arduino/go-paths-helper"
+	"github.com/compose-spec/compose-go/v2/template"
 	"github.com/containerd/errdefs"
 	"github.com/docker/cli/cli/command"
 	"github.com/docker/docker/api/types/container"
@@ -299,6 +300,9 @@ func generateMainComposeFile(
 		return err
 	}
 	mainAppCompose.Name = composeProjectName
+	if err := recordAppComposeProject(cfg, composeProjectName, app.FullPath); err != nil {
+		slog.Warn("unable to record the compose project of the app", slog.String("error", err.Error()))
+	}
 	mainAppCompose.Include = composeFiles.AsStrings()
 
 	volumes := []volume{
@@ -308,6 +312,14 @@ func generateMainComposeFile(
 			Target: "/app",
 		},
 	}
+	if app.ReadOnly {
+		// The folder of the app is not writable: the state, like the logs, goes to the data folder.
+		volumes = append(volumes, volume{
+			Type:   "bind",
+			Source: app.ProvisioningStateDir().String(),
+			Target: "/app/.cache",
+		})
+	}
 	slog.Debug("Adding UNIX socket", slog.Any("sock", cfg.RouterSocketPath().String()), slog.Bool("exists", cfg.RouterSocketPath().Exist()))
 	if cfg.RouterSocketPath().Exist() {
 		volumes = append(volumes, volume{
@@ -394,7 +406,14 @@ func generateMainComposeFile(
 
 	// If there are services that require devices, we need to generate an override compose file
 	// Write additional file to override devices section in included compose files
-	if e := generateServicesOverrideFile(app, slices.Collect(maps.Keys(services)), servicesThatRequireDevices, devices.devicePaths, getCurrentUser(), groups, overrideComposeFile, envs); e != nil {
+	// Downloaded models are read by the bricks directly from the models cache.
+	var extraVolumes []string
+	if usesModelCache(envs, cfg) {
+		cacheDir := cfg.ModelsCacheDir().String()
+		extraVolumes = append(extraVolumes, fmt.Sprintf("%s:%s:ro", cacheDir, cacheDir))
+	}
+	images := rewriteServicesImages(services, cfg, envs)
+	if e := generateServicesOverrideFile(app, slices.Collect(maps.Keys(services)), servicesThatRequireDevices, devices.devicePaths, getCurrentUser(), groups, overrideComposeFile, envs, extraVolumes, images); e != nil {
 		return e
 	}
 
@@ -419,6 +438,8 @@ func generateMainComposeFile(
 
 type serviceInfo struct {
 	hasHealthcheck bool
+	// image as written in the compose file, before the interpolation.
+	image string
 }
 
 func extractServicesFromComposeFile(composeFile *paths.Path) (map[string]serviceInfo, error) {
@@ -443,12 +464,33 @@ func extractServicesFromComposeFile(composeFile *paths.Path) (map[string]service
 	services := make(map[string]serviceInfo, len(index.Services))
 	for svc, svcDef := range index.Services {
 		hasHealthcheck := len(svcDef.Healthcheck.Test) > 0
-		services[svc] = serviceInfo{hasHealthcheck: hasHealthcheck}
+		services[svc] = serviceInfo{hasHealthcheck: hasHealthcheck, image: svcDef.Image}
 	}
 	return services, nil
 }
 
-func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string, servicesThatRequireDevices []string, devices []string, user string, groups []string, overrideComposeFile *paths.Path, envs helpers.EnvVars) error {
+// rewriteServicesImages returns the images of the services redirected to a registry mirror, by service.
+func rewriteServicesImages(services map[string]serviceInfo, cfg config.Configuration, envs helpers.EnvVars) map[string]string {
+	images := make(map[string]string)
+	for name, svc := range services {
+		image, err := template.Substitute(svc.image, func(key string) (string, bool) {
+			if value, ok := envs[key]; ok {
+				return value, true
+			}
+			return os.LookupEnv(key)
+		})
+		if err != nil {
+			slog.Warn("Failed to interpolate the image of a service", slog.String("service", name), slog.String("image", svc.image), slog.Any("error", err))
+			continue
+		}
+		if rewritten := cfg.RewriteImage(image); rewritten != image {
+			images[name] = rewritten
+		}
+	}
+	return images
+}
+
+func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string, servicesThatRequireDevices []string, devices []string, user string, groups []string, overrideComposeFile *paths.Path, envs helpers.EnvVars, volumes []string, images map[string]string) error {
 	if overrideComposeFile.Exist() {
 		if err := overrideComposeFile.Remove(); err != nil {
 			return fmt.Errorf("failed to remove existing override compose file: %w", err)
@@ -461,11 +503,13 @@ func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string,
 	}
 
 	type serviceOverride struct {
+		Image       string            `yaml:"image,omitempty"`
 		User        string            `yaml:"user,omitempty"`
 		Devices     *[]string         `yaml:"devices,omitempty"`
 		GroupAdd    *[]string         `yaml:"group_add,omitempty"`
 		Labels      map[string]string `yaml:"labels,omitempty"`
 		Environment map[string]string `yaml:"environment,omitempty"`
+		Volumes     []string          `yaml:"volumes,omitempty"`
 	}
 	var overrideCompose struct {
 		Services map[string]serviceOverride `yaml:"services,omitempty"`
@@ -473,7 +517,8 @@ func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string,
 	overrideCompose.Services = make(map[string]serviceOverride, len(services))
 	for _, svc := range services {
 		override := serviceOverride{
-			User: user,
+			Image: images[svc],
+			User:  user,
 			Labels: map[string]string{
 				DockerAppLabel:     "true",
 				DockerAppPathLabel: arduinoApp.FullPath.String(),
@@ -484,6 +529,7 @@ func generateServicesOverrideFile(arduinoApp *app.ArduinoApp, services []string,
 			override.GroupAdd = &groups
 		}
 		override.Environment = envs
+		override.Volumes = volumes
 		overrideCompose.Services[svc] = override
 	}
 	writeOverrideCompose := func() error {
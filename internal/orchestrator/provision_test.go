@@ -423,3 +423,72 @@ services:
 		require.Equal(t, exp, content, "Main compose content should match the expected structure")
 	})
 }
+
+func TestProvisionAppWithRegistryMirrors(t *testing.T) {
+	t.Setenv("DOCKER_REGISTRY_BASE", "")
+	t.Setenv("ARDUINO_APP_CLI__REGISTRY_MIRRORS", "ghcr.io/=mirror.lab:5000/ghcr/;ghcr.io/arduino/=mirror.lab:5000/arduino/;influxdb=mirror.lab:5000/library/influxdb")
+	cfg := setTestOrchestratorConfig(t)
+	require.True(t, strings.HasPrefix(cfg.PythonImage, "mirror.lab:5000/arduino/app-bricks/python-apps-base:"), cfg.PythonImage)
+	require.Equal(t, "mirror.lab:5000/ghcr/other/image:1.0", cfg.RewriteImage("ghcr.io/other/image:1.0"))
+	require.Equal(t, "docker.io/library/redis:7", cfg.RewriteImage("docker.io/library/redis:7"))
+
+	staticStore := store.NewStaticStore(cfg.AssetsDir().String())
+	for brick, compose := range map[string]string{
+		"object_detection": `
+services:
+  ei-obj-detection-runner:
+    image: ${DOCKER_REGISTRY_BASE:-ghcr.io/arduino/}app-bricks/ei-models-runner:0.5.0
+`,
+		"dbstorage_tsstore": `
+services:
+  dbstorage-influx:
+    image: influxdb:2.7
+  local-service:
+    image: my-local-image:latest
+`,
+	} {
+		composePath := cfg.AssetsDir().Join("compose", "arduino", brick)
+		require.NoError(t, composePath.MkdirAll())
+		require.NoError(t, composePath.Join("brick_compose.yaml").WriteFile([]byte(compose)))
+	}
+	require.NoError(t, cfg.AssetsDir().Join("bricks-list.yaml").WriteFile([]byte(`
+bricks:
+- id: arduino:object_detection
+  name: Object Detection
+  require_container: true
+- id: arduino:dbstorage_tsstore
+  name: Database Storage - Time Series Store
+  require_container: true
+`)))
+	bricksIndex, err := bricksindex.GenerateBricksIndexFromFile(cfg.AssetsDir())
+	require.NoError(t, err)
+
+	app := app.ArduinoApp{
+		Name: "TestApp",
+		Descriptor: app.AppDescriptor{
+			Bricks: []app.Brick{{ID: "arduino:object_detection"}, {ID: "arduino:dbstorage_tsstore"}},
+		},
+		FullPath: paths.New(t.TempDir()),
+	}
+	require.NoError(t, app.ProvisioningStateDir().MkdirAll())
+	require.NoError(t, generateMainComposeFile(&app, bricksIndex, cfg.PythonImage, cfg, map[string]string{}, staticStore))
+
+	overridesContent, err := app.AppComposeOverrideFilePath().ReadFile()
+	require.NoError(t, err)
+	var content struct {
+		Services map[string]struct {
+			Image string `yaml:"image"`
+		} `yaml:"services"`
+	}
+	require.NoError(t, yaml.Unmarshal(overridesContent, &content))
+	require.Equal(t, "mirror.lab:5000/arduino/app-bricks/ei-models-runner:0.5.0", content.Services["ei-obj-detection-runner"].Image)
+	require.Equal(t, "mirror.lab:5000/library/influxdb:2.7", content.Services["dbstorage-influx"].Image)
+	require.Empty(t, content.Services["local-service"].Image)
+
+	images, err := parseAllModelsRunnerImageTag(cfg, staticStore)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"mirror.lab:5000/arduino/app-bricks/ei-models-runner:0.5.0",
+		"mirror.lab:5000/library/influxdb:2.7",
+	}, images)
+}
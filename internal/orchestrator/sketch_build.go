@@ -0,0 +1,153 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/docker/cli/cli/command"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+)
+
+var ErrAppHasNoSketch = errors.New("the app has no sketch")
+
+// SketchDiagnostic is a compiler message, with the position of the code it refers to.
+// Files inside the app folder are relative to the app folder.
+type SketchDiagnostic struct {
+	File     string                 `json:"file" required:"true"`
+	Line     int64                  `json:"line" required:"true"`
+	Column   int64                  `json:"column,omitempty"`
+	Severity string                 `json:"severity" required:"true" enum:"error,warning,fatal"`
+	Message  string                 `json:"message" required:"true"`
+	Notes    []SketchDiagnosticNote `json:"notes,omitempty"`
+}
+
+// SketchDiagnosticNote is an additional message attached to a diagnostic.
+type SketchDiagnosticNote struct {
+	File    string `json:"file"`
+	Line    int64  `json:"line"`
+	Column  int64  `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// SketchSectionSize is the size of a section of the compiled binary. The text section is
+// the program storage, the data section the memory used by global variables.
+type SketchSectionSize struct {
+	Name    string `json:"name" required:"true"`
+	Size    int64  `json:"size" required:"true"`
+	MaxSize int64  `json:"max_size,omitempty"`
+}
+
+type SketchBuildResult struct {
+	Success       bool                `json:"success" required:"true"`
+	Error         string              `json:"error,omitempty"`
+	Diagnostics   []SketchDiagnostic  `json:"diagnostics"`
+	Sizes         []SketchSectionSize `json:"sizes,omitempty"`
+	UsedLibraries []LibraryReleaseID  `json:"used_libraries,omitempty"`
+	Uploaded      bool                `json:"uploaded,omitempty"`
+}
+
+// BuildSketch compiles the sketch of the app without uploading it. Compilation failures are
+// not returned as errors: they are reported by the result, along with the diagnostics.
+func BuildSketch(ctx context.Context, userApp app.ArduinoApp, w io.Writer) (*SketchBuildResult, error) {
+	if userApp.MainSketchPath == nil {
+		return nil, ErrAppHasNoSketch
+	}
+	result, err := buildSketch(ctx, &userApp, false, w)
+	if result != nil {
+		return result, nil
+	}
+	return nil, err
+}
+
+// UploadSketch compiles the sketch of the app and uploads it to the micro, leaving the
+// Python part of the app untouched. Compilation failures are reported by the result.
+func UploadSketch(ctx context.Context, docker command.Cli, userApp app.ArduinoApp, cfg config.Configuration, w io.Writer) (*SketchBuildResult, error) {
+	if userApp.MainSketchPath == nil {
+		return nil, ErrAppHasNoSketch
+	}
+	running, err := getRunningApp(ctx, docker.Client(), cfg)
+	if err != nil {
+		return nil, err
+	}
+	if running != nil && running.FullPath.String() != userApp.FullPath.String() {
+		return nil, fmt.Errorf("another app %q is running", running.Name)
+	}
+
+	_, result, err := ensureSketchUploaded(ctx, &userApp, cfg, true, w)
+	if result != nil && !result.Success {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Uploaded = true
+	return result, nil
+}
+
+func newSketchBuildResult(userApp *app.ArduinoApp, result *rpc.BuilderResult, compileErr error) *SketchBuildResult {
+	relPath := func(file string) string {
+		if file == "" {
+			return ""
+		}
+		if rel, err := userApp.FullPath.RelTo(paths.New(file)); err == nil && !strings.HasPrefix(rel.String(), "..") {
+			return filepath.ToSlash(rel.String())
+		}
+		return file
+	}
+
+	res := &SketchBuildResult{
+		Success:     compileErr == nil,
+		Diagnostics: []SketchDiagnostic{},
+	}
+	if compileErr != nil {
+		res.Error = compileErr.Error()
+	}
+	for _, d := range result.GetDiagnostics() {
+		res.Diagnostics = append(res.Diagnostics, SketchDiagnostic{
+			File:     relPath(d.GetFile()),
+			Line:     d.GetLine(),
+			Column:   d.GetColumn(),
+			Severity: strings.ToLower(d.GetSeverity()),
+			Message:  d.GetMessage(),
+			Notes: f.Map(d.GetNotes(), func(n *rpc.CompileDiagnosticNote) SketchDiagnosticNote {
+				return SketchDiagnosticNote{
+					File:    relPath(n.GetFile()),
+					Line:    n.GetLine(),
+					Column:  n.GetColumn(),
+					Message: n.GetMessage(),
+				}
+			}),
+		})
+	}
+	for _, s := range result.GetExecutableSectionsSize() {
+		res.Sizes = append(res.Sizes, SketchSectionSize{Name: s.GetName(), Size: s.GetSize(), MaxSize: s.GetMaxSize()})
+	}
+	for _, lib := range result.GetUsedLibraries() {
+		res.UsedLibraries = append(res.UsedLibraries, NewLibraryReleaseID(lib.GetName(), lib.GetVersion()))
+	}
+	return res
+}
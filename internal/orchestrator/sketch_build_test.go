@@ -0,0 +1,96 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+)
+
+func TestNewSketchBuildResult(t *testing.T) {
+	userApp := &app.ArduinoApp{FullPath: paths.New("/apps/blink")}
+
+	t.Run("compile failure", func(t *testing.T) {
+		res := newSketchBuildResult(userApp, &rpc.BuilderResult{
+			Diagnostics: []*rpc.CompileDiagnostic{
+				{
+					Severity: "ERROR",
+					Message:  "'foo' was not declared in this scope",
+					File:     "/apps/blink/sketch/sketch.ino",
+					Line:     12,
+					Column:   3,
+					Notes: []*rpc.CompileDiagnosticNote{
+						{Message: "suggested alternative: 'for'", File: "/apps/blink/sketch/sketch.ino", Line: 12, Column: 3},
+					},
+				},
+				{
+					Severity: "WARNING",
+					Message:  "unused variable 'x'",
+					File:     "/opt/libraries/Lib/src/lib.cpp",
+					Line:     4,
+				},
+			},
+		}, errors.New("compilation failed"))
+
+		require.Equal(t, &SketchBuildResult{
+			Success: false,
+			Error:   "compilation failed",
+			Diagnostics: []SketchDiagnostic{
+				{
+					File:     "sketch/sketch.ino",
+					Line:     12,
+					Column:   3,
+					Severity: "error",
+					Message:  "'foo' was not declared in this scope",
+					Notes: []SketchDiagnosticNote{
+						{File: "sketch/sketch.ino", Line: 12, Column: 3, Message: "suggested alternative: 'for'"},
+					},
+				},
+				{
+					File:     "/opt/libraries/Lib/src/lib.cpp",
+					Line:     4,
+					Severity: "warning",
+					Message:  "unused variable 'x'",
+					Notes:    []SketchDiagnosticNote{},
+				},
+			},
+		}, res)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		res := newSketchBuildResult(userApp, &rpc.BuilderResult{
+			ExecutableSectionsSize: []*rpc.ExecutableSectionSize{
+				{Name: "text", Size: 12000, MaxSize: 1966080},
+				{Name: "data", Size: 3000, MaxSize: 523624},
+			},
+			UsedLibraries: []*rpc.Library{{Name: "MsgPack", Version: "0.4.2"}},
+		}, nil)
+
+		require.True(t, res.Success)
+		require.Empty(t, res.Diagnostics)
+		require.Equal(t, []SketchSectionSize{
+			{Name: "text", Size: 12000, MaxSize: 1966080},
+			{Name: "data", Size: 3000, MaxSize: 523624},
+		}, res.Sizes)
+		require.Equal(t, []LibraryReleaseID{{Name: "MsgPack", Version: "0.4.2"}}, res.UsedLibraries)
+	})
+}
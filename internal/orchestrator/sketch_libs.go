@@ -17,123 +17,265 @@ package orchestrator
 
 import (
 	"context"
-	"log/slog"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
-	"github.com/arduino/arduino-cli/commands"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+	"github.com/codeclysm/extract/v4"
 	"go.bug.st/f"
 
 	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
 )
 
 const indexUpdateInterval = 10 * time.Minute
 
+// vendoredLibrariesDir is the folder of the app holding the libraries not in the library index.
+const vendoredLibrariesDir = "libraries"
+
+// ErrInvalidLibrary is returned when a folder or an archive does not contain a library.
+var ErrInvalidLibrary = errors.New("invalid library")
+
 func AddSketchLibrary(ctx context.Context, app app.ArduinoApp, libRef LibraryReleaseID, addDeps bool) ([]LibraryReleaseID, error) {
-	srv := commands.NewArduinoCoreServer()
-	var inst *rpc.Instance
-	if res, err := srv.Create(ctx, &rpc.CreateRequest{}); err != nil {
+	if err := app.CheckWritable(); err != nil {
 		return nil, err
-	} else {
-		inst = res.Instance
-	}
-	defer func() { _, _ = srv.Destroy(ctx, &rpc.DestroyRequest{Instance: inst}) }()
-	if err := srv.Init(&rpc.InitRequest{
-		Instance: inst,
-	}, commands.InitStreamResponseToCallbackFunction(ctx, func(r *rpc.InitResponse) error {
-		// TODO: LOG progress/error?
+	}
+	if libRef.Path != "" {
+		return nil, fmt.Errorf("%w: %s is not in the library index, add its folder instead", ErrInvalidLibrary, libRef)
+	}
+	var added []LibraryReleaseID
+	err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		// update the local library index after a certain time, to avoid if a library is added to the sketch but the local library index is not update, the compile can fail (because the lib is not found)
+		if err := updateLibrariesIndex(ctx, s); err != nil {
+			return err
+		}
+
+		resp, err := s.Server.ProfileLibAdd(ctx, &rpc.ProfileLibAddRequest{
+			Instance:   s.Instance,
+			SketchPath: app.MainSketchPath.String(),
+			Library: &rpc.SketchProfileLibraryReference{
+				Library: &rpc.SketchProfileLibraryReference_IndexLibrary_{
+					IndexLibrary: &rpc.SketchProfileLibraryReference_IndexLibrary{
+						Name:    libRef.Name,
+						Version: libRef.Version,
+					},
+				},
+			},
+			AddDependencies: &addDeps,
+		})
+		if err != nil {
+			return err
+		}
+		added = f.Map(resp.GetAddedLibraries(), rpcProfileLibReferenceToLibReleaseID)
 		return nil
-	})); err != nil {
+	})
+	if err != nil {
 		return nil, err
 	}
+	return added, nil
+}
 
-	stream, _ := commands.UpdateLibrariesIndexStreamResponseToCallbackFunction(ctx, func(curr *rpc.DownloadProgress) {
-		slog.Debug("downloading library index", "progress", curr.GetMessage())
-	})
-	// update the local library index after a certain time, to avoid if a library is added to the sketch but the local library index is not update, the compile can fail (because the lib is not found)
-	req := &rpc.UpdateLibrariesIndexRequest{Instance: inst, UpdateIfOlderThanSecs: int64(indexUpdateInterval.Seconds())}
-	if err := srv.UpdateLibrariesIndex(req, stream); err != nil {
-		slog.Warn("error updating library index, skipping", slog.String("error", err.Error()))
+// AddSketchLocalLibrary adds to the sketch a library that is not in the library index,
+// either a folder or a zip archive. Zip archives are extracted, and folders outside the app
+// are copied, in the libraries folder of the app, so that the app stays self-contained.
+// A relative libPath is resolved against the app folder.
+func AddSketchLocalLibrary(ctx context.Context, app app.ArduinoApp, libPath *paths.Path) (LibraryReleaseID, error) {
+	if err := app.CheckWritable(); err != nil {
+		return LibraryReleaseID{}, err
+	}
+	if !libPath.IsAbs() {
+		libPath = app.FullPath.JoinPath(libPath)
+	}
+	if libPath.NotExist() {
+		return LibraryReleaseID{}, fmt.Errorf("%w: %s does not exist", ErrInvalidLibrary, libPath)
 	}
 
-	resp, err := srv.ProfileLibAdd(ctx, &rpc.ProfileLibAddRequest{
-		Instance:   inst,
-		SketchPath: app.MainSketchPath.String(),
-		Library: &rpc.SketchProfileLibraryReference{
-			Library: &rpc.SketchProfileLibraryReference_IndexLibrary_{
-				IndexLibrary: &rpc.SketchProfileLibraryReference_IndexLibrary{
-					Name:    libRef.Name,
-					Version: libRef.Version,
+	libDir := libPath
+	if !libPath.IsDir() {
+		if !strings.EqualFold(libPath.Ext(), ".zip") {
+			return LibraryReleaseID{}, fmt.Errorf("%w: %s is neither a folder nor a zip archive", ErrInvalidLibrary, libPath)
+		}
+		extracted, err := vendorZipLibrary(ctx, app, libPath)
+		if err != nil {
+			return LibraryReleaseID{}, err
+		}
+		libDir = extracted
+	} else if !isInsideApp(app, libDir) {
+		if !isLibraryDir(libDir) {
+			return LibraryReleaseID{}, fmt.Errorf("%w: %s does not contain a library", ErrInvalidLibrary, libDir)
+		}
+		dst := app.FullPath.Join(vendoredLibrariesDir, libDir.Base())
+		if dst.Exist() {
+			return LibraryReleaseID{}, fmt.Errorf("%w: %s already exists", ErrInvalidLibrary, dst)
+		}
+		if err := dst.Parent().MkdirAll(); err != nil {
+			return LibraryReleaseID{}, err
+		}
+		if err := libDir.CopyDirTo(dst); err != nil {
+			return LibraryReleaseID{}, fmt.Errorf("unable to copy the library into the app: %w", err)
+		}
+		libDir = dst
+	}
+	if !isLibraryDir(libDir) {
+		return LibraryReleaseID{}, fmt.Errorf("%w: %s does not contain a library", ErrInvalidLibrary, libDir)
+	}
+
+	rel, err := app.MainSketchPath.RelTo(libDir)
+	if err != nil {
+		return LibraryReleaseID{}, err
+	}
+	libRef := LibraryReleaseID{Name: libDir.Base(), Path: filepath.ToSlash(rel.String())}
+
+	current, err := ListSketchLibraries(ctx, app)
+	if err != nil {
+		return LibraryReleaseID{}, err
+	}
+	for _, l := range current {
+		if l.Path == libRef.Path {
+			return libRef, nil
+		}
+	}
+
+	err = arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		_, err := s.Server.ProfileLibAdd(ctx, &rpc.ProfileLibAddRequest{
+			Instance:   s.Instance,
+			SketchPath: app.MainSketchPath.String(),
+			Library: &rpc.SketchProfileLibraryReference{
+				Library: &rpc.SketchProfileLibraryReference_LocalLibrary_{
+					LocalLibrary: &rpc.SketchProfileLibraryReference_LocalLibrary{Path: libRef.Path},
 				},
 			},
-		},
-		AddDependencies: &addDeps,
+		})
+		return err
 	})
+	if err != nil {
+		return LibraryReleaseID{}, err
+	}
+	return libRef, nil
+}
+
+// vendorZipLibrary extracts a zip archive containing a library in the libraries folder of the app.
+func vendorZipLibrary(ctx context.Context, app app.ArduinoApp, zipPath *paths.Path) (*paths.Path, error) {
+	archive, err := zipPath.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	librariesDir := app.FullPath.Join(vendoredLibrariesDir)
+	if err := librariesDir.MkdirAll(); err != nil {
+		return nil, err
+	}
+	tmpDir, err := paths.MkTempDir(librariesDir.String(), ".extract-")
 	if err != nil {
 		return nil, err
 	}
+	defer func() { _ = tmpDir.RemoveAll() }()
+	if err := extract.Zip(ctx, archive, tmpDir.String(), nil); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidLibrary, err)
+	}
 
-	return f.Map(resp.GetAddedLibraries(), rpcProfileLibReferenceToLibReleaseID), nil
+	// Library archives usually contain a single folder named after the library.
+	root, name := tmpDir, strings.TrimSuffix(zipPath.Base(), zipPath.Ext())
+	if entries, err := tmpDir.ReadDir(); err == nil {
+		entries.FilterOutPrefix(".", "__MACOSX")
+		if len(entries) == 1 && entries[0].IsDir() {
+			root, name = entries[0], entries[0].Base()
+		}
+	}
+	if !isLibraryDir(root) {
+		return nil, fmt.Errorf("%w: %s does not contain a library", ErrInvalidLibrary, zipPath)
+	}
+	dst := librariesDir.Join(name)
+	if dst.Exist() {
+		return nil, fmt.Errorf("%w: %s already exists", ErrInvalidLibrary, dst)
+	}
+	if err := root.Rename(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// isLibraryDir reports whether dir looks like the root folder of an Arduino library.
+func isLibraryDir(dir *paths.Path) bool {
+	if dir.Join("library.properties").Exist() || dir.Join("src").IsDir() {
+		return true
+	}
+	files, err := dir.ReadDir()
+	if err != nil {
+		return false
+	}
+	files.FilterSuffix(".h", ".hpp")
+	return len(files) > 0
+}
+
+func isInsideApp(app app.ArduinoApp, path *paths.Path) bool {
+	rel, err := app.FullPath.RelTo(path)
+	return err == nil && rel.String() != ".." && !strings.HasPrefix(filepath.ToSlash(rel.String()), "../")
 }
 
 func RemoveSketchLibrary(ctx context.Context, app app.ArduinoApp, libRef LibraryReleaseID) (LibraryReleaseID, error) {
-	srv := commands.NewArduinoCoreServer()
-	var inst *rpc.Instance
-	if res, err := srv.Create(ctx, &rpc.CreateRequest{}); err != nil {
-		return LibraryReleaseID{}, err
-	} else {
-		inst = res.Instance
-	}
-	defer func() { _, _ = srv.Destroy(ctx, &rpc.DestroyRequest{Instance: inst}) }()
-	if err := srv.Init(&rpc.InitRequest{
-		Instance: inst,
-	}, commands.InitStreamResponseToCallbackFunction(ctx, func(r *rpc.InitResponse) error {
-		// TODO: LOG progress/error?
-		return nil
-	})); err != nil {
+	if err := app.CheckWritable(); err != nil {
 		return LibraryReleaseID{}, err
 	}
-
-	resp, err := srv.ProfileLibRemove(ctx, &rpc.ProfileLibRemoveRequest{
-		Library: &rpc.SketchProfileLibraryReference{
-			Library: &rpc.SketchProfileLibraryReference_IndexLibrary_{
-				IndexLibrary: &rpc.SketchProfileLibraryReference_IndexLibrary{
-					Name: libRef.Name,
-				},
+	ref := &rpc.SketchProfileLibraryReference{
+		Library: &rpc.SketchProfileLibraryReference_IndexLibrary_{
+			IndexLibrary: &rpc.SketchProfileLibraryReference_IndexLibrary{
+				Name: libRef.Name,
 			},
 		},
-		SketchPath: app.MainSketchPath.String(),
+	}
+	// Libraries not in the index are removed by their folder.
+	if libs, err := ListSketchLibraries(ctx, app); err == nil {
+		for _, l := range libs {
+			if l.Path != "" && (l.Name == libRef.Name || l.Path == libRef.Path) {
+				ref = &rpc.SketchProfileLibraryReference{
+					Library: &rpc.SketchProfileLibraryReference_LocalLibrary_{
+						LocalLibrary: &rpc.SketchProfileLibraryReference_LocalLibrary{Path: l.Path},
+					},
+				}
+				break
+			}
+		}
+	}
+	var removed LibraryReleaseID
+	err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		resp, err := s.Server.ProfileLibRemove(ctx, &rpc.ProfileLibRemoveRequest{
+			Library:    ref,
+			SketchPath: app.MainSketchPath.String(),
+		})
+		if err != nil {
+			return err
+		}
+		removed = rpcProfileLibReferenceToLibReleaseID(resp.GetLibrary())
+		return nil
 	})
 	if err != nil {
 		return LibraryReleaseID{}, err
 	}
-	return rpcProfileLibReferenceToLibReleaseID(resp.GetLibrary()), nil
+	return removed, nil
 }
 
+// ListSketchLibraries lists the libraries of the sketch profile, both the ones of the library
+// index and the ones referenced by their folder.
 func ListSketchLibraries(ctx context.Context, app app.ArduinoApp) ([]LibraryReleaseID, error) {
-	srv := commands.NewArduinoCoreServer()
-
-	resp, err := srv.ProfileLibList(ctx, &rpc.ProfileLibListRequest{
+	resp, err := arduinocli.Shared().Server().ProfileLibList(ctx, &rpc.ProfileLibListRequest{
 		SketchPath: app.MainSketchPath.String(),
 	})
 	if err != nil {
 		return nil, err
 	}
-
-	// Keep only index libraries
-	libs := f.Filter(resp.Libraries, func(l *rpc.SketchProfileLibraryReference) bool {
-		return l.GetIndexLibrary() != nil
-	})
-	res := f.Map(libs, func(l *rpc.SketchProfileLibraryReference) LibraryReleaseID {
-		return LibraryReleaseID{
-			Name:    l.GetIndexLibrary().GetName(),
-			Version: l.GetIndexLibrary().GetVersion(),
-		}
-	})
-	return res, nil
+	return f.Map(resp.GetLibraries(), rpcProfileLibReferenceToLibReleaseID), nil
 }
 
 func rpcProfileLibReferenceToLibReleaseID(ref *rpc.SketchProfileLibraryReference) LibraryReleaseID {
+	if l := ref.GetLocalLibrary(); l != nil {
+		return LibraryReleaseID{Name: filepath.Base(l.GetPath()), Path: l.GetPath()}
+	}
 	l := ref.GetIndexLibrary()
 	return NewLibraryReleaseID(l.GetName(), l.GetVersion())
 }
@@ -0,0 +1,501 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"go.bug.st/f"
+	semver "go.bug.st/relaxed-semver"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
+)
+
+// ErrLibraryNotFound is returned when a library is not in the local library index.
+var ErrLibraryNotFound = errors.New("library not found")
+
+// LibraryInfo describes a library of the library index, as of its latest release.
+type LibraryInfo struct {
+	Name          string              `json:"name" required:"true"`
+	Version       string              `json:"version" required:"true" description:"the latest version of the library"`
+	Versions      []string            `json:"versions,omitempty" description:"all the versions of the library, oldest first"`
+	Author        string              `json:"author,omitempty"`
+	Maintainer    string              `json:"maintainer,omitempty"`
+	Sentence      string              `json:"sentence,omitempty"`
+	Paragraph     string              `json:"paragraph,omitempty"`
+	Website       string              `json:"website,omitempty"`
+	Category      string              `json:"category,omitempty"`
+	License       string              `json:"license,omitempty"`
+	Architectures []string            `json:"architectures,omitempty"`
+	Types         []string            `json:"types,omitempty"`
+	Includes      []string            `json:"includes,omitempty"`
+	Dependencies  []LibraryDependency `json:"dependencies,omitempty"`
+}
+
+// LibraryDependency is a dependency declared by a library release.
+type LibraryDependency struct {
+	Name              string `json:"name" required:"true"`
+	VersionConstraint string `json:"version_constraint,omitempty"`
+}
+
+// LibraryDependencyNode is a library release of a dependency tree, along with the
+// releases it depends on. A library already listed higher in the tree is not expanded again.
+type LibraryDependencyNode struct {
+	Name         string                  `json:"name" required:"true"`
+	Version      string                  `json:"version" required:"true"`
+	Dependencies []LibraryDependencyNode `json:"dependencies,omitempty"`
+}
+
+// LibraryUpgrade is a version change of a sketch library. FromVersion is empty for the
+// dependencies added to the sketch by the upgrade.
+type LibraryUpgrade struct {
+	Name        string `json:"name" required:"true"`
+	FromVersion string `json:"from_version" required:"true" description:"the current version, empty for the dependencies added by the upgrade"`
+	ToVersion   string `json:"to_version" required:"true"`
+}
+
+// SearchLibraries searches the local library index, so that it works offline. The query
+// supports the qualifiers of arduino-cli (e.g. "author:arduino" or "name=Servo").
+func SearchLibraries(ctx context.Context, query string) ([]LibraryInfo, error) {
+	var res []LibraryInfo
+	err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		if err := ensureLibrariesIndex(ctx, s); err != nil {
+			return err
+		}
+		resp, err := s.Server.LibrarySearch(ctx, &rpc.LibrarySearchRequest{
+			Instance:            s.Instance,
+			SearchArgs:          query,
+			OmitReleasesDetails: true,
+		})
+		if err != nil {
+			return err
+		}
+		res = f.Map(resp.GetLibraries(), rpcSearchedLibraryToLibraryInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetLibraryDetails returns the details of a library of the local library index.
+func GetLibraryDetails(ctx context.Context, name string) (LibraryInfo, error) {
+	var res LibraryInfo
+	err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		if err := ensureLibrariesIndex(ctx, s); err != nil {
+			return err
+		}
+		lib, err := findIndexLibrary(ctx, s, name)
+		if err != nil {
+			return err
+		}
+		res = rpcSearchedLibraryToLibraryInfo(lib)
+		return nil
+	})
+	return res, err
+}
+
+// GetLibraryDependencyTree resolves the dependencies of a library release, as they would be
+// added to a sketch, without changing anything. If the version is empty the latest is used.
+func GetLibraryDependencyTree(ctx context.Context, libRef LibraryReleaseID) (LibraryDependencyNode, error) {
+	var res LibraryDependencyNode
+	err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		if err := ensureLibrariesIndex(ctx, s); err != nil {
+			return err
+		}
+		root, err := findIndexLibrary(ctx, s, libRef.Name)
+		if err != nil {
+			return err
+		}
+		version := libRef.Version
+		if version == "" {
+			version = root.GetLatest().GetVersion()
+		}
+		if _, ok := root.GetReleases()[version]; !ok {
+			return fmt.Errorf("%w: %s", ErrLibraryNotFound, libRef)
+		}
+
+		resp, err := s.Server.LibraryResolveDependencies(ctx, &rpc.LibraryResolveDependenciesRequest{
+			Instance: s.Instance,
+			Name:     root.GetName(),
+			Version:  version,
+		})
+		if err != nil {
+			return err
+		}
+		resolved := map[string]string{}
+		for _, dep := range resp.GetDependencies() {
+			resolved[dep.GetName()] = dep.GetVersionRequired()
+		}
+		resolved[root.GetName()] = version
+
+		libs := map[string]*rpc.SearchedLibrary{root.GetName(): root}
+		var visit func(name string, parents []string) (LibraryDependencyNode, error)
+		visit = func(name string, parents []string) (LibraryDependencyNode, error) {
+			node := LibraryDependencyNode{Name: name, Version: resolved[name]}
+			lib, ok := libs[name]
+			if !ok {
+				var err error
+				if lib, err = findIndexLibrary(ctx, s, name); err != nil {
+					return node, err
+				}
+				libs[name] = lib
+			}
+			release, ok := lib.GetReleases()[node.Version]
+			if !ok {
+				return node, nil
+			}
+			parents = append(parents, name)
+			for _, dep := range release.GetDependencies() {
+				if containsFold(parents, dep.GetName()) {
+					continue
+				}
+				child, err := visit(dep.GetName(), parents)
+				if err != nil {
+					return node, err
+				}
+				node.Dependencies = append(node.Dependencies, child)
+			}
+			return node, nil
+		}
+		res, err = visit(root.GetName(), nil)
+		return err
+	})
+	return res, err
+}
+
+// UpgradeSketchLibraries moves every index library of the sketch profile to its latest
+// version, along with the dependencies of the new releases. The upgrades breaking the
+// dependencies of the other libraries are held back. With dryRun the sketch.yaml is left
+// untouched and only the changes are reported.
+func UpgradeSketchLibraries(ctx context.Context, app app.ArduinoApp, dryRun bool) ([]LibraryUpgrade, error) {
+	if app.MainSketchPath == nil {
+		return nil, ErrAppHasNoSketch
+	}
+	if !dryRun {
+		if err := app.CheckWritable(); err != nil {
+			return nil, err
+		}
+	}
+	current, err := ListSketchLibraries(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrades := []LibraryUpgrade{}
+	err = arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		if err := updateLibrariesIndex(ctx, s); err != nil {
+			return err
+		}
+		var indexLibs []LibraryReleaseID
+		latest := map[string]string{}
+		libs := map[string]*rpc.SearchedLibrary{}
+		for _, lib := range current {
+			if lib.Path != "" {
+				// Libraries referenced by their folder are not versioned.
+				continue
+			}
+			indexLibs = append(indexLibs, lib)
+			indexLib, err := findIndexLibrary(ctx, s, lib.Name)
+			if errors.Is(err, ErrLibraryNotFound) {
+				slog.Warn("sketch library not found in the library index", slog.String("library", lib.Name))
+				continue
+			} else if err != nil {
+				return err
+			}
+			libs[lib.Name] = indexLib
+			if version := indexLib.GetLatest().GetVersion(); isNewerLibraryVersion(version, lib.Version) {
+				latest[lib.Name] = version
+			}
+		}
+
+		resolve := func(name, version string) ([]LibraryReleaseID, error) {
+			resp, err := s.Server.LibraryResolveDependencies(ctx, &rpc.LibraryResolveDependenciesRequest{
+				Instance: s.Instance,
+				Name:     name,
+				Version:  version,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return f.Map(resp.GetDependencies(), func(d *rpc.LibraryDependencyStatus) LibraryReleaseID {
+				return NewLibraryReleaseID(d.GetName(), d.GetVersionRequired())
+			}), nil
+		}
+		dependencies := func(name, version string) ([]LibraryDependency, error) {
+			lib, ok := libs[name]
+			if !ok {
+				var err error
+				if lib, err = findIndexLibrary(ctx, s, name); err != nil && !errors.Is(err, ErrLibraryNotFound) {
+					return nil, err
+				}
+				libs[name] = lib
+			}
+			return f.Map(lib.GetReleases()[version].GetDependencies(), func(d *rpc.LibraryDependency) LibraryDependency {
+				return LibraryDependency{Name: d.GetName(), VersionConstraint: d.GetVersionConstraint()}
+			}), nil
+		}
+		upgrades, err = planLibraryUpgrades(indexLibs, latest, resolve, dependencies)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			return nil
+		}
+		// The dependencies are part of the upgrades: arduino-cli must not add other versions.
+		addDeps := false
+		for _, upgrade := range upgrades {
+			if _, err := s.Server.ProfileLibAdd(ctx, &rpc.ProfileLibAddRequest{
+				Instance:        s.Instance,
+				SketchPath:      app.MainSketchPath.String(),
+				AddDependencies: &addDeps,
+				Library: &rpc.SketchProfileLibraryReference{
+					Library: &rpc.SketchProfileLibraryReference_IndexLibrary_{
+						IndexLibrary: &rpc.SketchProfileLibraryReference_IndexLibrary{
+							Name:    upgrade.Name,
+							Version: upgrade.ToVersion,
+						},
+					},
+				},
+			}); err != nil {
+				return fmt.Errorf("unable to upgrade %s to %s: %w", upgrade.Name, upgrade.ToVersion, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return upgrades, nil
+}
+
+// planLibraryUpgrades moves the given libraries to their latest version, adding the
+// dependencies that their new releases need, as resolved by arduino-cli. The upgrades whose
+// dependencies cannot be resolved, or breaking the dependencies of another library, are held
+// back to the current version.
+func planLibraryUpgrades(
+	current []LibraryReleaseID,
+	latest map[string]string,
+	resolve func(name, version string) ([]LibraryReleaseID, error),
+	dependencies func(name, version string) ([]LibraryDependency, error),
+) ([]LibraryUpgrade, error) {
+	held := map[string]bool{}
+	for {
+		versions := map[string]string{}
+		// cause maps the changed libraries to the upgrade changing them.
+		cause := map[string]string{}
+		var added []string
+		for _, lib := range current {
+			versions[lib.Name] = lib.Version
+			if version, ok := latest[lib.Name]; ok && !held[lib.Name] {
+				versions[lib.Name] = version
+				cause[lib.Name] = lib.Name
+			}
+		}
+
+		hold := ""
+		for _, lib := range current {
+			if cause[lib.Name] != lib.Name {
+				continue
+			}
+			deps, err := resolve(lib.Name, versions[lib.Name])
+			if err != nil {
+				slog.Warn("unable to resolve the dependencies of the library, not upgrading it",
+					slog.String("library", lib.Name), slog.String("version", versions[lib.Name]), slog.String("error", err.Error()))
+				hold = lib.Name
+				break
+			}
+			for _, dep := range deps {
+				if _, ok := versions[dep.Name]; !ok {
+					versions[dep.Name] = dep.Version
+					cause[dep.Name] = lib.Name
+					added = append(added, dep.Name)
+				}
+			}
+		}
+
+		if hold == "" {
+			names := append(f.Map(current, func(l LibraryReleaseID) string { return l.Name }), added...)
+			var err error
+			if hold, err = brokenLibraryUpgrade(names, versions, cause, dependencies); err != nil {
+				return nil, err
+			}
+		}
+		if hold != "" {
+			held[hold] = true
+			continue
+		}
+
+		upgrades := []LibraryUpgrade{}
+		for _, lib := range current {
+			if versions[lib.Name] != lib.Version {
+				upgrades = append(upgrades, LibraryUpgrade{Name: lib.Name, FromVersion: lib.Version, ToVersion: versions[lib.Name]})
+			}
+		}
+		for _, name := range added {
+			upgrades = append(upgrades, LibraryUpgrade{Name: name, ToVersion: versions[name]})
+		}
+		return upgrades, nil
+	}
+}
+
+// brokenLibraryUpgrade checks the dependencies of the libraries against the planned versions
+// and returns the upgrade breaking one of them, if any.
+func brokenLibraryUpgrade(
+	names []string,
+	versions map[string]string,
+	cause map[string]string,
+	dependencies func(name, version string) ([]LibraryDependency, error),
+) (string, error) {
+	for _, name := range names {
+		deps, err := dependencies(name, versions[name])
+		if err != nil {
+			return "", err
+		}
+		for _, dep := range deps {
+			version, ok := versions[dep.Name]
+			if !ok || satisfiesConstraint(version, dep.VersionConstraint) {
+				continue
+			}
+			upgrade, ok := cause[dep.Name]
+			if !ok {
+				upgrade, ok = cause[name]
+			}
+			if !ok {
+				// The sketch was already inconsistent: nothing to hold back.
+				continue
+			}
+			slog.Warn("library upgrade breaks a dependency, not upgrading it", slog.String("library", upgrade),
+				slog.String("dependency", dep.Name+" "+dep.VersionConstraint), slog.String("required_by", name))
+			return upgrade, nil
+		}
+	}
+	return "", nil
+}
+
+// satisfiesConstraint reports if the version matches the constraint of a library dependency.
+// Versions and constraints that cannot be parsed are considered satisfied.
+func satisfiesConstraint(version, constraint string) bool {
+	c, err := semver.ParseConstraint(constraint)
+	if err != nil {
+		return true
+	}
+	v, err := semver.Parse(version)
+	if err != nil {
+		return true
+	}
+	return c.Match(v)
+}
+
+// updateLibrariesIndex downloads the library index if it is older than indexUpdateInterval.
+// A failed download is not an error: the local index is used.
+func updateLibrariesIndex(ctx context.Context, s *arduinocli.Session) error {
+	return updateLibrariesIndexIfOlderThan(ctx, s, int64(indexUpdateInterval.Seconds()))
+}
+
+// ensureLibrariesIndex downloads the library index only if it was never downloaded, so
+// that the operations reading the index do not depend on the network.
+func ensureLibrariesIndex(ctx context.Context, s *arduinocli.Session) error {
+	return updateLibrariesIndexIfOlderThan(ctx, s, math.MaxInt64)
+}
+
+func updateLibrariesIndexIfOlderThan(ctx context.Context, s *arduinocli.Session, secs int64) error {
+	stream, getResult := commands.UpdateLibrariesIndexStreamResponseToCallbackFunction(ctx, func(curr *rpc.DownloadProgress) {
+		slog.Debug("downloading library index", "progress", curr.GetMessage())
+	})
+	req := &rpc.UpdateLibrariesIndexRequest{Instance: s.Instance, UpdateIfOlderThanSecs: secs}
+	if err := s.Server.UpdateLibrariesIndex(req, stream); err != nil {
+		slog.Warn("error updating library index, skipping", slog.String("error", err.Error()))
+		return nil
+	}
+	if getResult().GetLibrariesIndex().GetStatus() == rpc.IndexUpdateReport_STATUS_UPDATED {
+		return s.Reinit(ctx)
+	}
+	return nil
+}
+
+// findIndexLibrary looks up a library of the index by its exact name, ignoring the case.
+func findIndexLibrary(ctx context.Context, s *arduinocli.Session, name string) (*rpc.SearchedLibrary, error) {
+	quoted := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(name)
+	resp, err := s.Server.LibrarySearch(ctx, &rpc.LibrarySearchRequest{
+		Instance:   s.Instance,
+		SearchArgs: `name="` + quoted + `"`,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, lib := range resp.GetLibraries() {
+		if strings.EqualFold(lib.GetName(), name) {
+			return lib, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrLibraryNotFound, name)
+}
+
+func isNewerLibraryVersion(candidate, current string) bool {
+	c, err := semver.Parse(candidate)
+	if err != nil {
+		return false
+	}
+	if current == "" {
+		return true
+	}
+	v, err := semver.Parse(current)
+	if err != nil {
+		return true
+	}
+	return c.GreaterThan(v)
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func rpcSearchedLibraryToLibraryInfo(lib *rpc.SearchedLibrary) LibraryInfo {
+	latest := lib.GetLatest()
+	return LibraryInfo{
+		Name:          lib.GetName(),
+		Version:       latest.GetVersion(),
+		Versions:      lib.GetAvailableVersions(),
+		Author:        latest.GetAuthor(),
+		Maintainer:    latest.GetMaintainer(),
+		Sentence:      latest.GetSentence(),
+		Paragraph:     latest.GetParagraph(),
+		Website:       latest.GetWebsite(),
+		Category:      latest.GetCategory(),
+		License:       latest.GetLicense(),
+		Architectures: latest.GetArchitectures(),
+		Types:         latest.GetTypes(),
+		Includes:      latest.GetProvidesIncludes(),
+		Dependencies: f.Map(latest.GetDependencies(), func(d *rpc.LibraryDependency) LibraryDependency {
+			return LibraryDependency{Name: d.GetName(), VersionConstraint: d.GetVersionConstraint()}
+		}),
+	}
+}
@@ -0,0 +1,84 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsNewerLibraryVersion(t *testing.T) {
+	require.True(t, isNewerLibraryVersion("1.2.0", "1.1.9"))
+	require.True(t, isNewerLibraryVersion("1.10.0", "1.9.0"))
+	require.True(t, isNewerLibraryVersion("1.0.0", ""))
+	require.False(t, isNewerLibraryVersion("1.2.0", "1.2.0"))
+	require.False(t, isNewerLibraryVersion("1.2", "1.2.0"))
+	require.False(t, isNewerLibraryVersion("1.1.0", "1.2.0"))
+	require.False(t, isNewerLibraryVersion("", "1.2.0"))
+}
+
+func TestPlanLibraryUpgrades(t *testing.T) {
+	// releases maps the library releases to their dependencies.
+	releases := map[string][]LibraryDependency{
+		"A@2.0.0": {{Name: "B", VersionConstraint: ">=2.0.0"}, {Name: "C"}},
+		"B@1.0.0": nil,
+		"B@2.0.0": nil,
+		"C@1.0.0": nil,
+		"D@1.0.0": {{Name: "B", VersionConstraint: "<2.0.0"}},
+		"E@2.0.0": {{Name: "Missing"}},
+	}
+	dependencies := func(name, version string) ([]LibraryDependency, error) {
+		return releases[name+"@"+version], nil
+	}
+	resolve := func(name, version string) ([]LibraryReleaseID, error) {
+		res := []LibraryReleaseID{NewLibraryReleaseID(name, version)}
+		for _, dep := range releases[name+"@"+version] {
+			if dep.Name == "Missing" {
+				return nil, ErrLibraryNotFound
+			}
+			latest := map[string]string{"B": "2.0.0", "C": "1.0.0"}
+			res = append(res, NewLibraryReleaseID(dep.Name, latest[dep.Name]))
+		}
+		return res, nil
+	}
+
+	t.Run("dependencies are added", func(t *testing.T) {
+		current := []LibraryReleaseID{NewLibraryReleaseID("A", "1.0.0"), NewLibraryReleaseID("B", "1.0.0")}
+		upgrades, err := planLibraryUpgrades(current, map[string]string{"A": "2.0.0", "B": "2.0.0"}, resolve, dependencies)
+		require.NoError(t, err)
+		require.Equal(t, []LibraryUpgrade{
+			{Name: "A", FromVersion: "1.0.0", ToVersion: "2.0.0"},
+			{Name: "B", FromVersion: "1.0.0", ToVersion: "2.0.0"},
+			{Name: "C", ToVersion: "1.0.0"},
+		}, upgrades)
+	})
+
+	t.Run("upgrades breaking a dependency are held back", func(t *testing.T) {
+		// D needs B before 2.0.0, that A 2.0.0 needs.
+		current := []LibraryReleaseID{NewLibraryReleaseID("A", "1.0.0"), NewLibraryReleaseID("B", "1.0.0"), NewLibraryReleaseID("D", "1.0.0")}
+		upgrades, err := planLibraryUpgrades(current, map[string]string{"A": "2.0.0", "B": "2.0.0"}, resolve, dependencies)
+		require.NoError(t, err)
+		require.Empty(t, upgrades)
+	})
+
+	t.Run("upgrades with unresolved dependencies are held back", func(t *testing.T) {
+		current := []LibraryReleaseID{NewLibraryReleaseID("B", "1.0.0"), NewLibraryReleaseID("E", "1.0.0")}
+		upgrades, err := planLibraryUpgrades(current, map[string]string{"B": "2.0.0", "E": "2.0.0"}, resolve, dependencies)
+		require.NoError(t, err)
+		require.Equal(t, []LibraryUpgrade{{Name: "B", FromVersion: "1.0.0", ToVersion: "2.0.0"}}, upgrades)
+	})
+}
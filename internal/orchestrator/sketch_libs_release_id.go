@@ -25,10 +25,13 @@ import (
 
 // LibraryReleaseID represents a library release identifier in the form of:
 // - name[@version]
+// - name@dir:path, for the libraries that are not in the library index
 // Version is optional, if not provided, the latest version available will be used.
 type LibraryReleaseID struct {
 	Name    string
 	Version string
+	// Path is the folder of a library not in the library index, relative to the sketch.
+	Path string
 }
 
 func NewLibraryReleaseID(name string, version string) LibraryReleaseID {
@@ -49,6 +52,12 @@ func ParseLibraryReleaseID(s string) (LibraryReleaseID, error) {
 	if split[1] == "" {
 		return LibraryReleaseID{}, fmt.Errorf("missing version")
 	}
+	if path, ok := strings.CutPrefix(split[1], "dir:"); ok {
+		if path == "" {
+			return LibraryReleaseID{}, fmt.Errorf("missing library path")
+		}
+		return LibraryReleaseID{Name: split[0], Path: path}, nil
+	}
 	if _, err := semver.Parse(split[1]); err != nil {
 		return LibraryReleaseID{}, err
 	}
@@ -57,6 +66,9 @@ func ParseLibraryReleaseID(s string) (LibraryReleaseID, error) {
 }
 
 func (l LibraryReleaseID) String() string {
+	if l.Path != "" {
+		return l.Name + "@dir:" + l.Path
+	}
 	if l.Version == "" {
 		return l.Name
 	}
@@ -0,0 +1,43 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLibraryReleaseID(t *testing.T) {
+	for _, libRef := range []LibraryReleaseID{
+		{Name: "Arduino_RouterBridge"},
+		{Name: "Arduino_RouterBridge", Version: "0.1.0"},
+		{Name: "MyLib", Path: "../libraries/MyLib"},
+	} {
+		t.Run(libRef.String(), func(t *testing.T) {
+			parsed, err := ParseLibraryReleaseID(libRef.String())
+			require.NoError(t, err)
+			require.Equal(t, libRef, parsed)
+		})
+	}
+
+	for _, s := range []string{"MyLib@", "MyLib@dir:", "MyLib@not-a-version"} {
+		t.Run(s, func(t *testing.T) {
+			_, err := ParseLibraryReleaseID(s)
+			require.Error(t, err)
+		})
+	}
+}
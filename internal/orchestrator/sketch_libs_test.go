@@ -0,0 +1,119 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+func TestSketchLocalLibraries(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "Local libs"}, idProvider, &bricksindex.BricksIndex{}, cfg)
+	require.NoError(t, err)
+	userApp := f.Must(app.Load(resp.ID.ToPath().String(), cfg))
+
+	// A vendored zip archive is extracted in the libraries folder of the app.
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for _, name := range []string{"MyLib/library.properties", "MyLib/src/MyLib.h"} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("// " + name + "\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	require.NoError(t, userApp.FullPath.Join("vendor").MkdirAll())
+	require.NoError(t, userApp.FullPath.Join("vendor", "MyLib.zip").WriteFile(archive.Bytes()))
+
+	lib, err := AddSketchLocalLibrary(t.Context(), userApp, paths.New("vendor", "MyLib.zip"))
+	require.NoError(t, err)
+	require.Equal(t, LibraryReleaseID{Name: "MyLib", Path: "../libraries/MyLib"}, lib)
+	require.Equal(t, "MyLib@dir:../libraries/MyLib", lib.String())
+	require.FileExists(t, userApp.FullPath.Join("libraries", "MyLib", "src", "MyLib.h").String())
+
+	// Adding it again does not duplicate it.
+	lib, err = AddSketchLocalLibrary(t.Context(), userApp, paths.New("libraries", "MyLib"))
+	require.NoError(t, err)
+	require.Equal(t, "../libraries/MyLib", lib.Path)
+
+	// A folder outside the app is copied in the app.
+	external := paths.New(t.TempDir(), "OtherLib")
+	require.NoError(t, external.MkdirAll())
+	require.NoError(t, external.Join("OtherLib.h").WriteFile([]byte("#pragma once\n")))
+	lib, err = AddSketchLocalLibrary(t.Context(), userApp, external)
+	require.NoError(t, err)
+	require.Equal(t, LibraryReleaseID{Name: "OtherLib", Path: "../libraries/OtherLib"}, lib)
+
+	// Folders without a library are rejected.
+	require.NoError(t, userApp.FullPath.Join("empty").MkdirAll())
+	_, err = AddSketchLocalLibrary(t.Context(), userApp, paths.New("empty"))
+	require.ErrorIs(t, err, ErrInvalidLibrary)
+
+	libs, err := ListSketchLibraries(t.Context(), userApp)
+	require.NoError(t, err)
+	require.Contains(t, libs, LibraryReleaseID{Name: "MyLib", Path: "../libraries/MyLib"})
+	require.Contains(t, libs, LibraryReleaseID{Name: "OtherLib", Path: "../libraries/OtherLib"})
+
+	removed, err := RemoveSketchLibrary(t.Context(), userApp, LibraryReleaseID{Name: "OtherLib"})
+	require.NoError(t, err)
+	require.Equal(t, "OtherLib@dir:../libraries/OtherLib", removed.String())
+	libs, err = ListSketchLibraries(t.Context(), userApp)
+	require.NoError(t, err)
+	require.NotContains(t, libs, LibraryReleaseID{Name: "OtherLib", Path: "../libraries/OtherLib"})
+
+	t.Run("export bundles libraries outside the app", func(t *testing.T) {
+		outside := paths.New(t.TempDir(), "FarLib")
+		require.NoError(t, outside.MkdirAll())
+		require.NoError(t, outside.Join("FarLib.h").WriteFile([]byte("#pragma once\n")))
+		sketchYaml := userApp.MainSketchPath.Join("sketch.yaml")
+		content := f.Must(sketchYaml.ReadFile())
+		content = bytes.Replace(content, []byte("    libraries:\n"), []byte("    libraries:\n      - dir: "+outside.String()+"\n"), 1)
+		require.NoError(t, sketchYaml.WriteFile(content))
+
+		var bundle bytes.Buffer
+		manifest, err := ExportApp(t.Context(), userApp, ExportAppRequest{Format: BundleFormatZip}, &bundle, cfg)
+		require.NoError(t, err)
+		require.Contains(t, manifest.SketchLibraries, "FarLib@dir:../libraries/FarLib")
+		require.Contains(t, manifest.SketchLibraries, "MyLib@dir:../libraries/MyLib")
+
+		zr, err := zip.NewReader(bytes.NewReader(bundle.Bytes()), int64(bundle.Len()))
+		require.NoError(t, err)
+		files := map[string]*zip.File{}
+		for _, file := range zr.File {
+			files[file.Name] = file
+		}
+		require.Contains(t, files, "app/libraries/FarLib/FarLib.h")
+		require.Contains(t, files, "app/libraries/MyLib/src/MyLib.h")
+		r, err := files["app/sketch/sketch.yaml"].Open()
+		require.NoError(t, err)
+		var bundled bytes.Buffer
+		_, err = bundled.ReadFrom(r)
+		require.NoError(t, err)
+		require.Contains(t, bundled.String(), "      - dir: ../libraries/FarLib\n")
+		require.NotContains(t, bundled.String(), outside.String())
+	})
+}
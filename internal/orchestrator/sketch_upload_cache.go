@@ -0,0 +1,235 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/arduino/go-paths-helper"
+
+	"github.com/arduino/arduino-app-cli/internal/fatomic"
+	"github.com/arduino/arduino-app-cli/internal/micro"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
+)
+
+const sketchUploadRecordFileName = "sketch-upload.json"
+
+// sketchUploadRecord describes the last sketch successfully uploaded to the MCU.
+type sketchUploadRecord struct {
+	Hash       string    `json:"hash"`
+	AppPath    string    `json:"app_path"`
+	FlashMode  string    `json:"flash_mode,omitempty"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+// matches reports whether the recorded upload is still on the MCU. Only a sketch uploaded
+// in flash persists: stopping an app resets the MCU, which then boots the empty flash image
+// left by an upload in RAM, so a sketch uploaded in RAM is always lost before the next start.
+func (r *sketchUploadRecord) matches(sketchHash, flashMode string) bool {
+	return flashMode == app.FlashModeFlash && r.FlashMode == app.FlashModeFlash && r.Hash == sketchHash
+}
+
+// reusableBuild reports whether the build of the recorded upload in RAM can be uploaded again
+// as is: the binary is still in the build folder of the app and matches the sketch.
+func (r *sketchUploadRecord) reusableBuild(appPath *paths.Path, sketchHash, flashMode string) bool {
+	return flashMode == app.FlashModeRAM && r.FlashMode == app.FlashModeRAM && r.Hash == sketchHash && r.AppPath == appPath.String()
+}
+
+// ensureSketchUploaded compiles and uploads the sketch of the app, unless the app is in flash
+// mode and the sketch sources, the sketch.yaml and the resolved library set match the last
+// successful upload to the MCU flash. In RAM mode the binary of the last upload is uploaded
+// again without compiling, when it matches the sketch.
+// It reports whether the upload actually happened, along with the build result if it compiled.
+func ensureSketchUploaded(ctx context.Context, userApp *app.ArduinoApp, cfg config.Configuration, forceUpload bool, w io.Writer) (bool, *SketchBuildResult, error) {
+	flashMode := userApp.Descriptor.SketchFlashMode()
+	sketchHash, err := hashSketch(ctx, userApp.MainSketchPath)
+	if err != nil {
+		slog.Warn("unable to hash the sketch, uploading it", slog.String("error", err.Error()))
+		sketchHash = ""
+	}
+
+	if !forceUpload && sketchHash != "" {
+		record := readSketchUploadRecord(cfg)
+		if record != nil && record.matches(sketchHash, flashMode) {
+			// The MCU is held in reset while the app is stopped: release it to run the sketch again.
+			if err := micro.Enable(); err != nil {
+				slog.Debug("unable to enable the micro, uploading the sketch", slog.String("error", err.Error()))
+			} else {
+				fmt.Fprintln(w, "Sketch unchanged since the last upload, skipping compile and upload")
+				return false, nil, nil
+			}
+		}
+		if record != nil && record.reusableBuild(userApp.FullPath, sketchHash, flashMode) && buildUnchangedSince(userApp.SketchBuildPath(), record.UploadedAt) {
+			fmt.Fprintln(w, "Sketch unchanged since the last upload, skipping compile")
+			if err := uploadSketchBuild(ctx, userApp, w); err != nil {
+				slog.Warn("unable to upload the previous build, compiling the sketch", slog.String("error", err.Error()))
+			} else {
+				return true, nil, nil
+			}
+		}
+	}
+
+	// Forget the previous upload first: a failed upload leaves the MCU in an unknown state.
+	clearSketchUploadRecord(cfg)
+	result, err := buildSketch(ctx, userApp, true, w)
+	if err != nil {
+		return true, result, err
+	}
+	if sketchHash != "" {
+		record := sketchUploadRecord{
+			Hash:       sketchHash,
+			AppPath:    userApp.FullPath.String(),
+			FlashMode:  flashMode,
+			UploadedAt: time.Now(),
+		}
+		if err := writeSketchUploadRecord(cfg, record); err != nil {
+			slog.Warn("unable to record the sketch upload", slog.String("error", err.Error()))
+		}
+	}
+	return true, result, nil
+}
+
+// uploadSketchBuild uploads in RAM the binary left in the build folder of the app by the last compile.
+func uploadSketchBuild(ctx context.Context, userApp *app.ArduinoApp, w io.Writer) error {
+	req, err := sketchProfileRequest(ctx, userApp, w)
+	if err != nil {
+		return err
+	}
+	return arduinocli.Shared().Run(ctx, req, func(s *arduinocli.Session) error {
+		return uploadSketchInRamOrConfigure(ctx, w, s.Server, s.Instance, userApp.MainSketchPath.String(), userApp.SketchBuildPath().String())
+	})
+}
+
+// buildUnchangedSince reports whether the build folder exists and none of its files has been
+// written after t, e.g. by a compile without upload.
+func buildUnchangedSince(buildPath *paths.Path, t time.Time) bool {
+	files, err := buildPath.ReadDir()
+	if err != nil {
+		return false
+	}
+	for _, file := range files {
+		info, err := file.Stat()
+		if err != nil || info.ModTime().After(t) {
+			return false
+		}
+	}
+	return len(files) > 0
+}
+
+// hashSketch returns a digest of the sketch folder (sources and sketch.yaml) and of the
+// libraries resolved by its default profile.
+func hashSketch(ctx context.Context, sketchPath *paths.Path) (string, error) {
+	h := sha256.New()
+	if err := hashTree(h, sketchPath.String()); err != nil {
+		return "", err
+	}
+
+	resp, err := arduinocli.Shared().Server().ProfileLibList(ctx, &rpc.ProfileLibListRequest{SketchPath: sketchPath.String()})
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintf(h, "profile %s\n", resp.GetProfileName())
+	for _, lib := range resp.GetLibraries() {
+		if indexLib := lib.GetIndexLibrary(); indexLib != nil {
+			fmt.Fprintf(h, "library %s@%s\n", indexLib.GetName(), indexLib.GetVersion())
+		}
+		if localLib := lib.GetLocalLibrary(); localLib != nil {
+			// Local libraries are not versioned: their content is part of the hash.
+			fmt.Fprintf(h, "local library %s\n", localLib.GetPath())
+			libPath := localLib.GetPath()
+			if !filepath.IsAbs(libPath) {
+				libPath = sketchPath.Join(libPath).String()
+			}
+			if err := hashTree(h, libPath); err != nil {
+				return "", err
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashTree writes the relative path and the content of every file below root to h,
+// skipping hidden files and folders.
+func hashTree(h hash.Hash, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		fmt.Fprintf(h, "file %s\n", filepath.ToSlash(rel))
+		_, err = io.Copy(h, file)
+		return err
+	})
+}
+
+func readSketchUploadRecord(cfg config.Configuration) *sketchUploadRecord {
+	content, err := cfg.DataDir().Join(sketchUploadRecordFileName).ReadFile()
+	if err != nil {
+		return nil
+	}
+	var record sketchUploadRecord
+	if err := json.Unmarshal(content, &record); err != nil {
+		slog.Warn("invalid sketch upload record", slog.String("error", err.Error()))
+		return nil
+	}
+	return &record
+}
+
+func writeSketchUploadRecord(cfg config.Configuration, record sketchUploadRecord) error {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return fatomic.WriteFile(cfg.DataDir().Join(sketchUploadRecordFileName).String(), content, os.FileMode(0644))
+}
+
+func clearSketchUploadRecord(cfg config.Configuration) {
+	if err := cfg.DataDir().Join(sketchUploadRecordFileName).RemoveAll(); err != nil {
+		slog.Warn("unable to remove the sketch upload record", slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,129 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+)
+
+func TestHashSketch(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "Hashed sketch"}, idProvider, &bricksindex.BricksIndex{}, cfg)
+	require.NoError(t, err)
+	sketchPath := resp.ID.ToPath().Join("sketch")
+
+	hash := f.Must(hashSketch(t.Context(), sketchPath))
+	require.Equal(t, hash, f.Must(hashSketch(t.Context(), sketchPath)))
+
+	// Hidden files are ignored.
+	require.NoError(t, sketchPath.Join(".editorconfig").WriteFile([]byte("root = true\n")))
+	require.Equal(t, hash, f.Must(hashSketch(t.Context(), sketchPath)))
+
+	// Sources are part of the hash.
+	require.NoError(t, sketchPath.Join("helper.h").WriteFile([]byte("#pragma once\n")))
+	withHeader := f.Must(hashSketch(t.Context(), sketchPath))
+	require.NotEqual(t, hash, withHeader)
+
+	// The libraries of the profile are part of the hash.
+	sketchYaml := sketchPath.Join("sketch.yaml")
+	content := f.Must(sketchYaml.ReadFile())
+	content = bytes.Replace(content, []byte("    libraries:\n"), []byte("    libraries:\n      - Arduino_RouterBridge (0.1.0)\n"), 1)
+	require.NoError(t, sketchYaml.WriteFile(content))
+	require.NotEqual(t, withHeader, f.Must(hashSketch(t.Context(), sketchPath)))
+}
+
+func TestSketchUploadRecord(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+
+	require.Nil(t, readSketchUploadRecord(cfg))
+
+	record := sketchUploadRecord{
+		Hash:       "0123",
+		AppPath:    "/apps/blink",
+		UploadedAt: time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, writeSketchUploadRecord(cfg, record))
+	require.Equal(t, &record, readSketchUploadRecord(cfg))
+
+	clearSketchUploadRecord(cfg)
+	require.Nil(t, readSketchUploadRecord(cfg))
+}
+
+func TestSketchUploadRecordMatches(t *testing.T) {
+	ram := sketchUploadRecord{Hash: "0123"}
+	require.False(t, ram.matches("0123", app.FlashModeRAM), "a sketch in RAM is lost when the app is stopped")
+	require.False(t, ram.matches("0123", app.FlashModeFlash))
+
+	flash := sketchUploadRecord{Hash: "0123", FlashMode: app.FlashModeFlash}
+	require.True(t, flash.matches("0123", app.FlashModeFlash), "a sketch in flash survives a stop and a reboot")
+	require.False(t, flash.matches("0123", app.FlashModeRAM))
+	require.False(t, flash.matches("4567", app.FlashModeFlash))
+}
+
+func TestSketchUploadRecordReusableBuild(t *testing.T) {
+	appPath := paths.New("/apps/blink")
+	ram := sketchUploadRecord{Hash: "0123", AppPath: appPath.String(), FlashMode: app.FlashModeRAM}
+	require.True(t, ram.reusableBuild(appPath, "0123", app.FlashModeRAM), "an unchanged sketch in RAM is uploaded again without compiling")
+	require.False(t, ram.reusableBuild(appPath, "4567", app.FlashModeRAM))
+	require.False(t, ram.reusableBuild(paths.New("/apps/other"), "0123", app.FlashModeRAM))
+	require.False(t, ram.reusableBuild(appPath, "0123", app.FlashModeFlash), "the build targets another flash mode")
+
+	flash := sketchUploadRecord{Hash: "0123", AppPath: appPath.String(), FlashMode: app.FlashModeFlash}
+	require.False(t, flash.reusableBuild(appPath, "0123", app.FlashModeRAM))
+}
+
+func TestBuildUnchangedSince(t *testing.T) {
+	buildPath := paths.New(t.TempDir()).Join("build")
+	uploadedAt := time.Now()
+	require.False(t, buildUnchangedSince(buildPath, uploadedAt), "a missing build cannot be uploaded")
+
+	require.NoError(t, buildPath.MkdirAll())
+	binary := buildPath.Join("sketch.ino.elf-zsk.bin")
+	require.NoError(t, binary.WriteFile([]byte("binary")))
+	before := uploadedAt.Add(-time.Minute)
+	require.NoError(t, binary.Chtimes(before, before))
+	require.True(t, buildUnchangedSince(buildPath, uploadedAt))
+
+	// A compile after the upload replaced the binary.
+	after := uploadedAt.Add(time.Minute)
+	require.NoError(t, binary.Chtimes(after, after))
+	require.False(t, buildUnchangedSince(buildPath, uploadedAt))
+}
+
+func TestSketchUploadRecordStopStart(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+
+	for _, flashMode := range []string{app.FlashModeRAM, app.FlashModeFlash} {
+		// Start: the sketch is uploaded and recorded.
+		require.NoError(t, writeSketchUploadRecord(cfg, sketchUploadRecord{Hash: "0123", AppPath: "/apps/blink", FlashMode: flashMode}))
+		// Stop: the MCU is reset and boots from flash.
+		// Start again with an unchanged sketch: only the sketch in flash is still there.
+		record := readSketchUploadRecord(cfg)
+		require.NotNil(t, record)
+		require.Equal(t, flashMode == app.FlashModeFlash, record.matches("0123", flashMode), flashMode)
+	}
+}
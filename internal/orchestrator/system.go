@@ -27,11 +27,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/arduino/go-paths-helper"
 	"github.com/compose-spec/compose-go/v2/loader"
 	"github.com/compose-spec/compose-go/v2/types"
 	"github.com/docker/cli/cli/command"
-	"github.com/docker/docker/api/types/container"
-	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
 	dockerClient "github.com/docker/docker/client"
 	"go.bug.st/f"
@@ -43,14 +42,12 @@ import (
 
 // SystemInit pulls necessary Docker images.
 func SystemInit(ctx context.Context, cfg config.Configuration, staticStore *store.StaticStore, docker *command.DockerCli) error {
-	containersToPreinstall := []string{cfg.PythonImage}
-	additionalContainers, err := parseAllModelsRunnerImageTag(staticStore)
+	containersToPreinstall, err := getRequiredImages(cfg, staticStore)
 	if err != nil {
 		return err
 	}
-	containersToPreinstall = append(containersToPreinstall, additionalContainers...)
 
-	pulledImages, err := listImagesAlreadyPulled(ctx, docker.Client())
+	pulledImages, err := listImagesAlreadyPulled(ctx, cfg, docker.Client())
 	if err != nil {
 		return err
 	}
@@ -153,9 +150,44 @@ func isTemporaryDockerError(err error) bool {
 // List of prefixes used to identify current or past Arduino images. Used both during 'system init' and during cleanup.
 var imagePrefixes = []string{"ghcr.io/bcmi-labs/", "public.ecr.aws/arduino/", "ghcr.io/arduino/", "influxdb"}
 
+// isArduinoImage reports whether the image is an Arduino image, also when it comes from a registry
+// mirror: a mirrored image is an Arduino image only if the mirror rules redirect an Arduino image to it.
+func isArduinoImage(cfg config.Configuration, image string) bool {
+	if hasArduinoImagePrefix(image) {
+		return true
+	}
+	return slices.ContainsFunc(cfg.OriginalImages(image), hasArduinoImagePrefix)
+}
+
+func hasArduinoImagePrefix(image string) bool {
+	image = normalizeImageRef(image)
+	for _, prefix := range imagePrefixes {
+		rest, found := strings.CutPrefix(image, prefix)
+		if !found {
+			continue
+		}
+		// Prefixes not ending with a `/` are full repository names (e.g. `influxdb`).
+		if strings.HasSuffix(prefix, "/") || rest == "" || rest[0] == ':' || rest[0] == '@' {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeImageRef returns the image in the short form used by docker for the Docker Hub
+// images, e.g. `docker.io/library/influxdb:2.7` becomes `influxdb:2.7`.
+func normalizeImageRef(image string) string {
+	for _, registry := range []string{"docker.io/", "index.docker.io/", "registry-1.docker.io/"} {
+		if rest, found := strings.CutPrefix(image, registry); found {
+			return strings.TrimPrefix(rest, "library/")
+		}
+	}
+	return image
+}
+
 // Lists all the local docker images that could have been, or are downloaded by Arduino.
 // This is used both to avoid pulling already existing images and cleaning up unused old Arduino images.
-func listImagesAlreadyPulled(ctx context.Context, docker dockerClient.APIClient) ([]string, error) {
+func listImagesAlreadyPulled(ctx context.Context, cfg config.Configuration, docker dockerClient.APIClient) ([]string, error) {
 	images, err := docker.ImageList(ctx, image.ListOptions{})
 	if err != nil {
 		return nil, err
@@ -164,10 +196,8 @@ func listImagesAlreadyPulled(ctx context.Context, docker dockerClient.APIClient)
 	result := make([]string, 0, len(images))
 	for _, image := range images {
 		for _, tag := range image.RepoTags {
-			for _, prefix := range imagePrefixes {
-				if strings.HasPrefix(tag, prefix) {
-					result = append(result, tag)
-				}
+			if isArduinoImage(cfg, tag) {
+				result = append(result, tag)
 			}
 		}
 	}
@@ -175,7 +205,8 @@ func listImagesAlreadyPulled(ctx context.Context, docker dockerClient.APIClient)
 	return result, nil
 }
 
-func parseAllModelsRunnerImageTag(staticStore *store.StaticStore) ([]string, error) {
+// parseAllModelsRunnerImageTag returns the images of the bricks, redirected to the registry mirrors.
+func parseAllModelsRunnerImageTag(cfg config.Configuration, staticStore *store.StaticStore) ([]string, error) {
 	composePath := staticStore.GetComposeFolder()
 	brickNamespace := "arduino"
 	bricks, err := composePath.Join(brickNamespace).ReadDir()
@@ -185,27 +216,13 @@ func parseAllModelsRunnerImageTag(staticStore *store.StaticStore) ([]string, err
 
 	result := make([]string, 0, len(bricks))
 	for _, brick := range bricks {
-		composeFile := composePath.Join(brickNamespace, brick.Base(), "brick_compose.yaml")
-		content, err := composeFile.ReadFile()
-		if err != nil {
-			return nil, err
-		}
-		prj, err := loader.LoadWithContext(
-			context.Background(),
-			types.ConfigDetails{
-				ConfigFiles: []types.ConfigFile{{Content: content}},
-				Environment: types.NewMapping(os.Environ()),
-			},
-			func(o *loader.Options) { o.SetProjectName("test", false) },
-		)
+		images, err := composeFileImages(composePath.Join(brickNamespace, brick.Base(), "brick_compose.yaml"))
 		if err != nil {
 			return nil, err
 		}
-		for _, v := range prj.Services {
-			for _, prefix := range imagePrefixes {
-				if strings.HasPrefix(v.Image, prefix) {
-					result = append(result, v.Image)
-				}
+		for _, image := range images {
+			if isArduinoImage(cfg, image) {
+				result = append(result, cfg.RewriteImage(image))
 			}
 		}
 	}
@@ -213,66 +230,29 @@ func parseAllModelsRunnerImageTag(staticStore *store.StaticStore) ([]string, err
 	return f.Uniq(result), nil
 }
 
-type SystemCleanupResult struct {
-	ContainersRemoved int
-	ImagesRemoved     int
-	RunningAppRemoved bool
-	SpaceFreed        int64 // in bytes
-}
-
-func (s SystemCleanupResult) IsEmpty() bool {
-	return s == SystemCleanupResult{}
-}
-
-// SystemCleanup removes dangling containers and unused images.
-// Also running apps are stopped and removed.
-func SystemCleanup(ctx context.Context, cfg config.Configuration, staticStore *store.StaticStore, docker command.Cli) (SystemCleanupResult, error) {
-	var result SystemCleanupResult
-
-	// Remove running app and dangling containers
-	runningApp, err := getRunningApp(ctx, docker.Client())
-	if err != nil {
-		feedback.Warnf("failed to get running app - %v", err)
-	}
-	if runningApp != nil {
-		for item := range StopAndDestroyApp(ctx, *runningApp) {
-			if item.GetType() == ErrorType {
-				feedback.Warnf("failed to stop and destroy running app - %v", item.GetError())
-				break
-			}
-		}
-		result.RunningAppRemoved = true
-	}
-	if count, err := removeDanglingContainers(ctx, docker.Client()); err != nil {
-		feedback.Warnf("failed to remove dangling containers - %v", err)
-	} else {
-		result.ContainersRemoved = count
-	}
-
-	// Remove unused images
-	containersMustStay, err := getRequiredImages(cfg, staticStore)
+// composeFileImages returns the images of the services of a compose file, interpolated
+// with the environment.
+func composeFileImages(composeFile *paths.Path) ([]string, error) {
+	content, err := composeFile.ReadFile()
 	if err != nil {
-		return result, err
+		return nil, err
 	}
-	allImages, err := listImagesAlreadyPulled(ctx, docker.Client())
+	prj, err := loader.LoadWithContext(
+		context.Background(),
+		types.ConfigDetails{
+			ConfigFiles: []types.ConfigFile{{Content: content}},
+			Environment: types.NewMapping(os.Environ()),
+		},
+		func(o *loader.Options) { o.SetProjectName("test", false) },
+	)
 	if err != nil {
-		return result, err
+		return nil, err
 	}
-	imagesToRemove := slices.DeleteFunc(allImages, func(v string) bool {
-		return slices.Contains(containersMustStay, v)
-	})
-
-	for _, image := range imagesToRemove {
-		imageSize, err := removeImage(ctx, docker.Client(), image)
-		if err != nil {
-			feedback.Warnf("failed to remove image %s - %v", image, err)
-			continue
-		}
-		result.SpaceFreed += imageSize
-		result.ImagesRemoved++
+	images := make([]string, 0, len(prj.Services))
+	for _, v := range prj.Services {
+		images = append(images, v.Image)
 	}
-
-	return result, nil
+	return images, nil
 }
 
 func removeImage(ctx context.Context, docker dockerClient.APIClient, imageName string) (int64, error) {
@@ -297,7 +277,7 @@ func removeImage(ctx context.Context, docker dockerClient.APIClient, imageName s
 func getRequiredImages(cfg config.Configuration, staticStore *store.StaticStore) ([]string, error) {
 	requiredImages := []string{cfg.PythonImage}
 
-	modelsRunnersContainers, err := parseAllModelsRunnerImageTag(staticStore)
+	modelsRunnersContainers, err := parseAllModelsRunnerImageTag(cfg, staticStore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse models runner images: %w", err)
 	}
@@ -305,25 +285,3 @@ func getRequiredImages(cfg config.Configuration, staticStore *store.StaticStore)
 	requiredImages = append(requiredImages, modelsRunnersContainers...)
 	return requiredImages, nil
 }
-
-func removeDanglingContainers(ctx context.Context, docker dockerClient.APIClient) (int, error) {
-	containers, err := docker.ContainerList(ctx, container.ListOptions{
-		All:     true,
-		Filters: filters.NewArgs(filters.Arg("label", DockerAppLabel+"=true")),
-	})
-	if err != nil {
-		return 0, fmt.Errorf("failed to list containers: %w", err)
-	}
-
-	var counter int
-	for _, info := range containers {
-		if err := docker.ContainerRemove(ctx, info.ID, container.RemoveOptions{
-			Force:         true,
-			RemoveVolumes: true,
-		}); err != nil {
-			return 0, fmt.Errorf("failed to remove container %s: %w", info.ID, err)
-		}
-		counter++
-	}
-	return counter, nil
-}
@@ -0,0 +1,504 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/docker/cli/cli/command"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	dockervolume "github.com/docker/docker/api/types/volume"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/cmd/feedback"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+type CleanupTarget string
+
+const (
+	// CleanupContainers stops the running app and removes the containers of the apps.
+	CleanupContainers CleanupTarget = "containers"
+	// CleanupImages removes the Arduino images not required by this version nor used by any app.
+	CleanupImages CleanupTarget = "images"
+	// CleanupModels removes the cached models no longer used by any app.
+	CleanupModels CleanupTarget = "models"
+	// CleanupSketchCaches removes the build caches of the sketches, under `.cache/sketch`.
+	CleanupSketchCaches CleanupTarget = "sketch-caches"
+	// CleanupAppCaches removes the provisioning files, regenerated on start, from the `.cache`
+	// of the apps that are not running. The snapshots and the logs are kept.
+	CleanupAppCaches CleanupTarget = "app-caches"
+	// CleanupVolumes removes the volumes of the apps whose folder has been deleted.
+	CleanupVolumes CleanupTarget = "volumes"
+	// CleanupLogs removes the rotated log archives, in the `.cache` of the apps, older than logArchiveMaxAge.
+	CleanupLogs CleanupTarget = "logs"
+)
+
+// CleanupTargets are all the cleanup targets, in the order they are cleaned up.
+var CleanupTargets = []CleanupTarget{
+	CleanupContainers,
+	CleanupImages,
+	CleanupModels,
+	CleanupSketchCaches,
+	CleanupAppCaches,
+	CleanupVolumes,
+	CleanupLogs,
+}
+
+// DefaultCleanupTargets are cleaned up when no target is requested. The other targets
+// remove caches and logs that are still useful, and must be requested explicitly.
+var DefaultCleanupTargets = []CleanupTarget{
+	CleanupContainers,
+	CleanupImages,
+	CleanupModels,
+}
+
+const composeProjectLabel = "com.docker.compose.project"
+
+const logArchiveMaxAge = 7 * 24 * time.Hour
+
+// logFileRegexp matches the log files, either current or rotated, e.g. `app.log` or `app.log.1.gz`.
+var logFileRegexp = regexp.MustCompile(`\.log(\.\d+)?(\.(gz|xz|zip))?$`)
+
+// logArchiveRegexp matches rotated log files, e.g. `app.log.1`, `app.log.gz` or `app.log.2.gz`.
+var logArchiveRegexp = regexp.MustCompile(`\.log(\.\d+)?\.(gz|xz|zip)$|\.log\.\d+$`)
+
+type SystemCleanupRequest struct {
+	// Targets to clean up, the DefaultCleanupTargets when empty.
+	Targets []CleanupTarget
+	// DryRun only lists what would be removed.
+	DryRun bool
+}
+
+// CleanupItem is something removed, or that would be removed, by the cleanup.
+type CleanupItem struct {
+	Target CleanupTarget `json:"target"`
+	Name   string        `json:"name"`
+	Size   int64         `json:"size,omitempty"` // in bytes, 0 when unknown
+	// SharedSize of the image layers shared with other images, freed only if they are removed too.
+	SharedSize int64 `json:"shared_size,omitempty"`
+}
+
+type SystemCleanupResult struct {
+	Items      []CleanupItem `json:"items"`
+	DryRun     bool          `json:"dry_run,omitempty"`
+	SpaceFreed int64         `json:"space_freed"` // in bytes, the space that would be freed in dry run
+	// SharedSpace of the removed images layers shared with other images, freed only if they are removed too.
+	SharedSpace int64 `json:"shared_space,omitempty"`
+}
+
+func (s SystemCleanupResult) IsEmpty() bool {
+	return len(s.Items) == 0
+}
+
+type cleanupCandidate struct {
+	CleanupItem
+	remove func() error
+}
+
+// SystemCleanup removes what is no longer needed by the apps: see CleanupTarget for the
+// details of each target. The running app is stopped when the containers are cleaned up.
+func SystemCleanup(
+	ctx context.Context,
+	cfg config.Configuration,
+	staticStore *store.StaticStore,
+	modelsIndex *modelsindex.ModelsIndex,
+	docker command.Cli,
+	req SystemCleanupRequest,
+) (SystemCleanupResult, error) {
+	result := SystemCleanupResult{DryRun: req.DryRun}
+	targets := req.Targets
+	if len(targets) == 0 {
+		targets = DefaultCleanupTargets
+	}
+	for _, target := range targets {
+		if !slices.Contains(CleanupTargets, target) {
+			return result, fmt.Errorf("invalid cleanup target %q", target)
+		}
+	}
+
+	runningApp, err := getRunningApp(ctx, docker.Client(), cfg)
+	if err != nil {
+		feedback.Warnf("failed to get running app - %v", err)
+	}
+	apps := findAllApps(cfg)
+
+	for _, target := range CleanupTargets {
+		if !slices.Contains(targets, target) {
+			continue
+		}
+		var candidates []cleanupCandidate
+		var err error
+		switch target {
+		case CleanupContainers:
+			candidates, err = containersCleanupCandidates(ctx, docker, runningApp)
+		case CleanupImages:
+			candidates, err = imagesCleanupCandidates(ctx, cfg, staticStore, modelsIndex, docker, apps)
+		case CleanupModels:
+			candidates, err = modelsCleanupCandidates(cfg)
+		case CleanupSketchCaches:
+			candidates = sketchCachesCleanupCandidates(apps)
+		case CleanupAppCaches:
+			candidates = appCachesCleanupCandidates(apps, runningApp)
+		case CleanupVolumes:
+			candidates, err = volumesCleanupCandidates(ctx, cfg, docker)
+		case CleanupLogs:
+			candidates = logsCleanupCandidates(apps)
+		}
+		if err != nil {
+			return result, err
+		}
+
+		for _, c := range candidates {
+			if !req.DryRun {
+				if err := c.remove(); err != nil {
+					feedback.Warnf("failed to remove %s %s - %v", c.Target, c.Name, err)
+					continue
+				}
+			}
+			result.Items = append(result.Items, c.CleanupItem)
+			result.SpaceFreed += c.Size
+			result.SharedSpace += c.SharedSize
+		}
+	}
+	return result, nil
+}
+
+func containersCleanupCandidates(ctx context.Context, docker command.Cli, runningApp *app.ArduinoApp) ([]cleanupCandidate, error) {
+	var candidates []cleanupCandidate
+	if runningApp != nil {
+		candidates = append(candidates, cleanupCandidate{
+			CleanupItem: CleanupItem{Target: CleanupContainers, Name: fmt.Sprintf("running app %q", runningApp.Name)},
+			remove: func() error {
+				for item := range StopAndDestroyApp(ctx, *runningApp) {
+					if item.GetType() == ErrorType {
+						return item.GetError()
+					}
+				}
+				return nil
+			},
+		})
+	}
+
+	containers, err := docker.Client().ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", DockerAppLabel+"=true")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+	for _, info := range containers {
+		// The containers of the running app are removed together with the app.
+		if runningApp != nil && info.Labels[DockerAppPathLabel] == runningApp.FullPath.String() {
+			continue
+		}
+		name := info.ID[:min(12, len(info.ID))]
+		if len(info.Names) > 0 {
+			name = info.Names[0]
+		}
+		candidates = append(candidates, cleanupCandidate{
+			CleanupItem: CleanupItem{Target: CleanupContainers, Name: name},
+			remove: func() error {
+				return docker.Client().ContainerRemove(ctx, info.ID, container.RemoveOptions{
+					Force:         true,
+					RemoveVolumes: true,
+				})
+			},
+		})
+	}
+	return candidates, nil
+}
+
+func imagesCleanupCandidates(
+	ctx context.Context,
+	cfg config.Configuration,
+	staticStore *store.StaticStore,
+	modelsIndex *modelsindex.ModelsIndex,
+	docker command.Cli,
+	apps []app.ArduinoApp,
+) ([]cleanupCandidate, error) {
+	imagesToKeep, err := getRequiredImages(cfg, staticStore)
+	if err != nil {
+		return nil, err
+	}
+	imagesToKeep = append(imagesToKeep, appsImages(cfg, staticStore, modelsIndex, apps)...)
+
+	images, err := docker.Client().ImageList(ctx, image.ListOptions{SharedSize: true})
+	if err != nil {
+		return nil, err
+	}
+	var candidates []cleanupCandidate
+	for _, item := range unusedImages(cfg, images, imagesToKeep) {
+		candidates = append(candidates, cleanupCandidate{
+			CleanupItem: item,
+			remove: func() error {
+				_, err := removeImage(ctx, docker.Client(), item.Name)
+				return err
+			},
+		})
+	}
+	return candidates, nil
+}
+
+// unusedImages returns the Arduino image tags not in imagesToKeep. An image is freed only when
+// all its tags are removed: its own layers are accounted to the first removed tag, and the
+// layers shared with other images are reported separately, as they are freed only if all
+// the images sharing them are removed.
+func unusedImages(cfg config.Configuration, images []image.Summary, imagesToKeep []string) []CleanupItem {
+	var items []CleanupItem
+	for _, summary := range images {
+		var unused []string
+		for _, tag := range summary.RepoTags {
+			if isArduinoImage(cfg, tag) && !slices.Contains(imagesToKeep, tag) {
+				unused = append(unused, tag)
+			}
+		}
+		for i, tag := range unused {
+			item := CleanupItem{Target: CleanupImages, Name: tag}
+			if i == 0 && len(unused) == len(summary.RepoTags) {
+				item.SharedSize = max(summary.SharedSize, 0)
+				item.Size = summary.Size - item.SharedSize
+			}
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// appsImages returns the images used by the bricks and by the models of the apps.
+func appsImages(cfg config.Configuration, staticStore *store.StaticStore, modelsIndex *modelsindex.ModelsIndex, apps []app.ArduinoApp) []string {
+	var images []string
+	for _, userApp := range apps {
+		for _, brick := range userApp.Descriptor.Bricks {
+			if composeFile, err := staticStore.GetBrickComposeFilePathFromID(brick.ID); err == nil && composeFile.Exist() {
+				brickImages, err := composeFileImages(composeFile)
+				if err != nil {
+					feedback.Warnf("failed to read the images of brick %s - %v", brick.ID, err)
+				}
+				for _, image := range brickImages {
+					images = append(images, cfg.RewriteImage(image))
+				}
+			}
+			if model, found := modelsIndex.GetModelByID(brick.Model); found && model.RunnerImage != "" {
+				images = append(images, cfg.RewriteImage(model.RunnerImage))
+			}
+		}
+	}
+	return f.Uniq(images)
+}
+
+func modelsCleanupCandidates(cfg config.Configuration) ([]cleanupCandidate, error) {
+	files, err := unusedModelCacheFiles(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unused models: %w", err)
+	}
+	cacheDir := cfg.ModelsCacheDir()
+	candidates := make([]cleanupCandidate, 0, len(files))
+	for _, file := range files {
+		name := file.String()
+		if rel, err := file.RelFrom(cacheDir); err == nil {
+			name = rel.String()
+		}
+		candidates = append(candidates, cleanupCandidate{
+			CleanupItem: CleanupItem{Target: CleanupModels, Name: name, Size: pathSize(file)},
+			remove:      file.RemoveAll,
+		})
+	}
+	return candidates, nil
+}
+
+func sketchCachesCleanupCandidates(apps []app.ArduinoApp) []cleanupCandidate {
+	var candidates []cleanupCandidate
+	for _, userApp := range apps {
+		buildPath := userApp.SketchBuildPath()
+		if !buildPath.IsDir() {
+			continue
+		}
+		candidates = append(candidates, cleanupCandidate{
+			CleanupItem: CleanupItem{Target: CleanupSketchCaches, Name: buildPath.String(), Size: pathSize(buildPath)},
+			remove:      buildPath.RemoveAll,
+		})
+	}
+	return candidates
+}
+
+func appCachesCleanupCandidates(apps []app.ArduinoApp, runningApp *app.ArduinoApp) []cleanupCandidate {
+	var candidates []cleanupCandidate
+	for _, userApp := range apps {
+		if runningApp != nil && runningApp.FullPath.EqualsTo(userApp.FullPath) {
+			continue
+		}
+		files, err := userApp.ProvisioningStateDir().ReadDir()
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			// The build cache of the sketch and the logs have their own target, the snapshots are not a cache.
+			if file.EqualsTo(userApp.SketchBuildPath()) || file.EqualsTo(appSnapshotsDir(userApp)) || logFileRegexp.MatchString(file.Base()) {
+				continue
+			}
+			candidates = append(candidates, cleanupCandidate{
+				CleanupItem: CleanupItem{Target: CleanupAppCaches, Name: file.String(), Size: pathSize(file)},
+				remove:      file.RemoveAll,
+			})
+		}
+	}
+	return candidates
+}
+
+func volumesCleanupCandidates(ctx context.Context, cfg config.Configuration, docker command.Cli) ([]cleanupCandidate, error) {
+	resp, err := docker.Client().VolumeList(ctx, dockervolume.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("dangling", "true"), filters.Arg("label", composeProjectLabel)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	var candidates []cleanupCandidate
+	for _, v := range orphanedAppVolumes(cfg, resp.Volumes, readAppComposeProjects(cfg)) {
+		var size int64
+		if v.UsageData != nil && v.UsageData.Size > 0 {
+			size = v.UsageData.Size
+		}
+		candidates = append(candidates, cleanupCandidate{
+			CleanupItem: CleanupItem{Target: CleanupVolumes, Name: v.Name, Size: size},
+			remove:      func() error { return docker.Client().VolumeRemove(ctx, v.Name, false) },
+		})
+	}
+	return candidates, nil
+}
+
+// orphanedAppVolumes returns the volumes of the app compose projects whose app folder has been
+// deleted. The volumes of other compose projects are never returned, nor the volumes of apps
+// whose folder exists, even if the app can't be loaded, nor the volumes of apps in a folder
+// that is not available, like an unmounted app root.
+func orphanedAppVolumes(cfg config.Configuration, volumes []*dockervolume.Volume, projects map[string]string) []*dockervolume.Volume {
+	roots := paths.PathList{cfg.AppsDir(), cfg.ExamplesDir()}
+	for _, root := range cfg.AppRoots() {
+		roots.Add(root.Path)
+	}
+
+	var orphaned []*dockervolume.Volume
+	for _, v := range volumes {
+		appPath, ok := projects[v.Labels[composeProjectLabel]]
+		if !ok {
+			continue
+		}
+		if isAppFolderDeleted(paths.New(appPath), roots) {
+			orphaned = append(orphaned, v)
+		}
+	}
+	return orphaned
+}
+
+// isAppFolderDeleted reports whether the app folder has been removed from an available root.
+// A missing or empty root is considered not mounted, and its apps are not deleted.
+func isAppFolderDeleted(appPath *paths.Path, roots paths.PathList) bool {
+	if appPath.Exist() {
+		return false
+	}
+	// The app is in the innermost root containing it.
+	var appRoot *paths.Path
+	for _, root := range roots {
+		if inside, err := appPath.IsInsideDir(root); err == nil && inside {
+			if appRoot == nil || len(root.String()) > len(appRoot.String()) {
+				appRoot = root
+			}
+		}
+	}
+	if appRoot == nil {
+		return false
+	}
+	entries, err := appRoot.ReadDir()
+	return err == nil && len(entries) > 0
+}
+
+// logsCleanupCandidates returns the old rotated logs in the `.cache` of the apps, where the
+// app processes write their logs. The folders below `.cache`, like the sketch build cache and
+// the snapshots, are not logs locations and are not scanned.
+func logsCleanupCandidates(apps []app.ArduinoApp) []cleanupCandidate {
+	var candidates []cleanupCandidate
+	for _, userApp := range apps {
+		files, err := userApp.ProvisioningStateDir().ReadDir(paths.FilterOutDirectories())
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			if !logArchiveRegexp.MatchString(file.Base()) {
+				continue
+			}
+			info, err := file.Stat()
+			if err != nil || time.Since(info.ModTime()) < logArchiveMaxAge {
+				continue
+			}
+			candidates = append(candidates, cleanupCandidate{
+				CleanupItem: CleanupItem{Target: CleanupLogs, Name: file.String(), Size: info.Size()},
+				remove:      file.Remove,
+			})
+		}
+	}
+	return candidates
+}
+
+// findAllApps returns the apps of the apps folder, of the examples folder and of the app roots.
+func findAllApps(cfg config.Configuration) []app.ArduinoApp {
+	dirs := paths.PathList{cfg.AppsDir(), cfg.ExamplesDir()}
+	for _, root := range cfg.AppRoots() {
+		if root.Path.IsDir() {
+			dirs.Add(root.Path)
+		}
+	}
+	var apps []app.ArduinoApp
+	for _, dir := range dirs {
+		appPaths, err := findAppPaths(dir)
+		if err != nil {
+			feedback.Warnf("failed to list the apps in %s - %v", dir, err)
+			continue
+		}
+		for _, appPath := range appPaths {
+			if userApp, err := app.Load(appPath.String(), cfg); err == nil {
+				apps = append(apps, userApp)
+			}
+		}
+	}
+	return apps
+}
+
+// pathSize returns the size of a file, or the total size of the files in a folder.
+func pathSize(p *paths.Path) int64 {
+	var size int64
+	_ = filepath.WalkDir(p.String(), func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info, err := d.Info(); err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,202 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/docker/docker/api/types/image"
+	dockervolume "github.com/docker/docker/api/types/volume"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/f"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/app"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/bricksindex"
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/modelsindex"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+func TestCleanupCandidates(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	idProvider := app.NewAppIDProvider(cfg)
+
+	resp, err := CreateApp(t.Context(), CreateAppRequest{Name: "Cleanup app"}, idProvider, &bricksindex.BricksIndex{}, cfg)
+	require.NoError(t, err)
+	userApp := f.Must(app.Load(resp.ID.ToPath().String(), cfg))
+
+	cacheDir := userApp.ProvisioningStateDir()
+	require.NoError(t, userApp.SketchBuildPath().MkdirAll())
+	require.NoError(t, userApp.SketchBuildPath().Join("sketch.ino.bin").WriteFile([]byte("binary")))
+	require.NoError(t, appSnapshotsDir(userApp).MkdirAll())
+	require.NoError(t, cacheDir.Join("app-compose.yaml").WriteFile([]byte("services: {}\n")))
+
+	require.NoError(t, userApp.SketchBuildPath().Join("build.log.1").WriteFile([]byte("not a log location")))
+	oldLog := cacheDir.Join("python.log.1")
+	require.NoError(t, oldLog.WriteFile([]byte("old")))
+	old := time.Now().Add(-2 * logArchiveMaxAge)
+	require.NoError(t, oldLog.Chtimes(old, old))
+	require.NoError(t, userApp.SketchBuildPath().Join("build.log.1").Chtimes(old, old))
+	require.NoError(t, cacheDir.Join("python.log.2.gz").WriteFile([]byte("recent")))
+	require.NoError(t, cacheDir.Join("python.log").WriteFile([]byte("current")))
+	require.NoError(t, cacheDir.Join("python.log").Chtimes(old, old))
+
+	apps := findAllApps(cfg)
+	require.True(t, apps[0].FullPath.EqualsTo(userApp.FullPath))
+
+	itemNames := func(candidates []cleanupCandidate) paths.PathList {
+		var names paths.PathList
+		for _, c := range candidates {
+			names.Add(paths.New(c.Name))
+		}
+		return names
+	}
+
+	t.Run("sketch caches", func(t *testing.T) {
+		candidates := sketchCachesCleanupCandidates(apps)
+		require.Equal(t, paths.PathList{userApp.SketchBuildPath()}, itemNames(candidates))
+		require.Equal(t, int64(len("binary")+len("not a log location")), candidates[0].Size)
+	})
+
+	t.Run("app caches", func(t *testing.T) {
+		names := itemNames(appCachesCleanupCandidates(apps, nil))
+		require.True(t, names.Contains(cacheDir.Join("app-compose.yaml")))
+		require.False(t, names.Contains(userApp.SketchBuildPath()))
+		require.False(t, names.Contains(appSnapshotsDir(userApp)))
+		// The logs, old or recent, have their own target.
+		require.False(t, names.Contains(oldLog))
+		require.False(t, names.Contains(cacheDir.Join("python.log")))
+		require.False(t, names.Contains(cacheDir.Join("python.log.2.gz")))
+
+		// The cache of the running app is in use.
+		require.Empty(t, appCachesCleanupCandidates(apps, &userApp))
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		candidates := logsCleanupCandidates(apps)
+		require.Equal(t, paths.PathList{oldLog}, itemNames(candidates))
+
+		require.NoError(t, candidates[0].remove())
+		require.NoFileExists(t, oldLog.String())
+	})
+}
+
+func TestOrphanedAppVolumes(t *testing.T) {
+	tmp := paths.New(t.TempDir())
+	usbRoot := tmp.Join("usb")
+	t.Setenv("ARDUINO_APP_CLI__APP_ROOTS", "usb="+usbRoot.String())
+	cfg := setTestOrchestratorConfig(t)
+	require.NoError(t, cfg.AppsDir().Join("existing").MkdirAll())
+
+	newVolume := func(name, project string) *dockervolume.Volume {
+		return &dockervolume.Volume{Name: name, Labels: map[string]string{composeProjectLabel: project}}
+	}
+	volumes := []*dockervolume.Volume{
+		newVolume("deleted_db", "deleted"),
+		newVolume("existing_db", "existing"),
+		newVolume("usb_db", "usb-app"),
+		newVolume("other_db", "not-an-app"),
+	}
+	projects := map[string]string{
+		"deleted":  cfg.AppsDir().Join("deleted").String(),
+		"existing": cfg.AppsDir().Join("existing").String(),
+		"usb-app":  usbRoot.Join("app").String(),
+	}
+
+	names := func(volumes []*dockervolume.Volume) []string {
+		var names []string
+		for _, v := range volumes {
+			names = append(names, v.Name)
+		}
+		return names
+	}
+	// The app root is not mounted: its apps are not deleted.
+	require.Equal(t, []string{"deleted_db"}, names(orphanedAppVolumes(cfg, volumes, projects)))
+	require.NoError(t, usbRoot.MkdirAll())
+	require.Equal(t, []string{"deleted_db"}, names(orphanedAppVolumes(cfg, volumes, projects)))
+
+	// The app root is mounted, but the app is no longer there.
+	require.NoError(t, usbRoot.Join("other-app").MkdirAll())
+	require.Equal(t, []string{"deleted_db", "usb_db"}, names(orphanedAppVolumes(cfg, volumes, projects)))
+}
+
+func TestRecordAppComposeProject(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+	require.Empty(t, readAppComposeProjects(cfg))
+
+	require.NoError(t, recordAppComposeProject(cfg, "blink", cfg.AppsDir().Join("blink")))
+	require.NoError(t, recordAppComposeProject(cfg, "other", cfg.AppsDir().Join("other")))
+	require.Equal(t, map[string]string{
+		"blink": cfg.AppsDir().Join("blink").String(),
+		"other": cfg.AppsDir().Join("other").String(),
+	}, readAppComposeProjects(cfg))
+}
+
+func TestAppsImages(t *testing.T) {
+	t.Setenv("ARDUINO_APP_CLI__REGISTRY_MIRRORS", "influxdb=registry.lab:5000/library/influxdb")
+	cfg := setTestOrchestratorConfig(t)
+
+	assetsDir := paths.New(t.TempDir())
+	composeFile := assetsDir.Join("compose", "arduino", "dbstorage_tsstore", "brick_compose.yaml")
+	require.NoError(t, composeFile.Parent().MkdirAll())
+	require.NoError(t, composeFile.WriteFile([]byte(`
+services:
+  dbstorage-influx:
+    image: influxdb:2.7
+`)))
+	require.NoError(t, assetsDir.Join("models-list.yaml").WriteFile([]byte(`
+models:
+  - face-detection:
+      runner: brick
+      runner_image: ghcr.io/arduino/app-bricks/ei-models-runner:0.5.0
+`)))
+	modelsIndex, err := modelsindex.GenerateModelsIndexFromFile(assetsDir)
+	require.NoError(t, err)
+
+	apps := []app.ArduinoApp{
+		{Descriptor: app.AppDescriptor{Bricks: []app.Brick{{ID: "arduino:dbstorage_tsstore"}}}},
+		{Descriptor: app.AppDescriptor{Bricks: []app.Brick{
+			{ID: "arduino:object_detection", Model: "face-detection"},
+			{ID: "arduino:dbstorage_tsstore"},
+		}}},
+	}
+	images := appsImages(cfg, store.NewStaticStore(assetsDir.String()), modelsIndex, apps)
+	require.ElementsMatch(t, []string{
+		"registry.lab:5000/library/influxdb:2.7",
+		"ghcr.io/arduino/app-bricks/ei-models-runner:0.5.0",
+	}, images)
+}
+
+func TestUnusedImages(t *testing.T) {
+	cfg := setTestOrchestratorConfig(t)
+
+	images := []image.Summary{
+		{RepoTags: []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.4.8"}, Size: 500, SharedSize: 200},
+		{RepoTags: []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.5.0"}, Size: 600, SharedSize: 200},
+		{RepoTags: []string{"ghcr.io/arduino/app-bricks/ei-models-runner:0.4.0", "ghcr.io/arduino/app-bricks/ei-models-runner:old"}, Size: 300, SharedSize: -1},
+		{RepoTags: []string{"ghcr.io/arduino/app-bricks/ei-models-runner:0.3.0", "my-runner:latest"}, Size: 300},
+		{RepoTags: []string{"postgres:16"}, Size: 400},
+	}
+	imagesToKeep := []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.5.0"}
+	require.Equal(t, []CleanupItem{
+		{Target: CleanupImages, Name: "ghcr.io/arduino/app-bricks/python-apps-base:0.4.8", Size: 300, SharedSize: 200},
+		{Target: CleanupImages, Name: "ghcr.io/arduino/app-bricks/ei-models-runner:0.4.0", Size: 300},
+		{Target: CleanupImages, Name: "ghcr.io/arduino/app-bricks/ei-models-runner:old"},
+		// The image stays, with the other tag.
+		{Target: CleanupImages, Name: "ghcr.io/arduino/app-bricks/ei-models-runner:0.3.0"},
+	}, unusedImages(cfg, images, imagesToKeep))
+}
@@ -0,0 +1,346 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/containerd/errdefs"
+	dockerClient "github.com/docker/docker/client"
+
+	"github.com/arduino/arduino-app-cli/internal/orchestrator/config"
+	"github.com/arduino/arduino-app-cli/internal/store"
+)
+
+var ErrNoImagesToExport = errors.New("none of the required images is available locally")
+
+type ExportImagesResult struct {
+	Exported []string `json:"exported"`
+	// Missing are the required images that are not available locally, so they are not in the bundle.
+	Missing []string `json:"missing,omitempty"`
+}
+
+type ImportImagesRequest struct {
+	Bundle *paths.Path
+	// Force replaces the installed images having the same tag as a different bundled image.
+	Force bool
+}
+
+type ImportImagesResult struct {
+	Imported []string `json:"imported"`
+	// Replaced are the imported tags that were already installed with a different image.
+	Replaced []string `json:"replaced,omitempty"`
+	// Missing are the required images that are still not available after the import.
+	Missing []string `json:"missing,omitempty"`
+}
+
+// ImageDigestMismatchError is returned when the content of an images bundle does not
+// match the digests it declares.
+type ImageDigestMismatchError struct {
+	Name string
+}
+
+func (e *ImageDigestMismatchError) Error() string {
+	return fmt.Sprintf("the digest of %s does not match its content: the bundle is corrupted", e.Name)
+}
+
+// UnexpectedImagesError is returned when an images bundle contains images, or tags, that
+// are not required by this version.
+type UnexpectedImagesError struct {
+	Images []string
+}
+
+func (e *UnexpectedImagesError) Error() string {
+	return fmt.Sprintf("the bundle contains images not required by this version: %s", strings.Join(e.Images, ", "))
+}
+
+// ReplacedImagesError is returned when importing an images bundle would replace installed
+// images with different ones having the same tag.
+type ReplacedImagesError struct {
+	Images []string
+}
+
+func (e *ReplacedImagesError) Error() string {
+	return fmt.Sprintf("the bundle would replace different images already installed: %s", strings.Join(e.Images, ", "))
+}
+
+// SystemExportImages saves the images required by this version, the python base image and
+// the images of the bricks, into a bundle that can be imported on a board without internet.
+// The bundle is the archive produced by `docker save`.
+func SystemExportImages(
+	ctx context.Context,
+	cfg config.Configuration,
+	staticStore *store.StaticStore,
+	docker dockerClient.APIClient,
+	bundle *paths.Path,
+) (ExportImagesResult, error) {
+	var result ExportImagesResult
+	requiredImages, err := getRequiredImages(cfg, staticStore)
+	if err != nil {
+		return result, err
+	}
+	for _, image := range requiredImages {
+		if _, err := docker.ImageInspect(ctx, image); errdefs.IsNotFound(err) {
+			result.Missing = append(result.Missing, image)
+		} else if err != nil {
+			return result, fmt.Errorf("inspecting image %s: %w", image, err)
+		} else {
+			result.Exported = append(result.Exported, image)
+		}
+	}
+	if len(result.Exported) == 0 {
+		return result, ErrNoImagesToExport
+	}
+
+	out, err := docker.ImageSave(ctx, result.Exported)
+	if err != nil {
+		return result, fmt.Errorf("saving images: %w", err)
+	}
+	defer out.Close()
+
+	// Write a temporary file first, to never leave a truncated bundle behind.
+	file, err := os.CreateTemp(bundle.Parent().String(), bundle.Base()+".tmp*")
+	if err != nil {
+		return result, err
+	}
+	tmp := paths.New(file.Name())
+	defer func() { _ = tmp.Remove() }()
+	if _, err := io.Copy(file, out); err != nil {
+		file.Close()
+		return result, fmt.Errorf("saving images: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return result, err
+	}
+	if err := tmp.Rename(bundle); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// SystemImportImages loads the images of a bundle created by SystemExportImages. The
+// digests and the tags of the bundle are verified before loading it, and the loaded images
+// are checked against the image ids declared in the bundle. The installed images are
+// replaced by different bundled images with the same tag only if forced.
+func SystemImportImages(
+	ctx context.Context,
+	cfg config.Configuration,
+	staticStore *store.StaticStore,
+	docker dockerClient.APIClient,
+	req ImportImagesRequest,
+) (ImportImagesResult, error) {
+	var result ImportImagesResult
+	images, blobs, err := readImagesBundle(req.Bundle)
+	if err != nil {
+		return result, err
+	}
+	requiredImages, err := getRequiredImages(cfg, staticStore)
+	if err != nil {
+		return result, err
+	}
+	if err := checkBundledImages(images, requiredImages); err != nil {
+		return result, err
+	}
+	result.Replaced, err = replacedImages(ctx, docker, images, blobs)
+	if err != nil {
+		return result, err
+	}
+	if len(result.Replaced) > 0 && !req.Force {
+		return ImportImagesResult{}, &ReplacedImagesError{Images: result.Replaced}
+	}
+
+	file, err := req.Bundle.Open()
+	if err != nil {
+		return result, err
+	}
+	defer file.Close()
+	resp, err := docker.ImageLoad(ctx, file, dockerClient.ImageLoadWithQuiet(true))
+	if err != nil {
+		return result, fmt.Errorf("loading images: %w", err)
+	}
+	defer resp.Body.Close()
+	if err := readImageLoadErrors(resp.Body); err != nil {
+		return result, fmt.Errorf("loading images: %w", err)
+	}
+
+	for _, image := range images {
+		for _, tag := range image.Tags {
+			info, err := docker.ImageInspect(ctx, tag)
+			if err != nil {
+				return result, fmt.Errorf("inspecting image %s: %w", tag, err)
+			}
+			// With the containerd image store the id is the digest of the manifest, or of
+			// the index, instead of the configuration: both are verified blobs of the bundle.
+			if info.ID != image.ID && !blobs[info.ID] {
+				return result, &ImageDigestMismatchError{Name: tag}
+			}
+			result.Imported = append(result.Imported, tag)
+		}
+	}
+
+	for _, image := range requiredImages {
+		if _, err := docker.ImageInspect(ctx, image); errdefs.IsNotFound(err) {
+			result.Missing = append(result.Missing, image)
+		} else if err != nil {
+			return result, fmt.Errorf("inspecting image %s: %w", image, err)
+		}
+	}
+	return result, nil
+}
+
+// replacedImages returns the bundled tags already installed with a different image.
+func replacedImages(ctx context.Context, docker dockerClient.APIClient, images []bundledImage, blobs map[string]bool) ([]string, error) {
+	var replaced []string
+	for _, image := range images {
+		for _, tag := range image.Tags {
+			info, err := docker.ImageInspect(ctx, tag)
+			if errdefs.IsNotFound(err) {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("inspecting image %s: %w", tag, err)
+			}
+			if info.ID != image.ID && !blobs[info.ID] {
+				replaced = append(replaced, tag)
+			}
+		}
+	}
+	return replaced, nil
+}
+
+// checkBundledImages returns an UnexpectedImagesError if the bundle contains untagged images,
+// or tags that are not in the required images.
+func checkBundledImages(images []bundledImage, requiredImages []string) error {
+	var unexpected []string
+	for _, image := range images {
+		if len(image.Tags) == 0 {
+			unexpected = append(unexpected, image.ID)
+		}
+		for _, tag := range image.Tags {
+			if !slices.Contains(requiredImages, tag) {
+				unexpected = append(unexpected, tag)
+			}
+		}
+	}
+	if len(unexpected) > 0 {
+		return &UnexpectedImagesError{Images: unexpected}
+	}
+	return nil
+}
+
+type bundledImage struct {
+	// ID is the digest of the configuration of the image.
+	ID   string
+	Tags []string
+}
+
+// readImagesBundle verifies the content-addressed files of a `docker save` archive and
+// returns the images it contains, together with the digests of its blobs.
+func readImagesBundle(bundle *paths.Path) ([]bundledImage, map[string]bool, error) {
+	file, err := bundle.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	var manifest []struct {
+		Config   string
+		RepoTags []string
+	}
+	foundManifest := false
+	digests := map[string]string{}
+	blobs := map[string]bool{}
+	tr := tar.NewReader(bufio.NewReader(file))
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading images bundle: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(header.Name)
+		if name == "manifest.json" {
+			if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+				return nil, nil, fmt.Errorf("reading images bundle manifest: %w", err)
+			}
+			foundManifest = true
+			continue
+		}
+		hash := sha256.New()
+		if _, err := io.Copy(hash, tr); err != nil {
+			return nil, nil, fmt.Errorf("reading images bundle: %w", err)
+		}
+		digests[name] = hex.EncodeToString(hash.Sum(nil))
+		if expected, ok := strings.CutPrefix(name, "blobs/sha256/"); ok {
+			if digests[name] != expected {
+				return nil, nil, &ImageDigestMismatchError{Name: name}
+			}
+			blobs["sha256:"+expected] = true
+		}
+	}
+	if !foundManifest {
+		return nil, nil, fmt.Errorf("%s is not an images bundle", bundle)
+	}
+
+	images := make([]bundledImage, 0, len(manifest))
+	for _, entry := range manifest {
+		config := path.Clean(entry.Config)
+		digest, ok := digests[config]
+		if !ok {
+			return nil, nil, fmt.Errorf("reading images bundle: missing %s", config)
+		}
+		// The name of the configuration is its digest, in both the OCI and the legacy layouts.
+		if expected := strings.TrimSuffix(path.Base(config), ".json"); digest != expected {
+			return nil, nil, &ImageDigestMismatchError{Name: config}
+		}
+		images = append(images, bundledImage{ID: "sha256:" + digest, Tags: slices.Clone(entry.RepoTags)})
+	}
+	return images, blobs, nil
+}
+
+// readImageLoadErrors consumes the output of an image load, returning the error it reports.
+func readImageLoadErrors(r io.Reader) error {
+	decoder := json.NewDecoder(r)
+	for {
+		var msg struct {
+			Error string `json:"error"`
+		}
+		if err := decoder.Decode(&msg); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if msg.Error != "" {
+			return errors.New(msg.Error)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package orchestrator
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/image"
+	dockerClient "github.com/docker/docker/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadImagesBundle(t *testing.T) {
+	digest := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+	writeBundle := func(t *testing.T, files map[string]string, order ...string) *paths.Path {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, name := range order {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(files[name])), Typeflag: tar.TypeReg}))
+			_, err := tw.Write([]byte(files[name]))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		bundle := paths.New(t.TempDir()).Join("bundle.tar")
+		require.NoError(t, bundle.WriteFile(buf.Bytes()))
+		return bundle
+	}
+
+	config := `{"architecture":"arm64"}`
+	layer := "layer content"
+	index := `{"manifests":[]}`
+	configBlob := "blobs/sha256/" + digest(config)
+	layerBlob := "blobs/sha256/" + digest(layer)
+	indexBlob := "blobs/sha256/" + digest(index)
+	manifest := `[{"Config":"` + configBlob + `","RepoTags":["ghcr.io/arduino/app-bricks/python-apps-base:0.6.0"],"Layers":["` + layerBlob + `"]}]`
+
+	t.Run("valid", func(t *testing.T) {
+		bundle := writeBundle(t, map[string]string{
+			configBlob: config, layerBlob: layer, indexBlob: index, "manifest.json": manifest,
+		}, configBlob, layerBlob, indexBlob, "manifest.json")
+		images, blobs, err := readImagesBundle(bundle)
+		require.NoError(t, err)
+		require.Equal(t, []bundledImage{{
+			ID:   "sha256:" + digest(config),
+			Tags: []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.6.0"},
+		}}, images)
+		require.True(t, blobs["sha256:"+digest(index)])
+	})
+
+	t.Run("corrupted layer", func(t *testing.T) {
+		bundle := writeBundle(t, map[string]string{
+			configBlob: config, layerBlob: "tampered", "manifest.json": manifest,
+		}, configBlob, layerBlob, "manifest.json")
+		_, _, err := readImagesBundle(bundle)
+		var mismatch *ImageDigestMismatchError
+		require.ErrorAs(t, err, &mismatch)
+		require.Equal(t, layerBlob, mismatch.Name)
+	})
+
+	t.Run("not a bundle", func(t *testing.T) {
+		bundle := writeBundle(t, map[string]string{"app.yaml": "name: test"}, "app.yaml")
+		_, _, err := readImagesBundle(bundle)
+		require.ErrorContains(t, err, "is not an images bundle")
+	})
+}
+
+func TestCheckBundledImages(t *testing.T) {
+	required := []string{
+		"ghcr.io/arduino/app-bricks/python-apps-base:0.6.0",
+		"ghcr.io/arduino/app-bricks/ei-models-runner:0.6.0",
+	}
+
+	t.Run("required images", func(t *testing.T) {
+		require.NoError(t, checkBundledImages([]bundledImage{
+			{ID: "sha256:aaa", Tags: []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.6.0"}},
+			{ID: "sha256:bbb", Tags: []string{"ghcr.io/arduino/app-bricks/ei-models-runner:0.6.0"}},
+		}, required))
+	})
+
+	t.Run("unexpected images", func(t *testing.T) {
+		err := checkBundledImages([]bundledImage{
+			{ID: "sha256:aaa", Tags: []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.6.0", "ghcr.io/arduino/app-bricks/python-apps-base:latest"}},
+			{ID: "sha256:bbb", Tags: []string{"docker.io/library/alpine:3"}},
+			{ID: "sha256:ccc"},
+		}, required)
+		var unexpected *UnexpectedImagesError
+		require.ErrorAs(t, err, &unexpected)
+		require.Equal(t, []string{
+			"ghcr.io/arduino/app-bricks/python-apps-base:latest",
+			"docker.io/library/alpine:3",
+			"sha256:ccc",
+		}, unexpected.Images)
+	})
+}
+
+// inspectOnlyDocker is a docker client knowing only the ids of the installed images, by tag.
+type inspectOnlyDocker struct {
+	dockerClient.APIClient
+	installed map[string]string
+}
+
+func (d inspectOnlyDocker) ImageInspect(_ context.Context, tag string, _ ...dockerClient.ImageInspectOption) (image.InspectResponse, error) {
+	id, ok := d.installed[tag]
+	if !ok {
+		return image.InspectResponse{}, errdefs.ErrNotFound
+	}
+	return image.InspectResponse{ID: id}, nil
+}
+
+func TestReplacedImages(t *testing.T) {
+	images := []bundledImage{
+		{ID: "sha256:aaa", Tags: []string{"ghcr.io/arduino/app-bricks/python-apps-base:0.6.0"}},
+		{ID: "sha256:bbb", Tags: []string{"ghcr.io/arduino/app-bricks/ei-models-runner:0.6.0"}},
+		{ID: "sha256:ccc", Tags: []string{"ghcr.io/arduino/app-bricks/audio-runner:0.6.0"}},
+	}
+	blobs := map[string]bool{"sha256:aaa": true, "sha256:bbb": true, "sha256:ccc": true, "sha256:index": true}
+	docker := inspectOnlyDocker{installed: map[string]string{
+		// The same image, identified by its configuration or by a blob of the bundle.
+		"ghcr.io/arduino/app-bricks/python-apps-base:0.6.0": "sha256:aaa",
+		"ghcr.io/arduino/app-bricks/ei-models-runner:0.6.0": "sha256:index",
+		// A different image with the same tag.
+		"ghcr.io/arduino/app-bricks/audio-runner:0.6.0": "sha256:other",
+	}}
+
+	replaced, err := replacedImages(t.Context(), docker, images, blobs)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ghcr.io/arduino/app-bricks/audio-runner:0.6.0"}, replaced)
+}
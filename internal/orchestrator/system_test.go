@@ -35,7 +35,7 @@ func TestListImagesAlreadyPulled(t *testing.T) {
 	_, _ = io.Copy(io.Discard, r)
 	r.Close()
 
-	images, err := listImagesAlreadyPulled(t.Context(), docker)
+	images, err := listImagesAlreadyPulled(t.Context(), setTestOrchestratorConfig(t), docker)
 	require.NoError(t, err)
 	require.Contains(t, images, "ghcr.io/arduino/app-bricks/python-apps-base:0.4.8")
 }
@@ -53,6 +53,30 @@ func TestRemoveImage(t *testing.T) {
 	require.Greater(t, size, int64(1024))
 }
 
+func TestIsArduinoImage(t *testing.T) {
+	t.Setenv("ARDUINO_APP_CLI__REGISTRY_MIRRORS", "ghcr.io/=mirror.lab:5000/ghcr/;ghcr.io/arduino/=mirror.lab:5000/arduino/;influxdb=mirror.lab:5000/library/influxdb")
+	cfg := setTestOrchestratorConfig(t)
+
+	for image, expected := range map[string]bool{
+		"ghcr.io/arduino/app-bricks/python-apps-base:0.4.8":        true,
+		"public.ecr.aws/arduino/app-bricks/ei-models-runner:0.5.0": true,
+		"influxdb:2.7":                   true,
+		"docker.io/library/influxdb:2.7": true,
+		"influxdb-exporter:1.0":          false,
+		"redis:7":                        false,
+		"ghcr.io/other/image:1.0":        false,
+		"mirror.lab:5000/arduino/app-bricks/python-apps-base:0.4.8":  true,
+		"mirror.lab:5000/ghcr/bcmi-labs/runner:1.0":                  true,
+		"mirror.lab:5000/library/influxdb:2.7":                       true,
+		"mirror.lab:5000/ghcr/other/image:1.0":                       false,
+		"mirror.lab:5000/library/influxdb-exporter:1.0":              false,
+		"mirror.lab:5000/library/redis:7":                            false,
+		"mirror.lab:5000/ghcr/arduino/app-bricks/python-apps-base:1": false,
+	} {
+		require.Equal(t, expected, isArduinoImage(cfg, image), image)
+	}
+}
+
 func getDockerClient(t *testing.T) dockerClient.APIClient {
 	t.Helper()
 	d, err := dockerCommand.NewDockerCli(
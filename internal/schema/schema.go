@@ -0,0 +1,141 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package schema provides the JSON Schemas of the files describing apps, bricks and models.
+// The schemas are generated from the Go types with `task schema` and embedded in the binary.
+package schema
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-yaml"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+const (
+	App        = "app"
+	Brick      = "brick"
+	ModelsList = "models-list"
+)
+
+const fileExtension = ".schema.json"
+
+var ErrSchemaNotFound = errors.New("schema not found")
+
+//go:embed schemas
+var schemasFS embed.FS
+
+var (
+	compiled sync.Map
+	printer  = message.NewPrinter(language.English)
+)
+
+// FileName returns the name of the file holding the given schema.
+func FileName(name string) string {
+	return name + fileExtension
+}
+
+// Names returns the names of the available schemas.
+func Names() []string {
+	entries, err := fs.ReadDir(schemasFS, "schemas")
+	if err != nil {
+		panic("embedded schemas folder not found: " + err.Error())
+	}
+	var names []string
+	for _, e := range entries {
+		if name, ok := strings.CutSuffix(e.Name(), fileExtension); ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Get returns the content of the given schema.
+func Get(name string) ([]byte, error) {
+	if !slices.Contains(Names(), name) {
+		return nil, ErrSchemaNotFound
+	}
+	return schemasFS.ReadFile("schemas/" + FileName(name))
+}
+
+// ValidateYAML validates the given YAML document against the given schema.
+func ValidateYAML(name string, content []byte) error {
+	sch, err := compile(name)
+	if err != nil {
+		return err
+	}
+	data, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return err
+	}
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(string(data)))
+	if err != nil {
+		return err
+	}
+	if err := sch.Validate(doc); err != nil {
+		var verr *jsonschema.ValidationError
+		if errors.As(err, &verr) {
+			return fmt.Errorf("does not match the %s schema: %s", name, strings.Join(validationMessages(verr), "; "))
+		}
+		return err
+	}
+	return nil
+}
+
+func compile(name string) (*jsonschema.Schema, error) {
+	if sch, ok := compiled.Load(name); ok {
+		return sch.(*jsonschema.Schema), nil
+	}
+	content, err := Get(name)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s schema: %w", name, err)
+	}
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource(FileName(name), doc); err != nil {
+		return nil, fmt.Errorf("invalid %s schema: %w", name, err)
+	}
+	sch, err := c.Compile(FileName(name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s schema: %w", name, err)
+	}
+	compiled.Store(name, sch)
+	return sch, nil
+}
+
+// validationMessages returns the leaf errors of a validation error, prefixed by their location.
+func validationMessages(verr *jsonschema.ValidationError) []string {
+	if len(verr.Causes) == 0 {
+		location := "/" + strings.Join(verr.InstanceLocation, "/")
+		return []string{fmt.Sprintf("at '%s': %s", location, verr.ErrorKind.LocalizedString(printer))}
+	}
+	var messages []string
+	for _, cause := range verr.Causes {
+		messages = append(messages, validationMessages(cause)...)
+	}
+	return messages
+}
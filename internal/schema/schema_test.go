@@ -0,0 +1,95 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/arduino/go-paths-helper"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemas(t *testing.T) {
+	require.Equal(t, []string{App, Brick, ModelsList}, Names())
+
+	_, err := Get("not-existing")
+	require.ErrorIs(t, err, ErrSchemaNotFound)
+
+	for _, name := range Names() {
+		_, err := compile(name)
+		require.NoError(t, err, name)
+	}
+}
+
+func TestValidateYAML(t *testing.T) {
+	t.Run("valid app", func(t *testing.T) {
+		err := ValidateYAML(App, []byte(`name: My app
+ports:
+bricks:
+  - arduino:web_ui
+  - arduino:object_detection:
+  - arduino:camera:
+      model: some-model
+      variables:
+        FPS: 30
+        ENABLED: true
+x-custom: unknown keys are allowed
+`))
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid app", func(t *testing.T) {
+		err := ValidateYAML(App, []byte(`ports: [8080, "http"]
+bricks:
+  - arduino:web_ui:
+    arduino:camera:
+`))
+		require.ErrorContains(t, err, "does not match the app schema")
+		require.ErrorContains(t, err, "at '/': missing property 'name'")
+		require.ErrorContains(t, err, "at '/ports/1': got string, want null or integer")
+		require.ErrorContains(t, err, "at '/bricks/0'")
+	})
+
+	t.Run("shipped assets", func(t *testing.T) {
+		assets := paths.New("..", "..", "debian", "arduino-app-cli", "home", "arduino", ".local", "share", "arduino-app-cli", "assets", "0.5.0")
+
+		content, err := assets.Join("models-list.yaml").ReadFile()
+		require.NoError(t, err)
+		require.NoError(t, ValidateYAML(ModelsList, content))
+
+		content, err = assets.Join("bricks-list.yaml").ReadFile()
+		require.NoError(t, err)
+		var bricksList struct {
+			Bricks []yaml.MapSlice `yaml:"bricks"`
+		}
+		require.NoError(t, yaml.Unmarshal(content, &bricksList))
+		require.NotEmpty(t, bricksList.Bricks)
+		for _, b := range bricksList.Bricks {
+			content, err := yaml.Marshal(b)
+			require.NoError(t, err)
+			require.NoError(t, ValidateYAML(Brick, content))
+		}
+
+		descriptors, err := assets.Join("examples").ReadDirRecursiveFiltered(nil, paths.FilterNames("app.yaml"))
+		require.NoError(t, err)
+		for _, descriptor := range descriptors {
+			content, err := descriptor.ReadFile()
+			require.NoError(t, err)
+			require.NoError(t, ValidateYAML(App, content), descriptor.String())
+		}
+	})
+}
@@ -25,11 +25,11 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/cmderrors"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
-	"github.com/sirupsen/logrus"
 
 	"github.com/arduino/arduino-app-cli/internal/helpers"
 	"github.com/arduino/arduino-app-cli/internal/orchestrator"
 	"github.com/arduino/arduino-app-cli/internal/update"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
 )
 
 type ArduinoPlatformUpdater struct {
@@ -40,16 +40,33 @@ func NewArduinoPlatformUpdater() *ArduinoPlatformUpdater {
 	return &ArduinoPlatformUpdater{}
 }
 
-func setConfig(ctx context.Context, srv rpc.ArduinoCoreServiceServer) error {
+// setConfig raises the network timeout of the shared arduino-cli server for the duration of
+// an update: the returned func restores the previous value, so that the other operations,
+// like compiling a sketch, keep failing fast when the network is not available.
+func setConfig(ctx context.Context, srv rpc.ArduinoCoreServiceServer) (func(), error) {
+	const key = "network.connection_timeout"
+	var previous string
+	if resp, err := srv.SettingsGetValue(ctx, &rpc.SettingsGetValueRequest{Key: key}); err == nil {
+		previous = resp.GetEncodedValue()
+	}
 	if _, err := srv.SettingsSetValue(ctx, &rpc.SettingsSetValueRequest{
-		Key:          "network.connection_timeout",
+		Key:          key,
 		EncodedValue: "600s",
 		ValueFormat:  "cli",
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return func() {
+		// An empty value unsets the key.
+		if _, err := srv.SettingsSetValue(context.Background(), &rpc.SettingsSetValueRequest{
+			Key:          key,
+			EncodedValue: previous,
+			ValueFormat:  "json",
+		}); err != nil {
+			slog.Error("Error restoring the network timeout", slog.Any("error", err))
+		}
+	}, nil
 }
 
 // ListUpgradablePackages implements ServiceUpdater.
@@ -59,42 +76,29 @@ func (a *ArduinoPlatformUpdater) ListUpgradablePackages(ctx context.Context, _ f
 	}
 	defer a.lock.Unlock()
 
-	logrus.SetLevel(logrus.ErrorLevel) // Reduce the log level of arduino-cli
-	srv := commands.NewArduinoCoreServer()
-	if err := setConfig(ctx, srv); err != nil {
-		return nil, err
-	}
-
-	var inst *rpc.Instance
-	if resp, err := srv.Create(ctx, &rpc.CreateRequest{}); err != nil {
-		return nil, err
-	} else {
-		inst = resp.GetInstance()
-	}
-	defer func() {
-		_, _ = srv.Destroy(ctx, &rpc.DestroyRequest{Instance: inst})
-	}()
-
-	stream, _ := commands.UpdateIndexStreamResponseToCallbackFunction(ctx, func(curr *rpc.DownloadProgress) {
-		slog.Debug("Update index progress", slog.String("download_progress", curr.String()))
-	})
-	if err := srv.UpdateIndex(&rpc.UpdateIndexRequest{Instance: inst}, stream); err != nil {
-		return nil, err
-	}
+	var platforms *rpc.PlatformSearchResponse
+	err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		restoreConfig, err := setConfig(ctx, s.Server)
+		if err != nil {
+			return err
+		}
+		defer restoreConfig()
 
-	if err := srv.Init(
-		&rpc.InitRequest{Instance: inst},
-		commands.InitStreamResponseToCallbackFunction(ctx, func(r *rpc.InitResponse) error {
-			slog.Debug("Arduino init instance", slog.String("instance", r.String()))
-			return nil
-		}),
-	); err != nil {
-		return nil, err
-	}
+		stream, _ := commands.UpdateIndexStreamResponseToCallbackFunction(ctx, func(curr *rpc.DownloadProgress) {
+			slog.Debug("Update index progress", slog.String("download_progress", curr.String()))
+		})
+		if err := s.Server.UpdateIndex(&rpc.UpdateIndexRequest{Instance: s.Instance}, stream); err != nil {
+			return err
+		}
+		if err := s.Reinit(ctx); err != nil {
+			return err
+		}
 
-	platforms, err := srv.PlatformSearch(ctx, &rpc.PlatformSearchRequest{
-		Instance:          inst,
-		ManuallyInstalled: true,
+		platforms, err = s.Server.PlatformSearch(ctx, &rpc.PlatformSearchRequest{
+			Instance:          s.Instance,
+			ManuallyInstalled: true,
+		})
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -151,135 +155,135 @@ func (a *ArduinoPlatformUpdater) UpgradePackages(ctx context.Context, names []st
 
 		eventsCh <- update.Event{Type: update.StartEvent, Data: "Upgrade is starting"}
 
-		logrus.SetLevel(logrus.ErrorLevel) // Reduce the log level of arduino-cli
-		srv := commands.NewArduinoCoreServer()
-
-		if err := setConfig(ctx, srv); err != nil {
-			eventsCh <- update.Event{
-				Type: update.ErrorEvent,
-				Err:  err,
-				Data: "Error setting additional URLs",
-			}
-			return
-		}
-
-		var inst *rpc.Instance
-		if resp, err := srv.Create(ctx, &rpc.CreateRequest{}); err != nil {
-			eventsCh <- update.Event{
-				Type: update.ErrorEvent,
-				Err:  err,
-				Data: "Error creating Arduino instance",
-			}
-			return
-		} else {
-			inst = resp.GetInstance()
-		}
-		defer func() {
-			_, err := srv.CleanDownloadCacheDirectory(ctx, &rpc.CleanDownloadCacheDirectoryRequest{})
+		err := arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+			srv, inst := s.Server, s.Instance
+			restoreConfig, err := setConfig(ctx, srv)
 			if err != nil {
-				slog.Error("Error cleaning cache directory", slog.Any("error", err))
-			}
-			_, _ = srv.Destroy(ctx, &rpc.DestroyRequest{Instance: inst})
-		}()
-
-		{
-			stream, _ := commands.UpdateIndexStreamResponseToCallbackFunction(ctx, downloadProgressCB)
-			if err := srv.UpdateIndex(&rpc.UpdateIndexRequest{Instance: inst}, stream); err != nil {
 				eventsCh <- update.Event{
 					Type: update.ErrorEvent,
 					Err:  err,
-					Data: "Error updating index",
+					Data: "Error setting additional URLs",
 				}
-				return
+				return nil
 			}
-			if err := srv.Init(&rpc.InitRequest{Instance: inst}, commands.InitStreamResponseToCallbackFunction(ctx, nil)); err != nil {
-				eventsCh <- update.Event{
-					Type: update.ErrorEvent,
-					Err:  err,
-					Data: "Error initializing Arduino instance",
+			defer restoreConfig()
+
+			defer func() {
+				_, err := srv.CleanDownloadCacheDirectory(ctx, &rpc.CleanDownloadCacheDirectoryRequest{})
+				if err != nil {
+					slog.Error("Error cleaning cache directory", slog.Any("error", err))
+				}
+			}()
+
+			{
+				stream, _ := commands.UpdateIndexStreamResponseToCallbackFunction(ctx, downloadProgressCB)
+				if err := srv.UpdateIndex(&rpc.UpdateIndexRequest{Instance: inst}, stream); err != nil {
+					eventsCh <- update.Event{
+						Type: update.ErrorEvent,
+						Err:  err,
+						Data: "Error updating index",
+					}
+					return nil
+				}
+				if err := s.Reinit(ctx); err != nil {
+					eventsCh <- update.Event{
+						Type: update.ErrorEvent,
+						Err:  err,
+						Data: "Error initializing Arduino instance",
+					}
+					return nil
 				}
-				return
 			}
-		}
 
-		stream, respCB := commands.PlatformUpgradeStreamResponseToCallbackFunction(
-			ctx,
-			downloadProgressCB,
-			taskProgressCB,
-		)
-		if err := srv.PlatformUpgrade(
-			&rpc.PlatformUpgradeRequest{
-				Instance:         inst,
-				PlatformPackage:  "arduino",
-				Architecture:     "zephyr",
-				SkipPostInstall:  false,
-				SkipPreUninstall: false,
-			},
-			stream,
-		); err != nil {
-			var alreadyPresent *cmderrors.PlatformAlreadyAtTheLatestVersionError
-			if errors.As(err, &alreadyPresent) {
-				eventsCh <- update.Event{Type: update.UpgradeLineEvent, Data: alreadyPresent.Error()}
-				return
-			}
+			stream, respCB := commands.PlatformUpgradeStreamResponseToCallbackFunction(
+				ctx,
+				downloadProgressCB,
+				taskProgressCB,
+			)
+			if err := srv.PlatformUpgrade(
+				&rpc.PlatformUpgradeRequest{
+					Instance:         inst,
+					PlatformPackage:  "arduino",
+					Architecture:     "zephyr",
+					SkipPostInstall:  false,
+					SkipPreUninstall: false,
+				},
+				stream,
+			); err != nil {
+				var alreadyPresent *cmderrors.PlatformAlreadyAtTheLatestVersionError
+				if errors.As(err, &alreadyPresent) {
+					eventsCh <- update.Event{Type: update.UpgradeLineEvent, Data: alreadyPresent.Error()}
+					return nil
+				}
 
-			var notFound *cmderrors.PlatformNotFoundError
-			if !errors.As(err, &notFound) {
+				var notFound *cmderrors.PlatformNotFoundError
+				if !errors.As(err, &notFound) {
+					eventsCh <- update.Event{
+						Type: update.ErrorEvent,
+						Err:  err,
+						Data: "Error upgrading platform",
+					}
+					return nil
+				}
+				// If the platform is not found, we will try to install it
+				err := srv.PlatformInstall(
+					&rpc.PlatformInstallRequest{
+						Instance:        inst,
+						PlatformPackage: "arduino",
+						Architecture:    "zephyr",
+					},
+					commands.PlatformInstallStreamResponseToCallbackFunction(
+						ctx,
+						downloadProgressCB,
+						taskProgressCB,
+					),
+				)
+				if err != nil {
+					eventsCh <- update.Event{
+						Type: update.ErrorEvent,
+						Err:  err,
+						Data: "Error installing platform",
+					}
+					return nil
+				}
+			} else if respCB().GetPlatform() == nil {
 				eventsCh <- update.Event{
 					Type: update.ErrorEvent,
-					Err:  err,
-					Data: "Error upgrading platform",
+					Data: "platform upgrade failed",
 				}
-				return
+				return nil
 			}
-			// If the platform is not found, we will try to install it
-			err := srv.PlatformInstall(
-				&rpc.PlatformInstallRequest{
-					Instance:        inst,
-					PlatformPackage: "arduino",
-					Architecture:    "zephyr",
+
+			s.Invalidate()
+
+			cbw := orchestrator.NewCallbackWriter(func(line string) {
+				eventsCh <- update.Event{Type: update.UpgradeLineEvent, Data: line}
+			})
+
+			err = srv.BurnBootloader(
+				&rpc.BurnBootloaderRequest{
+					Instance:   inst,
+					Fqbn:       "arduino:zephyr:unoq",
+					Programmer: "jlink",
 				},
-				commands.PlatformInstallStreamResponseToCallbackFunction(
-					ctx,
-					downloadProgressCB,
-					taskProgressCB,
-				),
+				commands.BurnBootloaderToServerStreams(ctx, cbw, cbw),
 			)
 			if err != nil {
 				eventsCh <- update.Event{
 					Type: update.ErrorEvent,
 					Err:  err,
-					Data: "Error installing platform",
+					Data: "Error burning bootloader",
 				}
-				return
+				return nil
 			}
-		} else if respCB().GetPlatform() == nil {
-			eventsCh <- update.Event{
-				Type: update.ErrorEvent,
-				Data: "platform upgrade failed",
-			}
-			return
-		}
-
-		cbw := orchestrator.NewCallbackWriter(func(line string) {
-			eventsCh <- update.Event{Type: update.UpgradeLineEvent, Data: line}
+			return nil
 		})
-
-		err := srv.BurnBootloader(
-			&rpc.BurnBootloaderRequest{
-				Instance:   inst,
-				Fqbn:       "arduino:zephyr:unoq",
-				Programmer: "jlink",
-			},
-			commands.BurnBootloaderToServerStreams(ctx, cbw, cbw),
-		)
 		if err != nil {
 			eventsCh <- update.Event{
 				Type: update.ErrorEvent,
 				Err:  err,
-				Data: "Error burning bootloader",
+				Data: "Error initializing Arduino instance",
 			}
-			return
 		}
 	}()
 
@@ -33,15 +33,19 @@ import (
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/pkg/fqbn"
 	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
-	"github.com/sirupsen/logrus"
 	"go.bug.st/f"
 
 	"github.com/arduino/arduino-app-cli/pkg/board/remote"
 	"github.com/arduino/arduino-app-cli/pkg/board/remote/adb"
 	"github.com/arduino/arduino-app-cli/pkg/board/remote/local"
 	"github.com/arduino/arduino-app-cli/pkg/board/remote/ssh"
+	"github.com/arduino/arduino-app-cli/pkg/x/arduinocli"
 )
 
+// boardQueries runs the short board queries on a dedicated arduino-cli service, so that the
+// polling of the boards is not queued behind compiles, uploads and platform upgrades.
+var boardQueries = sync.OnceValue(arduinocli.New)
+
 type Board struct {
 	Protocol   string
 	Serial     string
@@ -90,16 +94,7 @@ var onBoard = sync.OnceValue(func() bool {
 	return false
 })()
 
-// Cache the initialized Arduino CLI service, so it don't need to be re-initialized
-// TODO: provide a way to get the board information by event instead of polling.
-var arduinoCLIServer rpc.ArduinoCoreServiceServer
-var arduinoCLIInstance *rpc.Instance
-var arduinoCLILock sync.Mutex
-
 func FromFQBN(ctx context.Context, fqbn string) ([]Board, error) {
-	arduinoCLILock.Lock()
-	defer arduinoCLILock.Unlock()
-
 	if onBoard {
 		var customName string
 		if name, err := GetCustomName(ctx, &local.LocalConnection{}); err == nil {
@@ -118,37 +113,16 @@ func FromFQBN(ctx context.Context, fqbn string) ([]Board, error) {
 		}}, nil
 	}
 
-	if arduinoCLIServer == nil {
-		logrus.SetLevel(logrus.ErrorLevel) // Reduce the log level of arduino-cli
-		arduinoCLIServer = commands.NewArduinoCoreServer()
-	}
-
-	if arduinoCLIInstance == nil {
-		var inst *rpc.Instance
-		if resp, err := arduinoCLIServer.Create(ctx, &rpc.CreateRequest{}); err != nil {
-			return nil, err
-		} else {
-			inst = resp.GetInstance()
-		}
-
-		if err := arduinoCLIServer.Init(
-			&rpc.InitRequest{Instance: inst},
-			// TODO: implement progress callback function
-			commands.InitStreamResponseToCallbackFunction(ctx, func(r *rpc.InitResponse) error { return nil }),
-		); err != nil {
-			// in case of error destroy invalid instance
-			_, _ = arduinoCLIServer.Destroy(ctx, &rpc.DestroyRequest{Instance: inst})
-			return nil, err
-		}
-
-		arduinoCLIInstance = inst
-	}
-
-	listReq := &rpc.BoardListRequest{
-		Instance: arduinoCLIInstance,
-		Timeout:  100, // 100 ms
-	}
-	list, err := arduinoCLIServer.BoardList(ctx, listReq)
+	// TODO: provide a way to get the board information by event instead of polling.
+	var list *rpc.BoardListResponse
+	err := boardQueries().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		var err error
+		list, err = s.Server.BoardList(ctx, &rpc.BoardListRequest{
+			Instance: s.Instance,
+			Timeout:  100, // 100 ms
+		})
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get info for FQBN %s: %w", fqbn, err)
 	}
@@ -421,77 +395,67 @@ func EnsurePlatformInstalled(ctx context.Context, rawFQBN string) error {
 		return err
 	}
 
-	logrus.SetLevel(logrus.ErrorLevel) // Reduce the log level of arduino-cli
-	srv := commands.NewArduinoCoreServer()
+	return arduinocli.Shared().Run(ctx, arduinocli.Request{}, func(s *arduinocli.Session) error {
+		srv := s.Server
+		defer func() {
+			_, err := srv.CleanDownloadCacheDirectory(ctx, &rpc.CleanDownloadCacheDirectoryRequest{})
+			if err != nil {
+				slog.Error("Error cleaning cache directory", slog.Any("error", err))
+			}
+		}()
 
-	var inst *rpc.Instance
-	if resp, err := srv.Create(ctx, &rpc.CreateRequest{}); err != nil {
-		return err
-	} else {
-		inst = resp.GetInstance()
-	}
-	defer func() {
-		_, err := srv.CleanDownloadCacheDirectory(ctx, &rpc.CleanDownloadCacheDirectoryRequest{})
+		stream, _ := commands.UpdateIndexStreamResponseToCallbackFunction(ctx, func(curr *rpc.DownloadProgress) {
+			slog.Debug("Update index progress", slog.String("download_progress", curr.String()))
+		})
+		if err := srv.UpdateIndex(&rpc.UpdateIndexRequest{Instance: s.Instance}, stream); err != nil {
+			return err
+		}
+		if err := s.Reinit(ctx); err != nil {
+			return err
+		}
+
+		platforms, err := srv.PlatformSearch(ctx, &rpc.PlatformSearchRequest{
+			Instance:          s.Instance,
+			ManuallyInstalled: true,
+		})
 		if err != nil {
-			slog.Error("Error cleaning cache directory", slog.Any("error", err))
+			return err
 		}
-		_, _ = srv.Destroy(ctx, &rpc.DestroyRequest{Instance: inst})
-	}()
 
-	stream, _ := commands.UpdateIndexStreamResponseToCallbackFunction(ctx, func(curr *rpc.DownloadProgress) {
-		slog.Debug("Update index progress", slog.String("download_progress", curr.String()))
-	})
-	if err := srv.UpdateIndex(&rpc.UpdateIndexRequest{Instance: inst}, stream); err != nil {
-		return err
-	}
+		var platformSummary *rpc.PlatformSummary
+		for _, v := range platforms.GetSearchOutput() {
+			if v.GetMetadata().GetId() == parsedFQBN.Vendor+":"+parsedFQBN.Architecture {
+				platformSummary = v
+				break
+			}
+		}
+		if platformSummary == nil {
+			return fmt.Errorf("platform %s not found", parsedFQBN.Vendor+":"+parsedFQBN.Architecture)
+		}
 
-	if err := srv.Init(
-		&rpc.InitRequest{Instance: inst},
-		commands.InitStreamResponseToCallbackFunction(ctx, func(r *rpc.InitResponse) error {
-			slog.Debug("Arduino init instance", slog.String("instance", r.String()))
+		if platformSummary.GetInstalledVersion() != "" {
 			return nil
-		}),
-	); err != nil {
-		return err
-	}
-
-	platforms, err := srv.PlatformSearch(ctx, &rpc.PlatformSearchRequest{
-		Instance:          inst,
-		ManuallyInstalled: true,
-	})
-	if err != nil {
-		return err
-	}
-
-	var platformSummary *rpc.PlatformSummary
-	for _, v := range platforms.GetSearchOutput() {
-		if v.GetMetadata().GetId() == parsedFQBN.Vendor+":"+parsedFQBN.Architecture {
-			platformSummary = v
-			break
 		}
-	}
-	if platformSummary == nil {
-		return fmt.Errorf("platform %s not found", parsedFQBN.Vendor+":"+parsedFQBN.Architecture)
-	}
-
-	if platformSummary.GetInstalledVersion() != "" {
-		return nil
-	}
 
-	return srv.PlatformInstall(
-		&rpc.PlatformInstallRequest{
-			Instance:        inst,
-			PlatformPackage: parsedFQBN.Vendor,
-			Architecture:    parsedFQBN.Architecture,
-		},
-		commands.PlatformInstallStreamResponseToCallbackFunction(
-			ctx,
-			func(curr *rpc.DownloadProgress) {
-				slog.Debug("Platform install progress", slog.String("download_progress", curr.String()))
+		if err := srv.PlatformInstall(
+			&rpc.PlatformInstallRequest{
+				Instance:        s.Instance,
+				PlatformPackage: parsedFQBN.Vendor,
+				Architecture:    parsedFQBN.Architecture,
 			},
-			func(msg *rpc.TaskProgress) {
-				slog.Debug("Platform install message", slog.String("message", msg.GetMessage()))
-			},
-		),
-	)
+			commands.PlatformInstallStreamResponseToCallbackFunction(
+				ctx,
+				func(curr *rpc.DownloadProgress) {
+					slog.Debug("Platform install progress", slog.String("download_progress", curr.String()))
+				},
+				func(msg *rpc.TaskProgress) {
+					slog.Debug("Platform install message", slog.String("message", msg.GetMessage()))
+				},
+			),
+		); err != nil {
+			return err
+		}
+		s.Invalidate()
+		return nil
+	})
 }
@@ -0,0 +1,280 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+// Package arduinocli provides a shared arduino-cli service, keeping its instances
+// initialized across requests instead of paying the init cost on every operation.
+package arduinocli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/commands"
+	rpc "github.com/arduino/arduino-cli/rpc/cc/arduino/cli/commands/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// maxProfileInstances is the number of sketch profile instances kept initialized,
+// besides the default one.
+const maxProfileInstances = 2
+
+// Request selects the instance used to run an operation.
+type Request struct {
+	// SketchPath and Profile select a sketch profile: the instance is initialized with the
+	// platforms and libraries of the profile. When empty, the default instance is used.
+	SketchPath string
+	Profile    string
+	// InitCallback, if set, receives the init progress when the instance is (re)initialized.
+	InitCallback func(*rpc.InitResponse) error
+}
+
+func (r Request) key() string {
+	if r.SketchPath == "" {
+		return ""
+	}
+	return r.SketchPath + "#" + r.Profile
+}
+
+// Service is an arduino-cli server with a pool of lazily initialized instances.
+// Operations are queued and run one at a time.
+type Service struct {
+	srv rpc.ArduinoCoreServiceServer
+	// sem serializes the operations, waiting on it honours the context of the caller.
+	sem chan struct{}
+
+	// The following fields are guarded by sem.
+	instances  map[string]*instance
+	generation int
+	indexStamp string
+}
+
+type instance struct {
+	inst        *rpc.Instance
+	generation  int
+	sketchStamp string
+	lastUsed    time.Time
+}
+
+// Session gives access to an initialized instance while an operation runs.
+type Session struct {
+	Server   rpc.ArduinoCoreServiceServer
+	Instance *rpc.Instance
+
+	service *Service
+	req     Request
+	entry   *instance
+}
+
+var shared = sync.OnceValue(New)
+
+// Shared returns the arduino-cli service shared by the whole process.
+func Shared() *Service {
+	return shared()
+}
+
+// New returns a new arduino-cli service. Most callers should use Shared instead.
+func New() *Service {
+	logrus.SetLevel(logrus.ErrorLevel) // Reduce the log level of arduino-cli
+	return &Service{
+		srv:       commands.NewArduinoCoreServer(),
+		sem:       make(chan struct{}, 1),
+		instances: map[string]*instance{},
+	}
+}
+
+// Server returns the underlying arduino-cli server, for the operations that do not need
+// an instance (e.g. loading a sketch or listing the libraries of a profile).
+func (s *Service) Server() rpc.ArduinoCoreServiceServer {
+	return s.srv
+}
+
+// Run runs fn with an initialized instance. The instance is (re)initialized only if it was
+// never used, if the indexes changed, or, for profile instances, if the sketch.yaml changed.
+func (s *Service) Run(ctx context.Context, req Request, fn func(*Session) error) error {
+	select {
+	case s.sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-s.sem }()
+
+	if stamp := s.currentIndexStamp(ctx); stamp != s.indexStamp {
+		if s.indexStamp != "" {
+			slog.Debug("arduino-cli indexes changed, re-initializing instances")
+			s.generation++
+		}
+		s.indexStamp = stamp
+	}
+
+	entry, err := s.instance(ctx, req)
+	if err != nil {
+		return err
+	}
+	entry.lastUsed = time.Now()
+	return fn(&Session{Server: s.srv, Instance: entry.inst, service: s, req: req, entry: entry})
+}
+
+// Reinit re-initializes the instance of the session. It must be called after updating the
+// indexes or installing platforms: the other instances are re-initialized on their next use.
+func (s *Session) Reinit(ctx context.Context) error {
+	s.service.generation++
+	s.service.indexStamp = s.service.currentIndexStamp(ctx)
+	return s.service.init(ctx, s.req, s.entry)
+}
+
+// Invalidate marks the other instances as stale, so that they are re-initialized on their
+// next use. It must be called after operations that already re-initialize the instance of
+// the session, such as installing or upgrading a platform.
+func (s *Session) Invalidate() {
+	s.service.generation++
+	s.entry.generation = s.service.generation
+}
+
+// Discard marks the instance of the session as stale, so that it is re-initialized on its
+// next use. It must be called when the instance was initialized with missing platforms or
+// libraries, e.g. because their download failed.
+func (s *Session) Discard() {
+	s.entry.generation = s.service.generation - 1
+}
+
+// Close destroys all the instances of the service.
+func (s *Service) Close() {
+	s.sem <- struct{}{}
+	defer func() { <-s.sem }()
+	for key, entry := range s.instances {
+		_, _ = s.srv.Destroy(context.Background(), &rpc.DestroyRequest{Instance: entry.inst})
+		delete(s.instances, key)
+	}
+}
+
+func (s *Service) instance(ctx context.Context, req Request) (*instance, error) {
+	key := req.key()
+	entry, ok := s.instances[key]
+	if ok && entry.generation == s.generation && entry.sketchStamp == sketchStamp(req) {
+		return entry, nil
+	}
+	if !ok {
+		if key != "" {
+			s.evictProfileInstances()
+		}
+		resp, err := s.srv.Create(ctx, &rpc.CreateRequest{})
+		if err != nil {
+			return nil, err
+		}
+		entry = &instance{inst: resp.GetInstance()}
+	}
+	if err := s.init(ctx, req, entry); err != nil {
+		// In case of error destroy the invalid instance.
+		_, _ = s.srv.Destroy(ctx, &rpc.DestroyRequest{Instance: entry.inst})
+		delete(s.instances, key)
+		return nil, err
+	}
+	s.instances[key] = entry
+	return entry, nil
+}
+
+func (s *Service) init(ctx context.Context, req Request, entry *instance) error {
+	callback := req.InitCallback
+	if callback == nil {
+		callback = func(r *rpc.InitResponse) error {
+			slog.Debug("Arduino init instance", slog.String("instance", r.String()))
+			return nil
+		}
+	}
+	if err := s.srv.Init(
+		&rpc.InitRequest{Instance: entry.inst, SketchPath: req.SketchPath, Profile: req.Profile},
+		commands.InitStreamResponseToCallbackFunction(ctx, callback),
+	); err != nil {
+		return err
+	}
+	entry.generation = s.generation
+	entry.sketchStamp = sketchStamp(req)
+	return nil
+}
+
+// evictProfileInstances destroys the least recently used profile instances to make room for a new one.
+func (s *Service) evictProfileInstances() {
+	var keys []string
+	for key := range s.instances {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return s.instances[a].lastUsed.Compare(s.instances[b].lastUsed)
+	})
+	for len(keys) >= maxProfileInstances {
+		_, _ = s.srv.Destroy(context.Background(), &rpc.DestroyRequest{Instance: s.instances[keys[0]].inst})
+		delete(s.instances, keys[0])
+		keys = keys[1:]
+	}
+}
+
+// DataDir returns the arduino-cli data directory, where the indexes, the platforms and the
+// platforms and libraries of the sketch profiles are installed.
+func (s *Service) DataDir(ctx context.Context) (string, error) {
+	resp, err := s.srv.SettingsGetValue(ctx, &rpc.SettingsGetValueRequest{Key: "directories.data"})
+	if err != nil {
+		return "", err
+	}
+	var dataDir string
+	if err := json.Unmarshal([]byte(resp.GetEncodedValue()), &dataDir); err != nil {
+		return "", err
+	}
+	return dataDir, nil
+}
+
+// currentIndexStamp fingerprints the index files of the arduino-cli data directory,
+// so that an index updated by another process is detected too.
+func (s *Service) currentIndexStamp(ctx context.Context) string {
+	dataDir, err := s.DataDir(ctx)
+	if err != nil {
+		return ""
+	}
+	files, err := filepath.Glob(filepath.Join(dataDir, "*index*.json"))
+	if err != nil {
+		return ""
+	}
+	slices.Sort(files)
+	var stamp strings.Builder
+	for _, file := range files {
+		if info, err := os.Stat(file); err == nil {
+			fmt.Fprintf(&stamp, "%s:%d:%d;", filepath.Base(file), info.Size(), info.ModTime().UnixNano())
+		}
+	}
+	return stamp.String()
+}
+
+// sketchStamp fingerprints the sketch project file, so that a profile instance is
+// re-initialized when the platforms or libraries of the profile change.
+func sketchStamp(req Request) string {
+	if req.SketchPath == "" {
+		return ""
+	}
+	for _, name := range []string{"sketch.yaml", "sketch.yml"} {
+		if info, err := os.Stat(filepath.Join(req.SketchPath, name)); err == nil {
+			return fmt.Sprintf("%d:%d", info.Size(), info.ModTime().UnixNano())
+		}
+	}
+	return ""
+}
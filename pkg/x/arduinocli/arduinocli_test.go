@@ -0,0 +1,60 @@
+// This file is part of arduino-app-cli.
+//
+// Copyright 2025 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-app-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to
+// modify or otherwise use the software for commercial activities involving the
+// Arduino software without disclosing the source code of your own applications.
+// To purchase a commercial license, send an email to license@arduino.cc.
+
+package arduinocli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunHonoursContextWhileQueued(t *testing.T) {
+	s := New()
+	// Simulate an operation in progress.
+	s.sem <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	called := false
+	err := s.Run(ctx, Request{}, func(*Session) error {
+		called = true
+		return nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, called)
+}
+
+func TestSketchStamp(t *testing.T) {
+	require.Empty(t, sketchStamp(Request{}))
+
+	dir := t.TempDir()
+	req := Request{SketchPath: dir, Profile: "default"}
+	require.Empty(t, sketchStamp(req))
+	require.Equal(t, dir+"#default", req.key())
+	require.Empty(t, Request{}.key())
+
+	project := filepath.Join(dir, "sketch.yaml")
+	require.NoError(t, os.WriteFile(project, []byte("profiles:\n"), 0o600))
+	stamp := sketchStamp(req)
+	require.NotEmpty(t, stamp)
+
+	require.NoError(t, os.WriteFile(project, []byte("profiles:\n  default:\n"), 0o600))
+	require.NotEqual(t, stamp, sketchStamp(req))
+}